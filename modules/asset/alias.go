@@ -17,6 +17,13 @@ const (
 	QueryForbiddenAddr        = types.QueryForbiddenAddr
 	QueryParameters           = types.QueryParameters
 	QueryReservedSymbols      = types.QueryReservedSymbols
+	QueryMintSchedule         = types.QueryMintSchedule
+	QueryCoOwners             = types.QueryCoOwners
+	QueryProposals            = types.QueryProposals
+	QueryPendingOwner         = types.QueryPendingOwner
+	QueryPendingOwners        = types.QueryPendingOwners
+	QueryFrozenAmounts        = types.QueryFrozenAmounts
+	QueryAddrFrozen           = types.QueryAddrFrozen
 	MaxTokenAmount            = types.MaxTokenAmount
 	DefaultIssueTokenFee      = types.DefaultIssueLongTokenFee
 	DefaultIssue2CharTokenFee = types.DefaultIssue2CharTokenFee
@@ -24,6 +31,39 @@ const (
 	DefaultIssue4CharTokenFee = types.DefaultIssue4CharTokenFee
 	DefaultIssue5CharTokenFee = types.DefaultIssue5CharTokenFee
 	DefaultIssue6CharTokenFee = types.DefaultIssue6CharTokenFee
+
+	QuerySnapshot           = types.QuerySnapshot
+	QuerySnapshots          = types.QuerySnapshots
+	QuerySnapshotBalance    = types.QuerySnapshotBalance
+	DefaultSnapshotFee      = types.DefaultSnapshotFee
+	DefaultSnapshotLimit    = types.DefaultSnapshotLimit
+	SnapshotEntriesPerBlock = types.SnapshotEntriesPerBlock
+	SnapshotRetentionBlocks = types.SnapshotRetentionBlocks
+
+	QuerySymbolAuction        = types.QuerySymbolAuction
+	QuerySymbolAuctions       = types.QuerySymbolAuctions
+	QuerySymbolReservation    = types.QuerySymbolReservation
+	SymbolAuctionDuration     = types.SymbolAuctionDuration
+	SymbolReservationTimeout  = types.SymbolReservationTimeout
+	SymbolAuctionMinIncrement = types.SymbolAuctionMinIncrement
+
+	QueryDistribution           = types.QueryDistribution
+	QueryDistributions          = types.QueryDistributions
+	DistributionEntriesPerBlock = types.DistributionEntriesPerBlock
+	MaxDistributionExcluded     = types.MaxDistributionExcluded
+
+	QueryTokenMetadata = types.QueryTokenMetadata
+	MaxTokenDecimals   = types.MaxTokenDecimals
+
+	QueryMintPolicy       = types.QueryMintPolicy
+	MaxMintWindowSeconds  = types.MaxMintWindowSeconds
+	MintLimitSupplyCap    = types.MintLimitSupplyCap
+	MintLimitWindowAmount = types.MintLimitWindowAmount
+
+	QueryHolderCount   = types.QueryHolderCount
+	QueryTopHolders    = types.QueryTopHolders
+	QueryHolderRank    = types.QueryHolderRank
+	DefaultHolderLimit = types.DefaultHolderLimit
 )
 
 var (
@@ -48,9 +88,41 @@ var (
 	NewMsgForbidAddr           = types.NewMsgForbidAddr
 	NewMsgUnForbidAddr         = types.NewMsgUnForbidAddr
 	NewMsgModifyTokenInfo      = types.NewMsgModifyTokenInfo
+	NewMsgSetMintSchedule      = types.NewMsgSetMintSchedule
+	NewMsgSetTokenCoOwners     = types.NewMsgSetTokenCoOwners
+	NewMsgProposeTokenAction   = types.NewMsgProposeTokenAction
+	NewMsgApproveTokenAction   = types.NewMsgApproveTokenAction
 	TestIdentityString         = types.TestIdentityString
 	ValidateTokenSymbol        = types.ValidateTokenSymbol
 
+	NewMsgAcceptOwnership         = types.NewMsgAcceptOwnership
+	NewMsgCancelOwnershipTransfer = types.NewMsgCancelOwnershipTransfer
+	NewMsgHolderBurnToken         = types.NewMsgHolderBurnToken
+
+	NewMsgFreezeTokenAmount   = types.NewMsgFreezeTokenAmount
+	NewMsgUnfreezeTokenAmount = types.NewMsgUnfreezeTokenAmount
+	NewIssuerFrozenAmount     = types.NewIssuerFrozenAmount
+
+	NewMsgRequestTokenSnapshot = types.NewMsgRequestTokenSnapshot
+	NewTokenSnapshot           = types.NewTokenSnapshot
+	NewSnapshotBalance         = types.NewSnapshotBalance
+
+	NewMsgOpenSymbolAuction = types.NewMsgOpenSymbolAuction
+	NewMsgBidSymbolAuction  = types.NewMsgBidSymbolAuction
+	NewSymbolAuction        = types.NewSymbolAuction
+	NewSymbolReservation    = types.NewSymbolReservation
+
+	NewMsgDistributeToHolders  = types.NewMsgDistributeToHolders
+	NewDistribution            = types.NewDistribution
+	NewQueryDistributionParams = types.NewQueryDistributionParams
+	NewMsgSetMintPolicy        = types.NewMsgSetMintPolicy
+	NewMintPolicy              = types.NewMintPolicy
+	NewMintRecord              = types.NewMintRecord
+
+	NewTokenHolder           = types.NewTokenHolder
+	NewQueryTopHoldersParams = types.NewQueryTopHoldersParams
+	NewQueryHolderRankParams = types.NewQueryHolderRankParams
+
 	DefaultParams = types.DefaultParams
 
 	// variable aliases
@@ -77,4 +149,47 @@ type (
 	MsgRemoveTokenWhitelist = types.MsgRemoveTokenWhitelist
 	MsgUnForbidAddr         = types.MsgUnForbidAddr
 	MsgModifyTokenInfo      = types.MsgModifyTokenInfo
+	MsgSetMintSchedule      = types.MsgSetMintSchedule
+	MintSchedule            = types.MintSchedule
+	MintScheduleInfo        = types.MintScheduleInfo
+	MsgSetTokenCoOwners     = types.MsgSetTokenCoOwners
+	MsgProposeTokenAction   = types.MsgProposeTokenAction
+	MsgApproveTokenAction   = types.MsgApproveTokenAction
+	TokenCoOwners           = types.TokenCoOwners
+	TokenActionProposal     = types.TokenActionProposal
+	MintTranche             = types.MintTranche
+	LinearEmission          = types.LinearEmission
+
+	MsgAcceptOwnership         = types.MsgAcceptOwnership
+	MsgCancelOwnershipTransfer = types.MsgCancelOwnershipTransfer
+	PendingOwnershipTransfer   = types.PendingOwnershipTransfer
+	MsgHolderBurnToken         = types.MsgHolderBurnToken
+	TokenRedemption            = types.TokenRedemption
+
+	MsgFreezeTokenAmount   = types.MsgFreezeTokenAmount
+	MsgUnfreezeTokenAmount = types.MsgUnfreezeTokenAmount
+	IssuerFrozenAmount     = types.IssuerFrozenAmount
+
+	MsgRequestTokenSnapshot = types.MsgRequestTokenSnapshot
+	TokenSnapshot           = types.TokenSnapshot
+	SnapshotBalance         = types.SnapshotBalance
+
+	MsgOpenSymbolAuction = types.MsgOpenSymbolAuction
+	MsgBidSymbolAuction  = types.MsgBidSymbolAuction
+	SymbolAuction        = types.SymbolAuction
+	SymbolReservation    = types.SymbolReservation
+
+	MsgDistributeToHolders = types.MsgDistributeToHolders
+	Distribution           = types.Distribution
+
+	TokenMetadata = types.TokenMetadata
+	DenomUnit     = types.DenomUnit
+	SocialLink    = types.SocialLink
+
+	MsgSetMintPolicy = types.MsgSetMintPolicy
+	MintPolicy       = types.MintPolicy
+	MintPolicyInfo   = types.MintPolicyInfo
+	MintRecord       = types.MintRecord
+
+	TokenHolder = types.TokenHolder
 )
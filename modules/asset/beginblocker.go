@@ -0,0 +1,14 @@
+package asset
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker builds the holder index in the first block after an in-place upgrade from a version
+// without it, before any transaction changes the balances. The chains started from genesis.json
+// have built it in InitGenesis
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	if k.BuildHolderIndexOnce(ctx) {
+		ctx.Logger().Info("built the holder index of the tokens")
+	}
+}
@@ -11,6 +11,8 @@ const (
 	flagTokenURL         = "url"
 	flagTokenDescription = "description"
 	flagTokenIdentity    = "identity"
+	flagHolderBurnable   = "holder-burnable"
+	flagPermissioned     = "permissioned"
 
 	flagClientHome  = "home-client"
 	flagOwner       = "owner"
@@ -22,4 +24,37 @@ const (
 	flagAmount    = "amount"
 	flagWhitelist = "whitelist"
 	flagAddresses = "addresses"
+
+	flagMintTranches      = "mint-tranches"
+	flagEmissionRate      = "emission-rate"
+	flagEmissionStart     = "emission-start"
+	flagEmissionEnd       = "emission-end"
+	flagEmissionRecipient = "emission-recipient"
+
+	flagCoOwners   = "co-owners"
+	flagThreshold  = "threshold"
+	flagLifetime   = "lifetime"
+	flagProposalID = "proposal-id"
+
+	flagRedemptionMemo = "redemption-memo"
+
+	flagAddress = "address"
+
+	flagDenom    = "denom"
+	flagExcluded = "excluded"
+
+	flagDisplay       = "display"
+	flagDecimals      = "decimals"
+	flagDenomUnits    = "denom-units"
+	flagLogoHash      = "logo-hash"
+	flagSocialLinks   = "social-links"
+	flagClearMetadata = "clear-metadata"
+
+	flagSupplyCap     = "supply-cap"
+	flagWindowAmount  = "window-amount"
+	flagWindowSeconds = "window-seconds"
+
+	flagPage   = "page"
+	flagLimit  = "limit"
+	flagFormat = "format"
 )
@@ -2,10 +2,13 @@ package cli
 
 import (
 	"fmt"
+	"io/ioutil"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/viper"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
@@ -30,6 +33,13 @@ func parseIssueFlags(owner sdk.AccAddress) (*types.MsgIssueToken, error) {
 		return nil, types.ErrInvalidTokenSupply(flagTotalSupply)
 	}
 	msg := newMsgIssueToken(amt, owner)
+	schedule, err := parseMintScheduleFlags()
+	if err != nil {
+		return nil, err
+	}
+	msg.MintSchedule = schedule
+	msg.HolderBurnable = viper.GetBool(flagHolderBurnable)
+	msg.Permissioned = viper.GetBool(flagPermissioned)
 	return &msg, nil
 }
 
@@ -98,6 +108,24 @@ func parseBurnTokenFlags(owner sdk.AccAddress) (*types.MsgBurnToken, error) {
 	return &msg, nil
 }
 
+func parseHolderBurnTokenFlags(holder sdk.AccAddress) (*types.MsgHolderBurnToken, error) {
+	if err := checkFlags(burnTokenFlags, "$ cetcli tx asset holder-burn-token -h"); err != nil {
+		return nil, err
+	}
+	amt, ok := sdk.NewIntFromString(viper.GetString(flagAmount))
+	if !ok {
+		return nil, types.ErrInvalidTokenBurnAmt(flagAmount)
+	}
+	msg := types.NewMsgHolderBurnToken(
+		viper.GetString(flagSymbol),
+		amt,
+		holder,
+		viper.GetString(flagRedemptionMemo),
+	)
+
+	return &msg, nil
+}
+
 func parseForbidTokenFlags(owner sdk.AccAddress) (*types.MsgForbidToken, error) {
 	if err := checkFlags(symbolFlags, "$ cetcli tx asset forbid-token -h"); err != nil {
 		return nil, err
@@ -242,6 +270,350 @@ func parseModifyTokenInfoFlags(owner sdk.AccAddress) (*types.MsgModifyTokenInfo,
 		viper.GetString(flagAddrForbiddable),
 		viper.GetString(flagTokenForbiddable),
 	)
+	msg.HolderBurnable = viper.GetString(flagHolderBurnable)
+
+	metadata, err := parseTokenMetadataFlags()
+	if err != nil {
+		return nil, err
+	}
+	msg.Metadata = metadata
+
+	return &msg, nil
+}
+
+// parseTokenMetadataFlags returns nil if the metadata should not be modified
+func parseTokenMetadataFlags() (*types.TokenMetadata, error) {
+	if viper.GetBool(flagClearMetadata) {
+		return &types.TokenMetadata{}, nil
+	}
+
+	metadata := &types.TokenMetadata{
+		Display:  viper.GetString(flagDisplay),
+		Decimals: uint32(viper.GetInt64(flagDecimals)),
+		LogoHash: viper.GetString(flagLogoHash),
+	}
+	if units := viper.GetString(flagDenomUnits); units != "" {
+		// denom:exponent,denom:exponent...
+		for _, s := range strings.Split(units, ",") {
+			fields := strings.Split(s, ":")
+			if len(fields) != 2 {
+				return nil, fmt.Errorf("invalid denom unit %s, it must be denom:exponent", s)
+			}
+			exp, err := strconv.ParseUint(fields[1], 10, 32)
+			if err != nil {
+				return nil, err
+			}
+			metadata.DenomUnits = append(metadata.DenomUnits, types.DenomUnit{Denom: fields[0], Exponent: uint32(exp)})
+		}
+	}
+	if links := viper.GetString(flagSocialLinks); links != "" {
+		// name=url,name=url...
+		for _, s := range strings.Split(links, ",") {
+			fields := strings.SplitN(s, "=", 2)
+			if len(fields) != 2 {
+				return nil, fmt.Errorf("invalid social link %s, it must be name=url", s)
+			}
+			metadata.SocialLinks = append(metadata.SocialLinks, types.SocialLink{Name: fields[0], URL: fields[1]})
+		}
+	}
+
+	if metadata.IsEmpty() {
+		return nil, nil
+	}
+	return metadata, nil
+}
+
+func parseSetMintScheduleFlags(owner sdk.AccAddress) (*types.MsgSetMintSchedule, error) {
+	if err := checkFlags(symbolFlags, "$ cetcli tx asset set-mint-schedule -h"); err != nil {
+		return nil, err
+	}
+	schedule, err := parseMintScheduleFlags()
+	if err != nil {
+		return nil, err
+	}
+	if schedule == nil {
+		return nil, fmt.Errorf("either --%s or --%s must be provided", flagMintTranches, flagEmissionRate)
+	}
+
+	msg := types.NewMsgSetMintSchedule(
+		viper.GetString(flagSymbol),
+		owner,
+		*schedule,
+	)
+
+	return &msg, nil
+}
+
+func parseSetMintPolicyFlags(owner sdk.AccAddress) (*types.MsgSetMintPolicy, error) {
+	if err := checkFlags(symbolFlags, "$ cetcli tx asset set-mint-policy -h"); err != nil {
+		return nil, err
+	}
+	supplyCap, ok := sdk.NewIntFromString(viper.GetString(flagSupplyCap))
+	if !ok {
+		return nil, types.ErrInvalidMintPolicy("invalid supply cap " + viper.GetString(flagSupplyCap))
+	}
+	windowAmount, ok := sdk.NewIntFromString(viper.GetString(flagWindowAmount))
+	if !ok {
+		return nil, types.ErrInvalidMintPolicy("invalid window amount " + viper.GetString(flagWindowAmount))
+	}
+
+	msg := types.NewMsgSetMintPolicy(
+		viper.GetString(flagSymbol),
+		owner,
+		types.NewMintPolicy(supplyCap, windowAmount, viper.GetInt64(flagWindowSeconds)),
+	)
+
+	return &msg, nil
+}
+
+// parseMintScheduleFlags returns nil if no mint schedule is provided in the flags
+func parseMintScheduleFlags() (*types.MintSchedule, error) {
+	if tranches := viper.GetString(flagMintTranches); tranches != "" {
+		schedule := &types.MintSchedule{}
+		// time:amount:recipient,time:amount:recipient...
+		for _, s := range strings.Split(tranches, ",") {
+			fields := strings.Split(s, ":")
+			if len(fields) != 3 {
+				return nil, fmt.Errorf("invalid tranche %s, it must be time:amount:recipient", s)
+			}
+			t, err := strconv.ParseInt(fields[0], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			amt, ok := sdk.NewIntFromString(fields[1])
+			if !ok {
+				return nil, types.ErrInvalidTokenMintAmt(fields[1])
+			}
+			recipient, err := sdk.AccAddressFromBech32(fields[2])
+			if err != nil {
+				return nil, err
+			}
+			schedule.Tranches = append(schedule.Tranches, types.MintTranche{Time: t, Amount: amt, Recipient: recipient})
+		}
+		return schedule, nil
+	}
+
+	rateStr := viper.GetString(flagEmissionRate)
+	if rateStr == "" {
+		return nil, nil
+	}
+	rate, ok := sdk.NewIntFromString(rateStr)
+	if !ok {
+		return nil, types.ErrInvalidTokenMintAmt(rateStr)
+	}
+	recipient, err := sdk.AccAddressFromBech32(viper.GetString(flagEmissionRecipient))
+	if err != nil {
+		return nil, err
+	}
+	emission := &types.LinearEmission{
+		Rate:      rate,
+		StartTime: viper.GetInt64(flagEmissionStart),
+		EndTime:   viper.GetInt64(flagEmissionEnd),
+		Recipient: recipient,
+	}
+	return &types.MintSchedule{Emission: emission}, nil
+}
+
+func parseSetCoOwnersFlags(owner sdk.AccAddress) (*types.MsgSetTokenCoOwners, error) {
+	if err := checkFlags(symbolFlags, "$ cetcli tx asset set-co-owners -h"); err != nil {
+		return nil, err
+	}
+
+	var coOwners []sdk.AccAddress
+	if str := viper.GetString(flagCoOwners); str != "" {
+		for _, s := range strings.Split(str, ",") {
+			addr, err := sdk.AccAddressFromBech32(s)
+			if err != nil {
+				return nil, err
+			}
+			coOwners = append(coOwners, addr)
+		}
+	}
+
+	msg := types.NewMsgSetTokenCoOwners(
+		viper.GetString(flagSymbol),
+		owner,
+		coOwners,
+		uint32(viper.GetInt64(flagThreshold)),
+	)
+
+	return &msg, nil
+}
+
+// parseProposeActionFlags reads the proposed action from a JSON file
+func parseProposeActionFlags(cdc *codec.Codec, file string, proposer sdk.AccAddress) (*types.MsgProposeTokenAction, error) {
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var action sdk.Msg
+	if err := cdc.UnmarshalJSON(bz, &action); err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgProposeTokenAction(
+		proposer,
+		action,
+		viper.GetInt64(flagLifetime),
+	)
+
+	return &msg, nil
+}
+
+func parseApproveActionFlags(approver sdk.AccAddress) (*types.MsgApproveTokenAction, error) {
+	if err := checkFlags(approveActionFlags, "$ cetcli tx asset approve-action -h"); err != nil {
+		return nil, err
+	}
+
+	id, err := strconv.ParseUint(viper.GetString(flagProposalID), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgApproveTokenAction(
+		viper.GetString(flagSymbol),
+		id,
+		approver,
+	)
+
+	return &msg, nil
+}
+
+func parseAcceptOwnershipFlags(newOwner sdk.AccAddress) (*types.MsgAcceptOwnership, error) {
+	if err := checkFlags(symbolFlags, "$ cetcli tx asset accept-ownership -h"); err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgAcceptOwnership(
+		viper.GetString(flagSymbol),
+		newOwner,
+	)
+
+	return &msg, nil
+}
+
+func parseCancelOwnershipTransferFlags(owner sdk.AccAddress) (*types.MsgCancelOwnershipTransfer, error) {
+	if err := checkFlags(symbolFlags, "$ cetcli tx asset cancel-ownership-transfer -h"); err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgCancelOwnershipTransfer(
+		viper.GetString(flagSymbol),
+		owner,
+	)
+
+	return &msg, nil
+}
+
+func parseFreezeAmountFlags(example string) (symbol string, addr sdk.AccAddress, amt sdk.Int, err error) {
+	if err = checkFlags(freezeAmountFlags, example); err != nil {
+		return
+	}
+	if addr, err = sdk.AccAddressFromBech32(viper.GetString(flagAddress)); err != nil {
+		return
+	}
+	var ok bool
+	if amt, ok = sdk.NewIntFromString(viper.GetString(flagAmount)); !ok {
+		err = types.ErrInvalidFreezeAmount(viper.GetString(flagAmount))
+		return
+	}
+	symbol = viper.GetString(flagSymbol)
+	return
+}
+
+func parseFreezeTokenAmountFlags(owner sdk.AccAddress) (*types.MsgFreezeTokenAmount, error) {
+	symbol, addr, amt, err := parseFreezeAmountFlags("$ cetcli tx asset freeze-amount -h")
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgFreezeTokenAmount(symbol, owner, addr, amt)
+	return &msg, nil
+}
+
+func parseUnfreezeTokenAmountFlags(owner sdk.AccAddress) (*types.MsgUnfreezeTokenAmount, error) {
+	symbol, addr, amt, err := parseFreezeAmountFlags("$ cetcli tx asset unfreeze-amount -h")
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgUnfreezeTokenAmount(symbol, owner, addr, amt)
+	return &msg, nil
+}
+
+func parseRequestSnapshotFlags(requester sdk.AccAddress) (*types.MsgRequestTokenSnapshot, error) {
+	if err := checkFlags(symbolFlags, "$ cetcli tx asset request-snapshot -h"); err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgRequestTokenSnapshot(
+		viper.GetString(flagSymbol),
+		requester,
+	)
+
+	return &msg, nil
+}
+
+func parseSymbolBidFlags(usage string) (symbol string, amt sdk.Int, err error) {
+	if err = checkFlags(symbolBidFlags, usage); err != nil {
+		return
+	}
+	var ok bool
+	if amt, ok = sdk.NewIntFromString(viper.GetString(flagAmount)); !ok {
+		err = types.ErrInvalidSymbolBid(viper.GetString(flagAmount))
+		return
+	}
+	symbol = viper.GetString(flagSymbol)
+	return
+}
+
+func parseOpenSymbolAuctionFlags(bidder sdk.AccAddress) (*types.MsgOpenSymbolAuction, error) {
+	symbol, amt, err := parseSymbolBidFlags("$ cetcli tx asset open-auction -h")
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgOpenSymbolAuction(symbol, bidder, amt)
+	return &msg, nil
+}
+
+func parseBidSymbolAuctionFlags(bidder sdk.AccAddress) (*types.MsgBidSymbolAuction, error) {
+	symbol, amt, err := parseSymbolBidFlags("$ cetcli tx asset bid-auction -h")
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgBidSymbolAuction(symbol, bidder, amt)
+	return &msg, nil
+}
+
+func parseDistributeToHoldersFlags(owner sdk.AccAddress) (*types.MsgDistributeToHolders, error) {
+	if err := checkFlags(distributeFlags, "$ cetcli tx asset distribute -h"); err != nil {
+		return nil, err
+	}
+	amt, ok := sdk.NewIntFromString(viper.GetString(flagAmount))
+	if !ok {
+		return nil, types.ErrInvalidDistributionAmount(viper.GetString(flagAmount))
+	}
+
+	var excluded []sdk.AccAddress
+	if str := viper.GetString(flagExcluded); str != "" {
+		for _, s := range strings.Split(str, ",") {
+			addr, err := sdk.AccAddressFromBech32(s)
+			if err != nil {
+				return nil, err
+			}
+			excluded = append(excluded, addr)
+		}
+	}
+
+	msg := types.NewMsgDistributeToHolders(
+		viper.GetString(flagSymbol),
+		owner,
+		viper.GetString(flagDenom),
+		amt,
+		excluded,
+	)
 
 	return &msg, nil
 }
@@ -1,12 +1,17 @@
 package cli
 
 import (
+	"encoding/csv"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
 	"github.com/coinexchain/cosmos-utils/client/cliutil"
@@ -27,6 +32,27 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryTokenWhitelist(types.QuerierRoute, cdc),
 		GetCmdQueryTokenForbiddenAddr(types.QuerierRoute, cdc),
 		GetCmdQueryTokenReservedSymbols(types.QuerierRoute, cdc),
+		GetCmdQueryMintSchedule(types.QuerierRoute, cdc),
+		GetCmdQueryTokenMetadata(types.QuerierRoute, cdc),
+		GetCmdQueryMintPolicy(types.QuerierRoute, cdc),
+		GetCmdQueryCoOwners(types.QuerierRoute, cdc),
+		GetCmdQueryProposals(types.QuerierRoute, cdc),
+		GetCmdQueryPendingOwner(types.QuerierRoute, cdc),
+		GetCmdQueryPendingOwners(types.QuerierRoute, cdc),
+		GetCmdQueryFrozenAmounts(types.QuerierRoute, cdc),
+		GetCmdQueryAddrFrozenAmounts(types.QuerierRoute, cdc),
+		GetCmdQuerySnapshot(types.QuerierRoute, cdc),
+		GetCmdQuerySnapshots(types.QuerierRoute, cdc),
+		GetCmdQuerySnapshotBalances(types.QuerierRoute, cdc),
+		GetCmdExportSnapshot(types.QuerierRoute, cdc),
+		GetCmdQuerySymbolAuction(types.QuerierRoute, cdc),
+		GetCmdQuerySymbolAuctions(types.QuerierRoute, cdc),
+		GetCmdQuerySymbolReservation(types.QuerierRoute, cdc),
+		GetCmdQueryDistribution(types.QuerierRoute, cdc),
+		GetCmdQueryDistributions(types.QuerierRoute, cdc),
+		GetCmdQueryHolderCount(types.QuerierRoute, cdc),
+		GetCmdQueryTopHolders(types.QuerierRoute, cdc),
+		GetCmdQueryHolderRank(types.QuerierRoute, cdc),
 	)...)
 
 	return assQueryCmd
@@ -167,3 +193,611 @@ $ cetcli query asset reserved-symbols
 	}
 	return cmd
 }
+
+// GetCmdQueryMintSchedule returns the mint schedule of a token
+func GetCmdQueryMintSchedule(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-schedule [symbol]",
+		Short: "Query mint schedule",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the mint schedule of a token and how far it has been executed.
+
+Example:
+$ cetcli query asset mint-schedule abc
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryMintSchedule)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAssetParams(symbol)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryTokenMetadata returns the display metadata of a token
+func GetCmdQueryTokenMetadata(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "metadata [symbol]",
+		Short: "Query token metadata",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the display denomination, decimals, denom units, logo hash and social links of a token.
+
+Example:
+$ cetcli query asset metadata abc
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTokenMetadata)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAssetParams(symbol)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryMintPolicy returns the mint policy of a token
+func GetCmdQueryMintPolicy(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-policy [symbol]",
+		Short: "Query mint policy",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the mint policy of a token, the amount minted in the current window and how much can still be minted.
+
+Example:
+$ cetcli query asset mint-policy abc
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryMintPolicy)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAssetParams(symbol)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryCoOwners returns the co-owners of a token
+func GetCmdQueryCoOwners(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "co-owners [symbol]",
+		Short: "Query co-owners",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the co-owners of a token and the threshold of approvals needed by its admin actions.
+
+Example:
+$ cetcli query asset co-owners abc
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCoOwners)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAssetParams(symbol)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryProposals returns the pending proposals of a token
+func GetCmdQueryProposals(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposals [symbol]",
+		Short: "Query pending proposals",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the pending admin actions of a token, which are proposed by its co-owners.
+
+Example:
+$ cetcli query asset proposals abc
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryProposals)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAssetParams(symbol)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryPendingOwner returns the pending ownership transfer of a token
+func GetCmdQueryPendingOwner(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-owner [symbol]",
+		Short: "Query pending ownership transfer",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the nominated owner of a token, who has not accepted the ownership.
+
+Example:
+$ cetcli query asset pending-owner abc
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPendingOwner)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAssetParams(symbol)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryPendingOwners returns all the pending ownership transfers
+func GetCmdQueryPendingOwners(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-owners",
+		Short: "Query all pending ownership transfers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the ownership transfers which wait for the acceptance of the nominated owners.
+
+Example:
+$ cetcli query asset pending-owners
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPendingOwners)
+			return cliutil.CliQuery(cdc, route, nil)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryFrozenAmounts returns the amounts of a token frozen by its owner
+func GetCmdQueryFrozenAmounts(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen-amounts [symbol]",
+		Short: "Query the frozen amounts of a token",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the amounts of a token which are frozen on the addresses by the token owner.
+
+Example:
+$ cetcli query asset frozen-amounts abc
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryFrozenAmounts)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAssetParams(symbol)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryAddrFrozenAmounts returns the amounts frozen on an address by the token owners
+func GetCmdQueryAddrFrozenAmounts(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "addr-frozen-amounts [address]",
+		Short: "Query the frozen amounts of an address",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the amounts of all the tokens which are frozen on an address by the token owners.
+
+Example:
+$ cetcli query asset addr-frozen-amounts coinex1...
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAddrFrozen)
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			params := types.NewQueryAddrFrozenParams(addr)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQuerySnapshot returns a token snapshot
+func GetCmdQuerySnapshot(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot [id]",
+		Short: "Query a token snapshot",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a token snapshot, whose height is zero until it is taken at the end of the block.
+
+Example:
+$ cetcli query asset snapshot 1
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySnapshot)
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			params := types.NewQuerySnapshotParams(id)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQuerySnapshots returns the snapshots of a token
+func GetCmdQuerySnapshots(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots [symbol]",
+		Short: "Query the snapshots of a token",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the snapshots of a token, the earliest first.
+
+Example:
+$ cetcli query asset snapshots abc
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySnapshots)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAssetParams(symbol)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQuerySnapshotBalances returns a page of the balances recorded in a token snapshot
+func GetCmdQuerySnapshotBalances(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot-balances [id]",
+		Short: "Query the balances recorded in a token snapshot",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a page of the holder balances recorded in a token snapshot, in the order of the addresses.
+
+Example:
+$ cetcli query asset snapshot-balances 1 --page=1 --limit=100
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySnapshotBalance)
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			params := types.NewQuerySnapshotBalancesParams(id, viper.GetInt(flagPage), viper.GetInt(flagLimit))
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	cmd.Flags().Int(flagPage, 1, "The page of the results, starting from 1")
+	cmd.Flags().Int(flagLimit, types.DefaultSnapshotLimit, "The number of results in a page")
+	return cmd
+}
+
+// GetCmdExportSnapshot exports all the balances recorded in a token snapshot as JSON or CSV
+func GetCmdExportSnapshot(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-snapshot [id]",
+		Short: "Export the balances recorded in a token snapshot",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Export all the holder balances recorded in a token snapshot as JSON or CSV,
+which are fetched page by page.
+
+Example:
+$ cetcli query asset export-snapshot 1 --format=csv > snapshot.csv
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			format := viper.GetString(flagFormat)
+			if format != "json" && format != "csv" {
+				return fmt.Errorf("unsupported format %s, must be json or csv", format)
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySnapshotBalance)
+			balances, err := fetchSnapshotBalances(cliCtx, cdc, route, id)
+			if err != nil {
+				return err
+			}
+
+			if format == "json" {
+				bz, err := codec.MarshalJSONIndent(cdc, balances)
+				if err != nil {
+					return err
+				}
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+				return err
+			}
+
+			w := csv.NewWriter(cmd.OutOrStdout())
+			_ = w.Write([]string{"address", "amount"})
+			for _, b := range balances {
+				_ = w.Write([]string{b.Address.String(), b.Amount.String()})
+			}
+			w.Flush()
+			return w.Error()
+		},
+	}
+	cmd.Flags().String(flagFormat, "json", "The output format, json or csv")
+	return cmd
+}
+
+func fetchSnapshotBalances(cliCtx context.CLIContext, cdc *codec.Codec, route string, id uint64) ([]types.SnapshotBalance, error) {
+	balances := make([]types.SnapshotBalance, 0)
+	for page := 1; ; page++ {
+		bz, err := cdc.MarshalJSON(types.NewQuerySnapshotBalancesParams(id, page, types.DefaultSnapshotLimit))
+		if err != nil {
+			return nil, err
+		}
+		res, _, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			return nil, err
+		}
+		var pageBalances []types.SnapshotBalance
+		if err := cdc.UnmarshalJSON(res, &pageBalances); err != nil {
+			return nil, err
+		}
+		balances = append(balances, pageBalances...)
+		if len(pageBalances) < types.DefaultSnapshotLimit {
+			return balances, nil
+		}
+	}
+}
+
+// GetCmdQuerySymbolAuction returns the ongoing auction of a symbol
+func GetCmdQuerySymbolAuction(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auction [symbol]",
+		Short: "Query the ongoing auction of a symbol",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the ongoing auction of a symbol, including the highest bid and its bidder.
+
+Example:
+$ cetcli query asset auction abc
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySymbolAuction)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAssetParams(symbol)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQuerySymbolAuctions returns all the ongoing symbol auctions
+func GetCmdQuerySymbolAuctions(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "auctions",
+		Args:  cobra.NoArgs,
+		Short: "Query all the ongoing symbol auctions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySymbolAuctions)
+			return cliutil.CliQuery(cdc, route, nil)
+		},
+	}
+}
+
+// GetCmdQuerySymbolReservation returns the reservation of a symbol won in an auction
+func GetCmdQuerySymbolReservation(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reservation [symbol]",
+		Short: "Query the reservation of a symbol won in an auction",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the winner of a symbol auction, who can issue the token before the deadline.
+
+Example:
+$ cetcli query asset reservation abc
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySymbolReservation)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAssetParams(symbol)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryDistribution returns the progress of a distribution to token holders
+func GetCmdQueryDistribution(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribution [id]",
+		Short: "Query a distribution to token holders",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the progress of a distribution to token holders, whose settle_height is zero
+until all the holders are paid.
+
+Example:
+$ cetcli query asset distribution 1
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDistribution)
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			params := types.NewQueryDistributionParams(id)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryDistributions returns the distributions to the holders of a token
+func GetCmdQueryDistributions(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distributions [symbol]",
+		Short: "Query the distributions to the holders of a token",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the distributions to the holders of a token, the earliest first.
+
+Example:
+$ cetcli query asset distributions abc
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDistributions)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAssetParams(symbol)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryHolderCount returns the number of the holders of a token
+func GetCmdQueryHolderCount(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "holder-count [symbol]",
+		Short: "Query the number of the holders of a token",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of the addresses holding a token.
+CET is not indexed, because its balances are also changed by fees, staking and rewards.
+
+Example:
+$ cetcli query asset holder-count abc
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryHolderCount)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAssetParams(symbol)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryTopHolders returns a page of the holders of a token ordered by their amounts
+func GetCmdQueryTopHolders(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top-holders [symbol]",
+		Short: "Query the top holders of a token",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a page of the holders of a token, from the largest amount including the locked and frozen coins.
+CET is not indexed, because its balances are also changed by fees, staking and rewards.
+
+Example:
+$ cetcli query asset top-holders abc --page=1 --limit=100
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTopHolders)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryTopHoldersParams(symbol, viper.GetInt(flagPage), viper.GetInt(flagLimit))
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	cmd.Flags().Int(flagPage, 1, "The page of the results, starting from 1")
+	cmd.Flags().Int(flagLimit, types.DefaultHolderLimit, "The number of results in a page")
+	return cmd
+}
+
+// GetCmdQueryHolderRank returns the amount and the rank of a holder of a token
+func GetCmdQueryHolderRank(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "holder-rank [symbol] [address]",
+		Short: "Query the rank of a holder of a token",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the amount of a token held by an address and its rank among all the holders.
+CET is not indexed, because its balances are also changed by fees, staking and rewards.
+
+Example:
+$ cetcli query asset holder-rank abc coinex1y5kdxnzn2tfwayyntf2n28q8q2s80mcul852ke
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryHolderRank)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			addr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			params := types.NewQueryHolderRankParams(symbol, addr)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
 	"github.com/coinexchain/cosmos-utils/client/cliutil"
@@ -16,6 +19,34 @@ func TestQueryCmds(t *testing.T) {
 	testQueryCmd(t, "whitelist abc", "custom/asset/token-whitelist", types.NewQueryWhitelistParams("abc"))
 	testQueryCmd(t, "forbidden-addresses abc", "custom/asset/addr-forbidden", types.NewQueryForbiddenAddrParams("abc"))
 	testQueryCmd(t, "reserved-symbols", "custom/asset/reserved-symbols", nil)
+	testQueryCmd(t, "mint-schedule abc", "custom/asset/mint-schedule", types.NewQueryAssetParams("abc"))
+	testQueryCmd(t, "mint-policy abc", "custom/asset/mint-policy", types.NewQueryAssetParams("abc"))
+	testQueryCmd(t, "metadata abc", "custom/asset/token-metadata", types.NewQueryAssetParams("abc"))
+	testQueryCmd(t, "co-owners abc", "custom/asset/token-co-owners", types.NewQueryAssetParams("abc"))
+	testQueryCmd(t, "proposals abc", "custom/asset/token-proposals", types.NewQueryAssetParams("abc"))
+	testQueryCmd(t, "pending-owner abc", "custom/asset/pending-owner", types.NewQueryAssetParams("abc"))
+	testQueryCmd(t, "pending-owners", "custom/asset/pending-owners", nil)
+	testQueryCmd(t, "frozen-amounts abc", "custom/asset/frozen-amounts", types.NewQueryAssetParams("abc"))
+
+	addr, _ := sdk.AccAddressFromBech32(testAddrBech32)
+	testQueryCmd(t, "addr-frozen-amounts "+testAddrBech32, "custom/asset/addr-frozen-amounts", types.NewQueryAddrFrozenParams(addr))
+
+	testQueryCmd(t, "snapshot 1", "custom/asset/token-snapshot", types.NewQuerySnapshotParams(1))
+	testQueryCmd(t, "snapshots abc", "custom/asset/token-snapshots", types.NewQueryAssetParams("abc"))
+	testQueryCmd(t, "distribution 1", "custom/asset/token-distribution", types.NewQueryDistributionParams(1))
+	testQueryCmd(t, "distributions abc", "custom/asset/token-distributions", types.NewQueryAssetParams("abc"))
+	testQueryCmd(t, "auction abc", "custom/asset/symbol-auction", types.NewQueryAssetParams("abc"))
+	testQueryCmd(t, "auctions", "custom/asset/symbol-auctions", nil)
+	testQueryCmd(t, "reservation abc", "custom/asset/symbol-reservation", types.NewQueryAssetParams("abc"))
+	testQueryCmd(t, "holder-count abc", "custom/asset/holder-count", types.NewQueryAssetParams("abc"))
+	testQueryCmd(t, "holder-rank abc "+testAddrBech32, "custom/asset/holder-rank", types.NewQueryHolderRankParams("abc", addr))
+
+	cliutil.SetViperWithArgs([]string{"--page=2", "--limit=10"})
+	testQueryCmd(t, "snapshot-balances 1 --page=2 --limit=10", "custom/asset/snapshot-balances",
+		types.NewQuerySnapshotBalancesParams(1, 2, 10))
+	testQueryCmd(t, "top-holders abc --page=2 --limit=10", "custom/asset/top-holders",
+		types.NewQueryTopHoldersParams("abc", 2, 10))
+	viper.Reset()
 }
 
 func testQueryCmd(t *testing.T, args string, expectedPath string, expectedParam interface{}) {
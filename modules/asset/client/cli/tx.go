@@ -37,13 +37,27 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdTransferOwnership(cdc),
 		GetCmdMintToken(cdc),
 		GetCmdBurnToken(cdc),
+		GetCmdHolderBurnToken(cdc),
 		GetCmdForbidToken(cdc),
 		GetCmdUnForbidToken(cdc),
 		GetCmdAddTokenWhitelist(cdc),
 		GetCmdRemoveTokenWhitelist(cdc),
 		GetCmdForbidAddr(cdc),
 		GetCmdUnForbidAddr(cdc),
+		GetCmdFreezeTokenAmount(cdc),
+		GetCmdUnfreezeTokenAmount(cdc),
 		GetCmdModifyTokenInfo(cdc),
+		GetCmdSetMintSchedule(cdc),
+		GetCmdSetMintPolicy(cdc),
+		GetCmdSetCoOwners(cdc),
+		GetCmdProposeAction(cdc),
+		GetCmdApproveAction(cdc),
+		GetCmdAcceptOwnership(cdc),
+		GetCmdCancelOwnershipTransfer(cdc),
+		GetCmdRequestSnapshot(cdc),
+		GetCmdOpenSymbolAuction(cdc),
+		GetCmdBidSymbolAuction(cdc),
+		GetCmdDistributeToHolders(cdc),
 	)...)
 
 	return assTxCmd
@@ -68,6 +82,7 @@ $ cetcli tx asset issue-token --name="ABC Token" \
 	--url="www.abc.org" \
 	--description="token abc is a example token" \
 	--identity="552A83BA62F9B1F8" \
+	--holder-burnable=false \
 	--from mykey
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -89,6 +104,9 @@ $ cetcli tx asset issue-token --name="ABC Token" \
 	cmd.Flags().String(flagTokenURL, "", "url of token website")
 	cmd.Flags().String(flagTokenDescription, "", "description of token info")
 	cmd.Flags().String(flagTokenIdentity, "", "identity of token")
+	cmd.Flags().Bool(flagHolderBurnable, false, "whether the token holders could burn their own tokens for redemption")
+	cmd.Flags().Bool(flagPermissioned, false, "whether only the owner and the whitelisted addresses could hold, send, receive or trade the token")
+	addMintScheduleFlags(cmd)
 
 	for _, flag := range issueTokenFlags {
 		_ = cmd.MarkFlagRequired(flag)
@@ -109,6 +127,8 @@ func GetCmdTransferOwnership(cdc *codec.Codec) *cobra.Command {
 		Short: "Create and sign a transfer-ownership tx",
 		Long: strings.TrimSpace(
 			`Create and sign a transfer-ownership tx, broadcast to nodes.
+The new owner is nominated, and gets the ownership after sending an accept-ownership tx
+within 7 days.
 
 Example:
 $ cetcli tx asset transfer-ownership --symbol="abc" \
@@ -211,6 +231,43 @@ $ cetcli tx asset burn-token --symbol="abc" \
 	return cmd
 }
 
+// GetCmdHolderBurnToken will create a holder burn token tx and sign.
+func GetCmdHolderBurnToken(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "holder-burn-token",
+		Short: "Create and sign a holder burn token tx",
+		Long: strings.TrimSpace(
+			`Create and sign a holder burn token tx, broadcast to nodes.
+Any holder can burn its own tokens if the token is holder burnable,
+and the memo tells the token owner how to redeem the burned tokens off chain.
+
+Example:
+$ cetcli tx asset holder-burn-token --symbol="abc" \
+	--amount=10000000000000000 \
+	--redemption-memo="withdrawal address" \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseHolderBurnTokenFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token will be burned")
+	cmd.Flags().String(flagAmount, "0", "the amount of burn")
+	cmd.Flags().String(flagRedemptionMemo, "", "the redemption info for the token owner, such as a withdrawal address")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	for _, flag := range burnTokenFlags {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+
+	return cmd
+}
+
 var symbolFlags = []string{
 	flagSymbol,
 }
@@ -444,6 +501,12 @@ $ cetcli tx asset modify-token-info --symbol="abc" \
 	--description="abc example description" \
 	--identity="552A83BA62F9B1F8" \
 	--from mykey
+
+The metadata is replaced as a whole once any of its flags is provided:
+$ cetcli tx asset modify-token-info --symbol="abc" \
+	--display="ABC" --decimals=8 --denom-units="abc:0,mABC:5,ABC:8" \
+	--social-links="twitter=https://twitter.com/abc" \
+	--from mykey
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			msg, err := parseModifyTokenInfoFlags(nil)
@@ -464,8 +527,501 @@ $ cetcli tx asset modify-token-info --symbol="abc" \
 	cmd.Flags().String(flagBurnable, types.DoNotModifyTokenInfo, "whether the token could be burned")
 	cmd.Flags().String(flagAddrForbiddable, types.DoNotModifyTokenInfo, "whether the token holder address can be forbidden by token owner")
 	cmd.Flags().String(flagTokenForbiddable, types.DoNotModifyTokenInfo, "whether the token can be forbidden")
+	cmd.Flags().String(flagHolderBurnable, types.DoNotModifyTokenInfo, "whether the token holders could burn their own tokens for redemption")
+	addTokenMetadataFlags(cmd)
 
 	_ = cmd.MarkFlagRequired(client.FlagFrom)
 
 	return cmd
 }
+
+// GetCmdSetMintSchedule will create a set mint schedule tx and sign.
+func GetCmdSetMintSchedule(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-mint-schedule",
+		Short: "Create and sign a set mint schedule tx",
+		Long: strings.TrimSpace(
+			`Create and sign a set mint schedule tx, broadcast to nodes.
+The schedule is either a list of tranches, or a linear emission. Once it is set, the token can
+only be minted by the schedule, and the schedule can never be changed.
+
+Example:
+$ cetcli tx asset set-mint-schedule --symbol="abc" \
+	--mint-tranches="1600000000:100000000:coinex1gc5t98jap4zyhmhmyq5af5s7pyv57w5694el97,1700000000:100000000:coinex1gc5t98jap4zyhmhmyq5af5s7pyv57w5694el97" \
+	--from mykey
+
+$ cetcli tx asset set-mint-schedule --symbol="abc" \
+	--emission-rate=100 \
+	--emission-start=1600000000 \
+	--emission-end=1700000000 \
+	--emission-recipient=coinex1gc5t98jap4zyhmhmyq5af5s7pyv57w5694el97 \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseSetMintScheduleFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token will be minted by the schedule")
+	addMintScheduleFlags(cmd)
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(flagSymbol)
+
+	return cmd
+}
+
+// GetCmdSetMintPolicy will create a set mint policy tx and sign.
+func GetCmdSetMintPolicy(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-mint-policy",
+		Short: "Create and sign a set mint policy tx",
+		Long: strings.TrimSpace(
+			`Create and sign a set mint policy tx, broadcast to nodes.
+The policy caps the total supply, and/or limits the amount minted within any window of seconds.
+Once it is set, the policy can never be changed.
+
+Example:
+$ cetcli tx asset set-mint-policy --symbol="abc" \
+	--supply-cap=10000000000000000 \
+	--window-amount=100000000000000 --window-seconds=86400 \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseSetMintPolicyFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token will be limited by the policy")
+	cmd.Flags().String(flagSupplyCap, "0", "the cap of total supply, 0 for no cap")
+	cmd.Flags().String(flagWindowAmount, "0", "the max amount minted within the window, 0 for no limit")
+	cmd.Flags().Int64(flagWindowSeconds, 0, "the length of the window in seconds")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(flagSymbol)
+
+	return cmd
+}
+
+func addMintScheduleFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagMintTranches, "", "the tranches of the mint schedule, as time:amount:recipient separated by commas")
+	cmd.Flags().String(flagEmissionRate, "", "the amount minted per second by the linear emission")
+	cmd.Flags().Int64(flagEmissionStart, 0, "the unix time when the linear emission starts")
+	cmd.Flags().Int64(flagEmissionEnd, 0, "the unix time when the linear emission ends")
+	cmd.Flags().String(flagEmissionRecipient, "", "who receives the token minted by the linear emission")
+}
+
+func addTokenMetadataFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagDisplay, "", "the denomination shown to users")
+	cmd.Flags().Uint32(flagDecimals, 0, "the exponent of the display denomination")
+	cmd.Flags().String(flagDenomUnits, "", "the denom units, as denom:exponent separated by commas, starting with symbol:0")
+	cmd.Flags().String(flagLogoHash, "", "the hex encoded sha256 hash of the token logo")
+	cmd.Flags().String(flagSocialLinks, "", "the social links, as name=url separated by commas")
+	cmd.Flags().Bool(flagClearMetadata, false, "clear the metadata of token")
+}
+
+// GetCmdSetCoOwners will create a set co-owners tx and sign.
+func GetCmdSetCoOwners(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-co-owners",
+		Short: "Create and sign a set co-owners tx",
+		Long: strings.TrimSpace(
+			`Create and sign a set co-owners tx, broadcast to nodes.
+Once a token has co-owners, the actions which need the token owner must be proposed by a co-owner
+and approved by threshold co-owners, including changing the co-owners. An empty --co-owners
+together with --threshold=0 removes the co-owners.
+
+Example:
+$ cetcli tx asset set-co-owners --symbol="abc" \
+	--co-owners=coinex1gc5t98jap4zyhmhmyq5af5s7pyv57w5694el97,coinex1y5kdxnzn2tfwayyntf2n28q8q2s80mcul852ke \
+	--threshold=2 \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseSetCoOwnersFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token will have the co-owners")
+	cmd.Flags().String(flagCoOwners, "", "the co-owners separated by commas")
+	cmd.Flags().Uint32(flagThreshold, 0, "how many co-owners must approve an action")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(flagSymbol)
+
+	return cmd
+}
+
+// GetCmdProposeAction will create a propose token action tx and sign.
+func GetCmdProposeAction(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-action [action-file]",
+		Short: "Create and sign a propose token action tx",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			`Create and sign a propose token action tx, broadcast to nodes.
+The action is a message in JSON, whose owner must be the token owner, such as the message
+generated by "cetcli tx asset mint-token --generate-only". The proposer must be a co-owner of
+the token, and the action is executed once enough co-owners approve it.
+
+Example:
+$ cetcli tx asset propose-action mint.json --lifetime=86400 --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseProposeActionFlags(cdc, args[0], nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().Int64(flagLifetime, 7*24*3600, "how many seconds the proposal waits for approvals")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
+
+var approveActionFlags = []string{
+	flagSymbol,
+	flagProposalID,
+}
+
+// GetCmdApproveAction will create an approve token action tx and sign.
+func GetCmdApproveAction(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-action",
+		Short: "Create and sign an approve token action tx",
+		Long: strings.TrimSpace(
+			`Create and sign an approve token action tx, broadcast to nodes.
+
+Example:
+$ cetcli tx asset approve-action --symbol="abc" --proposal-id=1 --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseApproveActionFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "the token of the proposal")
+	cmd.Flags().Uint64(flagProposalID, 0, "the id of the proposal")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(flagSymbol)
+	_ = cmd.MarkFlagRequired(flagProposalID)
+
+	return cmd
+}
+
+// GetCmdAcceptOwnership will create an accept ownership tx and sign.
+func GetCmdAcceptOwnership(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-ownership",
+		Short: "Create and sign an accept ownership tx",
+		Long: strings.TrimSpace(
+			`Create and sign an accept ownership tx, broadcast to nodes, with which the nominated owner accepts the ownership of a token.
+
+Example:
+$ cetcli tx asset accept-ownership --symbol="abc" \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseAcceptOwnershipFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token`s ownership is accepted")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	for _, flag := range symbolFlags {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+
+	return cmd
+}
+
+// GetCmdCancelOwnershipTransfer will create a cancel ownership transfer tx and sign.
+func GetCmdCancelOwnershipTransfer(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-ownership-transfer",
+		Short: "Create and sign a cancel ownership transfer tx",
+		Long: strings.TrimSpace(
+			`Create and sign a cancel ownership transfer tx, broadcast to nodes, with which the owner cancels the pending ownership transfer of a token.
+
+Example:
+$ cetcli tx asset cancel-ownership-transfer --symbol="abc" \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseCancelOwnershipTransferFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token`s ownership transfer is cancelled")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	for _, flag := range symbolFlags {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+
+	return cmd
+}
+
+var freezeAmountFlags = []string{
+	flagSymbol,
+	flagAddress,
+	flagAmount,
+}
+
+// GetCmdFreezeTokenAmount will create a freeze token amount tx and sign.
+func GetCmdFreezeTokenAmount(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-amount",
+		Short: "Create and sign a freeze token amount tx",
+		Long: strings.TrimSpace(
+			`Create and sign a freeze token amount tx, broadcast to nodes.
+The token owner freezes a part of an address's balance, which the address can not send
+or use to create orders until it is unfrozen. The token must be addr forbiddable.
+
+Example:
+$ cetcli tx asset freeze-amount --symbol="abc" \
+	--address=coinex1... \
+	--amount=10000000000000000 \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseFreezeTokenAmountFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token will be frozen")
+	cmd.Flags().String(flagAddress, "", "the address whose balance will be frozen")
+	cmd.Flags().String(flagAmount, "0", "the amount to freeze")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	for _, flag := range freezeAmountFlags {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+
+	return cmd
+}
+
+// GetCmdUnfreezeTokenAmount will create an unfreeze token amount tx and sign.
+func GetCmdUnfreezeTokenAmount(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze-amount",
+		Short: "Create and sign an unfreeze token amount tx",
+		Long: strings.TrimSpace(
+			`Create and sign an unfreeze token amount tx, broadcast to nodes.
+
+Example:
+$ cetcli tx asset unfreeze-amount --symbol="abc" \
+	--address=coinex1... \
+	--amount=10000000000000000 \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseUnfreezeTokenAmountFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token will be unfrozen")
+	cmd.Flags().String(flagAddress, "", "the address whose balance will be unfrozen")
+	cmd.Flags().String(flagAmount, "0", "the amount to unfreeze")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	for _, flag := range freezeAmountFlags {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+
+	return cmd
+}
+
+// GetCmdRequestSnapshot will create a request token snapshot tx and sign.
+func GetCmdRequestSnapshot(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-snapshot",
+		Short: "Create and sign a request token snapshot tx",
+		Long: strings.TrimSpace(
+			`Create and sign a request token snapshot tx, broadcast to nodes.
+The balances of all the holders of the token, including their locked and frozen coins, are recorded
+at the end of the block, or of a later block if many snapshots are pending. The snapshot fee is charged
+to every requester, and the snapshot is removed some time after it is taken. CET can not be snapshotted.
+
+Example:
+$ cetcli tx asset request-snapshot --symbol="abc" \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseRequestSnapshotFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token`s holders will be recorded")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	for _, flag := range symbolFlags {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+
+	return cmd
+}
+
+var symbolBidFlags = []string{
+	flagSymbol,
+	flagAmount,
+}
+
+// GetCmdOpenSymbolAuction will create an open symbol auction tx and sign.
+func GetCmdOpenSymbolAuction(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-auction",
+		Short: "Create and sign an open symbol auction tx",
+		Long: strings.TrimSpace(
+			`Create and sign an open symbol auction tx, broadcast to nodes.
+Only the reserved symbols and the short symbols which are not issued can be auctioned. The opening bid
+in sato.CET must be no less than the issue fee of the symbol, and it is frozen until someone outbids it.
+When the auction ends, the highest bidder gets the exclusive right to issue the token for a while.
+
+Example:
+$ cetcli tx asset open-auction --symbol="abc" \
+	--amount=1000000000000 \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseOpenSymbolAuctionFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which symbol will be auctioned")
+	cmd.Flags().String(flagAmount, "0", "the opening bid in sato.CET")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	for _, flag := range symbolBidFlags {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+
+	return cmd
+}
+
+// GetCmdBidSymbolAuction will create a bid symbol auction tx and sign.
+func GetCmdBidSymbolAuction(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bid-auction",
+		Short: "Create and sign a bid symbol auction tx",
+		Long: strings.TrimSpace(
+			`Create and sign a bid symbol auction tx, broadcast to nodes.
+The bid in sato.CET must exceed the current highest bid by at least 1 CET. The bid is frozen, and the
+outbid one is returned to its bidder.
+
+Example:
+$ cetcli tx asset bid-auction --symbol="abc" \
+	--amount=1100000000000 \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseBidSymbolAuctionFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which symbol to bid for")
+	cmd.Flags().String(flagAmount, "0", "the bid in sato.CET")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	for _, flag := range symbolBidFlags {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+
+	return cmd
+}
+
+var distributeFlags = []string{
+	flagSymbol,
+	flagDenom,
+	flagAmount,
+}
+
+// GetCmdDistributeToHolders will create a distribute to holders tx and sign.
+func GetCmdDistributeToHolders(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribute",
+		Short: "Create and sign a distribute to holders tx",
+		Long: strings.TrimSpace(
+			`Create and sign a distribute to holders tx, broadcast to nodes.
+The amount of denom is frozen on the token owner, who also pays the snapshot fee, and a snapshot of the
+token holders is taken at the end of the block. Then the amount is paid to the holders pro-rata to their balances in batches across blocks,
+and the rounding dust is returned to the owner. The owner and the excluded addresses are not paid.
+
+Example:
+$ cetcli tx asset distribute --symbol="abc" \
+	--denom="cet" \
+	--amount=100000000000 \
+	--excluded=coinex1gc5t98jap4zyhmhmyq5af5s7pyv57w5694el97 \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseDistributeToHoldersFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token`s holders will be paid")
+	cmd.Flags().String(flagDenom, "", "which token will be paid")
+	cmd.Flags().String(flagAmount, "0", "the total amount to be paid")
+	cmd.Flags().String(flagExcluded, "", "the comma separated addresses which will not be paid")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	for _, flag := range distributeFlags {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+
+	return cmd
+}
@@ -1,11 +1,14 @@
 package cli
 
 import (
+	"io/ioutil"
+	"os"
 	"reflect"
 	"strings"
 	"testing"
 
 	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -27,6 +30,9 @@ func TestTxCmds(t *testing.T) {
 	testTxCmd(t, "burn-token --symbol=abc --amount=10000000000000000",
 		types.NewMsgBurnToken("abc", sdk.NewInt(10000000000000000), nil))
 
+	testTxCmd(t, "holder-burn-token --symbol=abc --amount=10000000000000000 --redemption-memo=addr",
+		types.NewMsgHolderBurnToken("abc", sdk.NewInt(10000000000000000), nil, "addr"))
+
 	testTxCmd(t, "forbid-token --symbol=abc",
 		types.NewMsgForbidToken("abc", nil))
 
@@ -45,10 +51,77 @@ func TestTxCmds(t *testing.T) {
 	testTxCmd(t, "unforbid-addr --symbol=abc --addresses={testAddrBech32}",
 		types.NewMsgUnForbidAddr("abc", nil, []sdk.AccAddress{testAddr}))
 
+	testTxCmd(t, "freeze-amount --symbol=abc --address={testAddrBech32} --amount=100",
+		types.NewMsgFreezeTokenAmount("abc", nil, testAddr, sdk.NewInt(100)))
+
+	testTxCmd(t, "unfreeze-amount --symbol=abc --address={testAddrBech32} --amount=100",
+		types.NewMsgUnfreezeTokenAmount("abc", nil, testAddr, sdk.NewInt(100)))
+
+	testTxCmd(t, "request-snapshot --symbol=abc",
+		types.NewMsgRequestTokenSnapshot("abc", nil))
+
+	testTxCmd(t, "open-auction --symbol=abc --amount=100",
+		types.NewMsgOpenSymbolAuction("abc", nil, sdk.NewInt(100)))
+
+	testTxCmd(t, "bid-auction --symbol=abc --amount=200",
+		types.NewMsgBidSymbolAuction("abc", nil, sdk.NewInt(200)))
+
+	testTxCmd(t, "distribute --symbol=abc --denom=cet --amount=100 --excluded={testAddrBech32}",
+		types.NewMsgDistributeToHolders("abc", nil, "cet", sdk.NewInt(100), []sdk.AccAddress{testAddr}))
+
+	modifyMsg := types.NewMsgModifyTokenInfo("abc", "coinex.org", "cool", "CET", nil,
+		"NewName", "123", "true", "true", "true", "true")
+	modifyMsg.HolderBurnable = "true"
 	testTxCmd(t, "modify-token-info --symbol=abc --url=coinex.org --description=cool --identity=CET"+
-		" --name=NewName --total-supply=123 --mintable=true --burnable=true --addr-forbiddable=true --token-forbiddable=true",
-		types.NewMsgModifyTokenInfo("abc", "coinex.org", "cool", "CET", nil,
-			"NewName", "123", "true", "true", "true", "true"))
+		" --name=NewName --total-supply=123 --mintable=true --burnable=true --addr-forbiddable=true --token-forbiddable=true"+
+		" --holder-burnable=true", modifyMsg)
+
+	metadataMsg := types.NewMsgModifyTokenInfo("abc", "", "", "", nil, "", "", "", "", "", "")
+	metadataMsg.Metadata = &types.TokenMetadata{
+		Display:     "ABC",
+		Decimals:    8,
+		DenomUnits:  []types.DenomUnit{{Denom: "abc", Exponent: 0}, {Denom: "ABC", Exponent: 8}},
+		SocialLinks: []types.SocialLink{{Name: "twitter", URL: "https://twitter.com/abc"}},
+	}
+	testTxCmd(t, "modify-token-info --symbol=abc --display=ABC --decimals=8 --denom-units=abc:0,ABC:8"+
+		" --social-links=twitter=https://twitter.com/abc", metadataMsg)
+
+	testTxCmd(t, "set-mint-schedule --symbol=abc --mint-tranches=1600000000:100:{testAddrBech32},1700000000:200:{testAddrBech32}",
+		types.NewMsgSetMintSchedule("abc", nil, types.MintSchedule{Tranches: []types.MintTranche{
+			{Time: 1600000000, Amount: sdk.NewInt(100), Recipient: testAddr},
+			{Time: 1700000000, Amount: sdk.NewInt(200), Recipient: testAddr},
+		}}))
+
+	testTxCmd(t, "set-mint-schedule --symbol=abc --emission-rate=100 --emission-start=1600000000 --emission-end=1700000000"+
+		" --emission-recipient={testAddrBech32}",
+		types.NewMsgSetMintSchedule("abc", nil, types.MintSchedule{Emission: &types.LinearEmission{
+			Rate: sdk.NewInt(100), StartTime: 1600000000, EndTime: 1700000000, Recipient: testAddr,
+		}}))
+
+	testTxCmd(t, "set-mint-policy --symbol=abc --supply-cap=10000 --window-amount=100 --window-seconds=86400",
+		types.NewMsgSetMintPolicy("abc", nil, types.NewMintPolicy(sdk.NewInt(10000), sdk.NewInt(100), 86400)))
+
+	testTxCmd(t, "set-co-owners --symbol=abc --co-owners={testAddrBech32} --threshold=1",
+		types.NewMsgSetTokenCoOwners("abc", nil, []sdk.AccAddress{testAddr}, 1))
+
+	testTxCmd(t, "approve-action --symbol=abc --proposal-id=3",
+		types.NewMsgApproveTokenAction("abc", 3, nil))
+
+	testTxCmd(t, "accept-ownership --symbol=abc",
+		types.NewMsgAcceptOwnership("abc", nil))
+
+	testTxCmd(t, "cancel-ownership-transfer --symbol=abc",
+		types.NewMsgCancelOwnershipTransfer("abc", nil))
+
+	action := types.NewMsgMintToken("abc", sdk.NewInt(100), testAddr)
+	file, err := ioutil.TempFile("", "action")
+	require.NoError(t, err)
+	defer os.Remove(file.Name())
+	_, err = file.Write(types.ModuleCdc.MustMarshalJSON(action))
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	testTxCmd(t, "propose-action "+file.Name()+" --lifetime=3600",
+		types.NewMsgProposeTokenAction(nil, action, 3600))
 }
 
 func testTxCmd(t *testing.T, args string, expectedMsg interface{}) {
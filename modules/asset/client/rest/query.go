@@ -3,9 +3,11 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/gorilla/mux"
 
@@ -23,6 +25,26 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 	r.HandleFunc("/asset/tokens", QueryTokensRequestHandlerFn(storeName, cliCtx)).Methods("GET")
 	r.HandleFunc("/asset/tokens/{symbol}/forbidden/whitelist", QueryWhitelistRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
 	r.HandleFunc("/asset/tokens/{symbol}/forbidden/addresses", QueryForbiddenAddrRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/mint-schedule", QueryMintScheduleRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/mint-policy", QueryMintPolicyRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/metadata", QueryTokenMetadataRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/co-owners", QueryCoOwnersRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/proposals", QueryProposalsRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/pending-owner", QueryPendingOwnerRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/pending-owners", QueryPendingOwnersRequestHandlerFn(storeName, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/frozen-amounts", QueryFrozenAmountsRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/addresses/{address}/frozen-amounts", QueryAddrFrozenAmountsRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/snapshots", QuerySnapshotsRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/snapshots/{id}", QuerySnapshotRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/snapshots/{id}/balances", QuerySnapshotBalancesRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/holders", QueryTopHoldersRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/holders/count", QueryHolderCountRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/holders/{address}", QueryHolderRankRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/distributions", QueryDistributionsRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/distributions/{id}", QueryDistributionRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/auctions", QuerySymbolAuctionsRequestHandlerFn(storeName, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/auctions/{symbol}", QuerySymbolAuctionRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/reservations/{symbol}", QuerySymbolReservationRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
 	r.HandleFunc("/asset/tokens/reserved/symbols", QueryReservedSymbolsRequestHandlerFn(storeName, cliCtx)).Methods("GET")
 	r.HandleFunc("/asset/parameters", QueryParamsHandlerFn(storeName, cliCtx)).Methods("GET")
 }
@@ -85,6 +107,331 @@ func QueryForbiddenAddrRequestHandlerFn(
 	}
 }
 
+// QueryMintScheduleRequestHandlerFn - query assetREST Handler
+func QueryMintScheduleRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryMintSchedule)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAssetParams(symbol)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONObj)
+	}
+}
+
+// QueryMintPolicyRequestHandlerFn - query assetREST Handler
+func QueryMintPolicyRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryMintPolicy)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAssetParams(symbol)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONObj)
+	}
+}
+
+// QueryTokenMetadataRequestHandlerFn - query assetREST Handler
+func QueryTokenMetadataRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryTokenMetadata)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAssetParams(symbol)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONObj)
+	}
+}
+
+// QueryCoOwnersRequestHandlerFn - query assetREST Handler
+func QueryCoOwnersRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryCoOwners)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAssetParams(symbol)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONObj)
+	}
+}
+
+// QueryProposalsRequestHandlerFn - query assetREST Handler
+func QueryProposalsRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryProposals)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAssetParams(symbol)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONObj)
+	}
+}
+
+// QueryPendingOwnerRequestHandlerFn - query assetREST Handler
+func QueryPendingOwnerRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryPendingOwner)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAssetParams(symbol)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONObj)
+	}
+}
+
+// QueryPendingOwnersRequestHandlerFn - query assetREST Handler
+func QueryPendingOwnersRequestHandlerFn(
+	storeName string, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryPendingOwners)
+		restutil.RestQuery(nil, cliCtx, w, r, route, nil, emptyJSONArr)
+	}
+}
+
+// QueryDistributionsRequestHandlerFn - query assetREST Handler
+func QueryDistributionsRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryDistributions)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAssetParams(symbol)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONArr)
+	}
+}
+
+// QueryDistributionRequestHandlerFn - query assetREST Handler
+func QueryDistributionRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryDistribution)
+		id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryDistributionParams(id)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONObj)
+	}
+}
+
+// QuerySymbolAuctionsRequestHandlerFn - query assetREST Handler
+func QuerySymbolAuctionsRequestHandlerFn(
+	storeName string, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QuerySymbolAuctions)
+		restutil.RestQuery(nil, cliCtx, w, r, route, nil, emptyJSONArr)
+	}
+}
+
+// QuerySymbolAuctionRequestHandlerFn - query assetREST Handler
+func QuerySymbolAuctionRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QuerySymbolAuction)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAssetParams(symbol)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONObj)
+	}
+}
+
+// QuerySymbolReservationRequestHandlerFn - query assetREST Handler
+func QuerySymbolReservationRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QuerySymbolReservation)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAssetParams(symbol)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONObj)
+	}
+}
+
+// QueryFrozenAmountsRequestHandlerFn - query assetREST Handler
+func QueryFrozenAmountsRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryFrozenAmounts)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAssetParams(symbol)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONArr)
+	}
+}
+
+// QueryAddrFrozenAmountsRequestHandlerFn - query assetREST Handler
+func QueryAddrFrozenAmountsRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryAddrFrozen)
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAddrFrozenParams(addr)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONArr)
+	}
+}
+
+// QuerySnapshotsRequestHandlerFn - query assetREST Handler
+func QuerySnapshotsRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QuerySnapshots)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAssetParams(symbol)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONArr)
+	}
+}
+
+// QuerySnapshotRequestHandlerFn - query assetREST Handler
+func QuerySnapshotRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QuerySnapshot)
+		id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQuerySnapshotParams(id)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONObj)
+	}
+}
+
+// QuerySnapshotBalancesRequestHandlerFn - query assetREST Handler
+// format: /asset/snapshots/1/balances?page=1&limit=100
+func QuerySnapshotBalancesRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QuerySnapshotBalance)
+		id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, types.DefaultSnapshotLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQuerySnapshotBalancesParams(id, page, limit)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONArr)
+	}
+}
+
+// QueryHolderCountRequestHandlerFn - query assetREST Handler
+func QueryHolderCountRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryHolderCount)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAssetParams(symbol)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, nil)
+	}
+}
+
+// QueryTopHoldersRequestHandlerFn - query assetREST Handler
+// format: /asset/tokens/abc/holders?page=1&limit=100
+func QueryTopHoldersRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryTopHolders)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, types.DefaultHolderLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryTopHoldersParams(symbol, page, limit)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONArr)
+	}
+}
+
+// QueryHolderRankRequestHandlerFn - query assetREST Handler
+func QueryHolderRankRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryHolderRank)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryHolderRankParams(symbol, addr)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONObj)
+	}
+}
+
 // QueryReservedSymbolsRequestHandlerFn - query assetREST Handler
 func QueryReservedSymbolsRequestHandlerFn(
 	storeName string, cliCtx context.CLIContext,
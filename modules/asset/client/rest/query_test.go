@@ -22,6 +22,21 @@ func TestQuery(t *testing.T) {
 	testQuery(t, "/asset/tokens", "custom/asset/token-list", nil)
 	testQuery(t, "/asset/tokens/abc/forbidden/whitelist", "custom/asset/token-whitelist", types.NewQueryWhitelistParams(testSymbol))
 	testQuery(t, "/asset/tokens/abc/forbidden/addresses", "custom/asset/addr-forbidden", types.NewQueryForbiddenAddrParams(testSymbol))
+	testQuery(t, "/asset/tokens/abc/mint-schedule", "custom/asset/mint-schedule", types.NewQueryAssetParams(testSymbol))
+	testQuery(t, "/asset/tokens/abc/mint-policy", "custom/asset/mint-policy", types.NewQueryAssetParams(testSymbol))
+	testQuery(t, "/asset/tokens/abc/metadata", "custom/asset/token-metadata", types.NewQueryAssetParams(testSymbol))
+	testQuery(t, "/asset/tokens/abc/co-owners", "custom/asset/token-co-owners", types.NewQueryAssetParams(testSymbol))
+	testQuery(t, "/asset/tokens/abc/proposals", "custom/asset/token-proposals", types.NewQueryAssetParams(testSymbol))
+	testQuery(t, "/asset/tokens/abc/pending-owner", "custom/asset/pending-owner", types.NewQueryAssetParams(testSymbol))
+	testQuery(t, "/asset/pending-owners", "custom/asset/pending-owners", nil)
+	testQuery(t, "/asset/tokens/abc/frozen-amounts", "custom/asset/frozen-amounts", types.NewQueryAssetParams(testSymbol))
+	testQuery(t, "/asset/tokens/abc/snapshots", "custom/asset/token-snapshots", types.NewQueryAssetParams(testSymbol))
+	testQuery(t, "/asset/tokens/abc/holders?page=2&limit=10", "custom/asset/top-holders", types.NewQueryTopHoldersParams(testSymbol, 2, 10))
+	testQuery(t, "/asset/tokens/abc/holders/count", "custom/asset/holder-count", types.NewQueryAssetParams(testSymbol))
+	testQuery(t, "/asset/tokens/abc/distributions", "custom/asset/token-distributions", types.NewQueryAssetParams(testSymbol))
+	testQuery(t, "/asset/auctions", "custom/asset/symbol-auctions", nil)
+	testQuery(t, "/asset/auctions/abc", "custom/asset/symbol-auction", types.NewQueryAssetParams(testSymbol))
+	testQuery(t, "/asset/reservations/abc", "custom/asset/symbol-reservation", types.NewQueryAssetParams(testSymbol))
 	testQuery(t, "/asset/tokens/reserved/symbols", "custom/asset/reserved-symbols", nil)
 	testQuery(t, "/asset/parameters", "custom/asset/parameters", nil)
 }
@@ -22,15 +22,29 @@ const (
 func registerTXRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
 	r.HandleFunc("/asset/tokens", issueRequestHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/asset/tokens/{symbol}/ownerships", transferOwnerRequestHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/ownerships/accept", acceptOwnershipHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/ownerships/cancel", cancelOwnershipTransferHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/asset/tokens/{symbol}/mints", mintTokenHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/asset/tokens/{symbol}/burns", burnTokenHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/redemptions", holderBurnTokenHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/asset/tokens/{symbol}/forbids", forbidTokenHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/asset/tokens/{symbol}/unforbids", unForbidTokenHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/asset/tokens/{symbol}/forbidden/whitelist", addWhitelistHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/asset/tokens/{symbol}/unforbidden/whitelist", removeWhitelistHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/asset/tokens/{symbol}/forbidden/addresses", forbidAddrHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/asset/tokens/{symbol}/unforbidden/addresses", unForbidAddrHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/frozen-amounts", freezeAmountHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/unfrozen-amounts", unfreezeAmountHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/snapshots", requestSnapshotHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/asset/tokens/{symbol}/infos", modifyTokenInfoHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/mint-schedule", setMintScheduleHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/mint-policy", setMintPolicyHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/co-owners", setCoOwnersHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/proposals", proposeActionHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/proposals/{proposal_id}/approvals", approveActionHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/distributions", distributeToHoldersHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/auctions/{symbol}", openSymbolAuctionHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/auctions/{symbol}/bids", bidSymbolAuctionHandlerFn(cdc, cliCtx)).Methods("POST")
 }
 
 // issueRequestHandlerFn - http request handler to issue new token.
@@ -65,6 +79,11 @@ func burnTokenHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Handle
 	return restutil.NewRestHandler(cdc, cliCtx, new(burnTokenReq))
 }
 
+// holderBurnTokenHandlerFn - http request handler to burn the tokens of a holder for redemption.
+func holderBurnTokenHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(holderBurnTokenReq))
+}
+
 // forbidTokenHandlerFn - http request handler to forbid token.
 func forbidTokenHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return restutil.NewRestHandler(cdc, cliCtx, new(forbidTokenReq))
@@ -99,3 +118,68 @@ func unForbidAddrHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Han
 func modifyTokenInfoHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return restutil.NewRestHandler(cdc, cliCtx, new(modifyTokenInfoReq))
 }
+
+// setMintScheduleHandlerFn - http request handler to set the mint schedule of a token.
+func setMintScheduleHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(setMintScheduleReq))
+}
+
+// setMintPolicyHandlerFn - http request handler to set the mint policy of a token.
+func setMintPolicyHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(setMintPolicyReq))
+}
+
+// setCoOwnersHandlerFn - http request handler to set the co-owners of a token.
+func setCoOwnersHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(setCoOwnersReq))
+}
+
+// proposeActionHandlerFn - http request handler to propose an action on a token.
+func proposeActionHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(proposeActionReq))
+}
+
+// approveActionHandlerFn - http request handler to approve a proposed action on a token.
+func approveActionHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(approveActionReq))
+}
+
+// acceptOwnershipHandlerFn - http request handler to accept the ownership of a token.
+func acceptOwnershipHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(acceptOwnershipReq))
+}
+
+// freezeAmountHandlerFn - http request handler to freeze a part of the balance of an address.
+func freezeAmountHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(freezeAmountReq))
+}
+
+// unfreezeAmountHandlerFn - http request handler to unfreeze a part of the balance of an address.
+func unfreezeAmountHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(unfreezeAmountReq))
+}
+
+// requestSnapshotHandlerFn - http request handler to request a snapshot of the holders of a token.
+func requestSnapshotHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(requestSnapshotReq))
+}
+
+// distributeToHoldersHandlerFn - http request handler to distribute an amount to the holders of a token.
+func distributeToHoldersHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(distributeToHoldersReq))
+}
+
+// openSymbolAuctionHandlerFn - http request handler to open an auction for a symbol.
+func openSymbolAuctionHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(openSymbolAuctionReq))
+}
+
+// bidSymbolAuctionHandlerFn - http request handler to bid in the auction of a symbol.
+func bidSymbolAuctionHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(bidSymbolAuctionReq))
+}
+
+// cancelOwnershipTransferHandlerFn - http request handler to cancel the ownership transfer of a token.
+func cancelOwnershipTransferHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(cancelOwnershipTransferReq))
+}
@@ -1,7 +1,9 @@
 package rest
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
@@ -15,17 +17,20 @@ import (
 type (
 	// issueReq defines the properties of a issue token request's body
 	issueReq struct {
-		BaseReq          rest.BaseReq `json:"base_req" yaml:"base_req"`
-		Name             string       `json:"name" yaml:"name"`
-		Symbol           string       `json:"symbol" yaml:"symbol"`
-		TotalSupply      string       `json:"total_supply" yaml:"total_supply"`
-		Mintable         bool         `json:"mintable" yaml:"mintable"`
-		Burnable         bool         `json:"burnable" yaml:"burnable"`
-		AddrForbiddable  bool         `json:"addr_forbiddable" yaml:"addr_forbiddable"`
-		TokenForbiddable bool         `json:"token_forbiddable" yaml:"token_forbiddable"`
-		URL              string       `json:"url" yaml:"url"`
-		Description      string       `json:"description" yaml:"description"`
-		Identity         string       `json:"identity" yaml:"identity"`
+		BaseReq          rest.BaseReq        `json:"base_req" yaml:"base_req"`
+		Name             string              `json:"name" yaml:"name"`
+		Symbol           string              `json:"symbol" yaml:"symbol"`
+		TotalSupply      string              `json:"total_supply" yaml:"total_supply"`
+		Mintable         bool                `json:"mintable" yaml:"mintable"`
+		Burnable         bool                `json:"burnable" yaml:"burnable"`
+		AddrForbiddable  bool                `json:"addr_forbiddable" yaml:"addr_forbiddable"`
+		TokenForbiddable bool                `json:"token_forbiddable" yaml:"token_forbiddable"`
+		URL              string              `json:"url" yaml:"url"`
+		Description      string              `json:"description" yaml:"description"`
+		Identity         string              `json:"identity" yaml:"identity"`
+		MintSchedule     *types.MintSchedule `json:"mint_schedule,omitempty" yaml:"mint_schedule,omitempty"`
+		HolderBurnable   bool                `json:"holder_burnable" yaml:"holder_burnable"`
+		Permissioned     bool                `json:"permissioned" yaml:"permissioned"`
 	}
 
 	// transferOwnerReq defines the properties of a transfer ownership request's body.
@@ -34,6 +39,16 @@ type (
 		NewOwner sdk.AccAddress `json:"new_owner" yaml:"new_owner"`
 	}
 
+	// acceptOwnershipReq defines the properties of an accept ownership request's body.
+	acceptOwnershipReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	}
+
+	// cancelOwnershipTransferReq defines the properties of a cancel ownership transfer request's body.
+	cancelOwnershipTransferReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	}
+
 	// mintTokenReq defines the properties of a mint token request's body.
 	mintTokenReq struct {
 		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
@@ -46,6 +61,13 @@ type (
 		Amount  string       `json:"amount" yaml:"amount"`
 	}
 
+	// holderBurnTokenReq defines the properties of a holder burn token request's body.
+	holderBurnTokenReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Amount  string       `json:"amount" yaml:"amount"`
+		Memo    string       `json:"memo" yaml:"memo"`
+	}
+
 	// forbidTokenReq defines the properties of a forbid token request's body.
 	forbidTokenReq struct {
 		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
@@ -71,6 +93,63 @@ type (
 		BaseReq   rest.BaseReq     `json:"base_req" yaml:"base_req"`
 		Addresses []sdk.AccAddress `json:"addresses" yaml:"addresses"`
 	}
+	// the flowing 2 reqs defines the properties of a freeze or unfreeze token amount request's body.
+	freezeAmountReq struct {
+		BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		Address sdk.AccAddress `json:"address" yaml:"address"`
+		Amount  string         `json:"amount" yaml:"amount"`
+	}
+	unfreezeAmountReq struct {
+		BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		Address sdk.AccAddress `json:"address" yaml:"address"`
+		Amount  string         `json:"amount" yaml:"amount"`
+	}
+	// requestSnapshotReq defines the properties of a request token snapshot request's body.
+	requestSnapshotReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	}
+	// distributeToHoldersReq defines the properties of a distribute to holders request's body.
+	distributeToHoldersReq struct {
+		BaseReq  rest.BaseReq     `json:"base_req" yaml:"base_req"`
+		Denom    string           `json:"denom" yaml:"denom"`
+		Amount   string           `json:"amount" yaml:"amount"`
+		Excluded []sdk.AccAddress `json:"excluded" yaml:"excluded"`
+	}
+	// the flowing 2 reqs defines the properties of an open or bid symbol auction request's body.
+	openSymbolAuctionReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Amount  string       `json:"amount" yaml:"amount"`
+	}
+	bidSymbolAuctionReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Amount  string       `json:"amount" yaml:"amount"`
+	}
+	// setMintScheduleReq defines the properties of a set mint schedule request's body.
+	setMintScheduleReq struct {
+		BaseReq  rest.BaseReq       `json:"base_req" yaml:"base_req"`
+		Schedule types.MintSchedule `json:"schedule" yaml:"schedule"`
+	}
+	// setMintPolicyReq defines the properties of a set mint policy request's body.
+	setMintPolicyReq struct {
+		BaseReq rest.BaseReq     `json:"base_req" yaml:"base_req"`
+		Policy  types.MintPolicy `json:"policy" yaml:"policy"`
+	}
+	// setCoOwnersReq defines the properties of a set co-owners request's body.
+	setCoOwnersReq struct {
+		BaseReq   rest.BaseReq     `json:"base_req" yaml:"base_req"`
+		CoOwners  []sdk.AccAddress `json:"co_owners" yaml:"co_owners"`
+		Threshold uint32           `json:"threshold" yaml:"threshold"`
+	}
+	// proposeActionReq defines the properties of a propose token action request's body.
+	proposeActionReq struct {
+		BaseReq  rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Action   sdk.Msg      `json:"action" yaml:"action"`
+		Lifetime int64        `json:"lifetime" yaml:"lifetime"`
+	}
+	// approveActionReq defines the properties of an approve token action request's body.
+	approveActionReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	}
 	// modifyTokenInfoReq defines the properties of a modify token info request's body.
 	modifyTokenInfoReq struct {
 		BaseReq          rest.BaseReq `json:"base_req" yaml:"base_req"`
@@ -83,6 +162,9 @@ type (
 		Burnable         *string      `json:"burnable" yaml:"burnable"`
 		AddrForbiddable  *string      `json:"addr_forbiddable" yaml:"addr_forbiddable"`
 		TokenForbiddable *string      `json:"token_forbiddable" yaml:"token_forbiddable"`
+		HolderBurnable   *string      `json:"holder_burnable,omitempty" yaml:"holder_burnable,omitempty"`
+		// the whole metadata is replaced if it is provided, and an empty one clears it
+		Metadata *types.TokenMetadata `json:"metadata,omitempty" yaml:"metadata,omitempty"`
 	}
 )
 
@@ -97,9 +179,13 @@ func (req *issueReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, err
 	if !ok {
 		return nil, types.ErrInvalidTokenSupply(req.TotalSupply)
 	}
-	return types.NewMsgIssueToken(req.Name, req.Symbol, amt, owner,
+	msg := types.NewMsgIssueToken(req.Name, req.Symbol, amt, owner,
 		req.Mintable, req.Burnable, req.AddrForbiddable, req.TokenForbiddable,
-		req.URL, req.Description, req.Identity), nil
+		req.URL, req.Description, req.Identity)
+	msg.MintSchedule = req.MintSchedule
+	msg.HolderBurnable = req.HolderBurnable
+	msg.Permissioned = req.Permissioned
+	return msg, nil
 }
 
 func (req *transferOwnerReq) New() restutil.RestReq {
@@ -147,6 +233,21 @@ func (req *burnTokenReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg,
 	return types.NewMsgBurnToken(symbol, amt, owner), nil
 }
 
+func (req *holderBurnTokenReq) New() restutil.RestReq {
+	return new(holderBurnTokenReq)
+}
+func (req *holderBurnTokenReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *holderBurnTokenReq) GetMsg(r *http.Request, holder sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	amt, ok := sdk.NewIntFromString(req.Amount)
+	if !ok {
+		return nil, types.ErrInvalidTokenBurnAmt(req.Amount)
+	}
+	return types.NewMsgHolderBurnToken(symbol, amt, holder, req.Memo), nil
+}
+
 func (req *forbidTokenReq) New() restutil.RestReq {
 	return new(forbidTokenReq)
 }
@@ -231,8 +332,181 @@ func (req *modifyTokenInfoReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sd
 	addrForbiddable := getNewTokenInfo(req.AddrForbiddable)
 	tokenForbiddable := getNewTokenInfo(req.TokenForbiddable)
 
-	return types.NewMsgModifyTokenInfo(symbol, url, description, identity, owner,
-		name, supply, mintable, burnable, addrForbiddable, tokenForbiddable), nil
+	msg := types.NewMsgModifyTokenInfo(symbol, url, description, identity, owner,
+		name, supply, mintable, burnable, addrForbiddable, tokenForbiddable)
+	msg.HolderBurnable = getNewTokenInfo(req.HolderBurnable)
+	msg.Metadata = req.Metadata
+	return msg, nil
+}
+
+func (req *setMintScheduleReq) New() restutil.RestReq {
+	return new(setMintScheduleReq)
+}
+func (req *setMintScheduleReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *setMintScheduleReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	return types.NewMsgSetMintSchedule(symbol, owner, req.Schedule), nil
+}
+
+func (req *setMintPolicyReq) New() restutil.RestReq {
+	return new(setMintPolicyReq)
+}
+func (req *setMintPolicyReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *setMintPolicyReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	return types.NewMsgSetMintPolicy(symbol, owner, req.Policy), nil
+}
+
+func (req *setCoOwnersReq) New() restutil.RestReq {
+	return new(setCoOwnersReq)
+}
+func (req *setCoOwnersReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *setCoOwnersReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	return types.NewMsgSetTokenCoOwners(symbol, owner, req.CoOwners, req.Threshold), nil
+}
+
+func (req *proposeActionReq) New() restutil.RestReq {
+	return new(proposeActionReq)
+}
+func (req *proposeActionReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *proposeActionReq) GetMsg(r *http.Request, proposer sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	if actionSymbol, _, ok := types.GetTokenAdminAction(req.Action); !ok || actionSymbol != symbol {
+		return nil, errors.New("the action is not an admin action of token " + symbol)
+	}
+	return types.NewMsgProposeTokenAction(proposer, req.Action, req.Lifetime), nil
+}
+
+func (req *approveActionReq) New() restutil.RestReq {
+	return new(approveActionReq)
+}
+func (req *approveActionReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *approveActionReq) GetMsg(r *http.Request, approver sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	id, err := strconv.ParseUint(mux.Vars(r)["proposal_id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewMsgApproveTokenAction(symbol, id, approver), nil
+}
+
+func (req *acceptOwnershipReq) New() restutil.RestReq {
+	return new(acceptOwnershipReq)
+}
+func (req *acceptOwnershipReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *acceptOwnershipReq) GetMsg(r *http.Request, newOwner sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	return types.NewMsgAcceptOwnership(symbol, newOwner), nil
+}
+
+func (req *cancelOwnershipTransferReq) New() restutil.RestReq {
+	return new(cancelOwnershipTransferReq)
+}
+func (req *cancelOwnershipTransferReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *cancelOwnershipTransferReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	return types.NewMsgCancelOwnershipTransfer(symbol, owner), nil
+}
+
+func (req *freezeAmountReq) New() restutil.RestReq {
+	return new(freezeAmountReq)
+}
+func (req *freezeAmountReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *freezeAmountReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	amt, ok := sdk.NewIntFromString(req.Amount)
+	if !ok {
+		return nil, types.ErrInvalidFreezeAmount(req.Amount)
+	}
+	return types.NewMsgFreezeTokenAmount(symbol, owner, req.Address, amt), nil
+}
+
+func (req *unfreezeAmountReq) New() restutil.RestReq {
+	return new(unfreezeAmountReq)
+}
+func (req *unfreezeAmountReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *unfreezeAmountReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	amt, ok := sdk.NewIntFromString(req.Amount)
+	if !ok {
+		return nil, types.ErrInvalidFreezeAmount(req.Amount)
+	}
+	return types.NewMsgUnfreezeTokenAmount(symbol, owner, req.Address, amt), nil
+}
+
+func (req *requestSnapshotReq) New() restutil.RestReq {
+	return new(requestSnapshotReq)
+}
+func (req *requestSnapshotReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *requestSnapshotReq) GetMsg(r *http.Request, requester sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	return types.NewMsgRequestTokenSnapshot(symbol, requester), nil
+}
+
+func (req *distributeToHoldersReq) New() restutil.RestReq {
+	return new(distributeToHoldersReq)
+}
+func (req *distributeToHoldersReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *distributeToHoldersReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	amt, ok := sdk.NewIntFromString(req.Amount)
+	if !ok {
+		return nil, types.ErrInvalidDistributionAmount(req.Amount)
+	}
+	return types.NewMsgDistributeToHolders(symbol, owner, req.Denom, amt, req.Excluded), nil
+}
+
+func (req *openSymbolAuctionReq) New() restutil.RestReq {
+	return new(openSymbolAuctionReq)
+}
+func (req *openSymbolAuctionReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *openSymbolAuctionReq) GetMsg(r *http.Request, bidder sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	amt, ok := sdk.NewIntFromString(req.Amount)
+	if !ok {
+		return nil, types.ErrInvalidSymbolBid(req.Amount)
+	}
+	return types.NewMsgOpenSymbolAuction(symbol, bidder, amt), nil
+}
+
+func (req *bidSymbolAuctionReq) New() restutil.RestReq {
+	return new(bidSymbolAuctionReq)
+}
+func (req *bidSymbolAuctionReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *bidSymbolAuctionReq) GetMsg(r *http.Request, bidder sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	amt, ok := sdk.NewIntFromString(req.Amount)
+	if !ok {
+		return nil, types.ErrInvalidSymbolBid(req.Amount)
+	}
+	return types.NewMsgBidSymbolAuction(symbol, bidder, amt), nil
 }
 
 func getNewTokenInfo(ptr *string) string {
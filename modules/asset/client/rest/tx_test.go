@@ -19,13 +19,27 @@ func TestTx(t *testing.T) {
 	testTx(t, "/asset/tokens/abc/ownerships", "*rest.transferOwnerReq")
 	testTx(t, "/asset/tokens/abc/mints", "*rest.mintTokenReq")
 	testTx(t, "/asset/tokens/abc/burns", "*rest.burnTokenReq")
+	testTx(t, "/asset/tokens/abc/redemptions", "*rest.holderBurnTokenReq")
 	testTx(t, "/asset/tokens/abc/forbids", "*rest.forbidTokenReq")
 	testTx(t, "/asset/tokens/abc/unforbids", "*rest.unForbidTokenReq")
 	testTx(t, "/asset/tokens/abc/forbidden/whitelist", "*rest.addWhiteListReq")
 	testTx(t, "/asset/tokens/abc/unforbidden/whitelist", "*rest.removeWhiteListReq")
 	testTx(t, "/asset/tokens/abc/forbidden/addresses", "*rest.forbidAddrReq")
 	testTx(t, "/asset/tokens/abc/unforbidden/addresses", "*rest.unforbidAddrReq")
+	testTx(t, "/asset/tokens/abc/frozen-amounts", "*rest.freezeAmountReq")
+	testTx(t, "/asset/tokens/abc/unfrozen-amounts", "*rest.unfreezeAmountReq")
+	testTx(t, "/asset/tokens/abc/snapshots", "*rest.requestSnapshotReq")
 	testTx(t, "/asset/tokens/abc/infos", "*rest.modifyTokenInfoReq")
+	testTx(t, "/asset/tokens/abc/mint-schedule", "*rest.setMintScheduleReq")
+	testTx(t, "/asset/tokens/abc/mint-policy", "*rest.setMintPolicyReq")
+	testTx(t, "/asset/tokens/abc/co-owners", "*rest.setCoOwnersReq")
+	testTx(t, "/asset/tokens/abc/proposals", "*rest.proposeActionReq")
+	testTx(t, "/asset/tokens/abc/proposals/1/approvals", "*rest.approveActionReq")
+	testTx(t, "/asset/tokens/abc/ownerships/accept", "*rest.acceptOwnershipReq")
+	testTx(t, "/asset/tokens/abc/ownerships/cancel", "*rest.cancelOwnershipTransferReq")
+	testTx(t, "/asset/tokens/abc/distributions", "*rest.distributeToHoldersReq")
+	testTx(t, "/asset/auctions/abc", "*rest.openSymbolAuctionReq")
+	testTx(t, "/asset/auctions/abc/bids", "*rest.bidSymbolAuctionReq")
 }
 
 func testTx(t *testing.T, restPath string, expectedReqType string) {
@@ -0,0 +1,114 @@
+package asset
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// EndBlocker removes the expired co-owner proposals and ownership transfers, executes the due mint
+// schedules, takes a bounded batch of the pending snapshots and removes the expired ones, pays a batch
+// of the pending distributions, settles the ended symbol auctions and removes the expired symbol
+// reservations. A mint schedule which fails is stopped.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	for _, p := range k.RemoveExpiredTokenActionProposals(ctx) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireTokenAction,
+				sdk.NewAttribute(types.AttributeKeySymbol, p.Symbol),
+				sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(p.ID, 10)),
+				sdk.NewAttribute(types.AttributeKeyAction, p.Action.Type()),
+			),
+		)
+	}
+	for _, transfer := range k.RemoveExpiredOwnershipTransfers(ctx) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireOwnerTransfer,
+				sdk.NewAttribute(types.AttributeKeySymbol, transfer.Symbol),
+				sdk.NewAttribute(types.AttributeKeyPendingOwner, transfer.NewOwner.String()),
+			),
+		)
+		fillMsgQueue(ctx, k, types.KafkaExpireOwnerTransfer, transfer)
+	}
+
+	for _, info := range k.GetDueMintSchedules(ctx) {
+		cacheCtx, write := ctx.CacheContext()
+		mints, err := k.ExecuteMintSchedule(cacheCtx, info)
+		if err != nil {
+			ctx.Logger().Error("stop mint schedule", "symbol", info.Symbol, "error", err.Error())
+			k.StopMintSchedule(ctx, info)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeStopMintSchedule,
+					sdk.NewAttribute(types.AttributeKeySymbol, info.Symbol),
+					sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+				),
+			)
+			continue
+		}
+		write()
+		for _, mint := range mints {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeScheduledMint,
+					sdk.NewAttribute(types.AttributeKeySymbol, info.Symbol),
+					sdk.NewAttribute(types.AttributeKeyRecipient, mint.Recipient.String()),
+					sdk.NewAttribute(types.AttributeKeyAmount, mint.Amount.String()),
+				),
+			)
+		}
+	}
+
+	for _, snapshot := range k.TakePendingSnapshots(ctx, types.SnapshotEntriesPerBlock) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTakeSnapshot,
+				sdk.NewAttribute(types.AttributeKeySymbol, snapshot.Symbol),
+				sdk.NewAttribute(types.AttributeKeySnapshotID, strconv.FormatUint(snapshot.ID, 10)),
+				sdk.NewAttribute(types.AttributeKeyHolders, strconv.FormatUint(snapshot.Holders, 10)),
+			),
+		)
+		fillMsgQueue(ctx, k, types.KafkaTakeSnapshot, snapshot)
+	}
+	k.RemoveExpiredSnapshots(ctx, types.SnapshotEntriesPerBlock)
+	for _, d := range k.ProcessDistributions(ctx, types.DistributionEntriesPerBlock) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSettleDistribution,
+				sdk.NewAttribute(types.AttributeKeySymbol, d.Symbol),
+				sdk.NewAttribute(types.AttributeKeyDistributionID, strconv.FormatUint(d.ID, 10)),
+				sdk.NewAttribute(types.AttributeKeyDenom, d.Denom),
+				sdk.NewAttribute(types.AttributeKeyDistributed, d.Distributed.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipients, strconv.FormatUint(d.Recipients, 10)),
+				sdk.NewAttribute(types.AttributeKeyDust, d.Dust().String()),
+			),
+		)
+		fillMsgQueue(ctx, k, types.KafkaSettleDistribution, d)
+	}
+
+	for _, r := range k.SettleEndedSymbolAuctions(ctx) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSettleSymbolAuction,
+				sdk.NewAttribute(types.AttributeKeySymbol, r.Symbol),
+				sdk.NewAttribute(types.AttributeKeyWinner, r.Winner.String()),
+				sdk.NewAttribute(types.AttributeKeyBid, r.Price.String()),
+				sdk.NewAttribute(types.AttributeKeyDeadline, strconv.FormatInt(r.Deadline, 10)),
+			),
+		)
+		fillMsgQueue(ctx, k, types.KafkaSettleSymbolAuction, r)
+	}
+	for _, r := range k.RemoveExpiredSymbolReservations(ctx) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireReservation,
+				sdk.NewAttribute(types.AttributeKeySymbol, r.Symbol),
+				sdk.NewAttribute(types.AttributeKeyWinner, r.Winner.String()),
+			),
+		)
+		fillMsgQueue(ctx, k, types.KafkaExpireReservation, r)
+	}
+}
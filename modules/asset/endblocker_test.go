@@ -0,0 +1,236 @@
+package asset_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func TestEndBlocker_MintSchedule(t *testing.T) {
+	input := createTestInput()
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+	symbol := "abc"
+	recipient := mockAddrList()[0]
+	h := asset.NewHandler(input.tk)
+
+	// issue token with a mint schedule
+	msgIssue := asset.NewMsgIssueToken("ABC Token", symbol, sdk.NewInt(2100), testAddr,
+		true, true, false, false, "", "", types.TestIdentityString)
+	msgIssue.MintSchedule = &asset.MintSchedule{Tranches: []asset.MintTranche{
+		{Time: 2000, Amount: sdk.NewInt(100), Recipient: recipient},
+		{Time: 3000, Amount: sdk.NewInt(200), Recipient: recipient},
+	}}
+	err := input.tk.AddToken(ctx, testAddr, dex.NewCetCoins(1e18))
+	require.NoError(t, err)
+	res := h(ctx, msgIssue)
+	require.True(t, res.IsOK())
+
+	// ad-hoc mint is forbidden
+	res = h(ctx, asset.NewMsgMintToken(symbol, sdk.NewInt(100), testAddr))
+	require.Equal(t, types.CodeTokenMintScheduled, res.Code)
+
+	asset.EndBlocker(ctx, input.tk)
+	require.True(t, input.tk.GetAccTotalToken(ctx, recipient).AmountOf(symbol).IsZero())
+
+	ctx = ctx.WithBlockTime(time.Unix(3000, 0)).WithEventManager(sdk.NewEventManager())
+	asset.EndBlocker(ctx, input.tk)
+	require.Equal(t, sdk.NewInt(300), input.tk.GetAccTotalToken(ctx, recipient).AmountOf(symbol))
+	require.Equal(t, 2, len(ctx.EventManager().Events()))
+	require.Equal(t, types.EventTypeScheduledMint, ctx.EventManager().Events()[0].Type)
+
+	token := input.tk.GetToken(ctx, symbol)
+	require.Equal(t, sdk.NewInt(2400), token.GetTotalSupply())
+	require.True(t, token.GetScheduledSupply().IsZero())
+
+	// the finished schedule is exported with the token
+	state := asset.ExportGenesis(ctx, input.tk)
+	require.Equal(t, 1, len(state.MintSchedules))
+	require.NoError(t, asset.ValidateGenesis(state))
+}
+
+func TestEndBlocker_TakeSnapshot(t *testing.T) {
+	input := createTestInput()
+	ctx := input.ctx.WithBlockHeight(10)
+	symbol := "abc"
+	holder := mockAddrList()[0]
+	h := asset.NewHandler(input.tk)
+
+	err := input.tk.AddToken(ctx, testAddr, dex.NewCetCoins(1e18))
+	require.NoError(t, err)
+	res := h(ctx, asset.NewMsgIssueToken("ABC Token", symbol, sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString))
+	require.True(t, res.IsOK())
+	err = input.tk.AddToken(ctx, holder, types.NewTokenCoins(symbol, sdk.NewInt(100)))
+	require.NoError(t, err)
+
+	res = h(ctx, asset.NewMsgRequestTokenSnapshot(symbol, testAddr))
+	require.True(t, res.IsOK())
+	event := res.Events[len(res.Events)-1]
+	require.Equal(t, types.EventTypeRequestSnapshot, event.Type)
+	require.Equal(t, "1", string(event.Attributes[1].Value))
+	res = h(ctx, asset.NewMsgRequestTokenSnapshot("xyz", testAddr))
+	require.Equal(t, types.CodeTokenNotFound, res.Code)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	asset.EndBlocker(ctx, input.tk)
+	events := ctx.EventManager().Events()
+	require.Equal(t, 1, len(events))
+	require.Equal(t, types.EventTypeTakeSnapshot, events[0].Type)
+	require.Equal(t, "2", string(events[0].Attributes[2].Value))
+
+	snapshot := input.tk.GetTokenSnapshot(ctx, 1)
+	require.Equal(t, int64(10), snapshot.Height)
+	require.Equal(t, uint64(2), snapshot.Holders)
+
+	// the snapshot is taken only once
+	ctx = ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	asset.EndBlocker(ctx, input.tk)
+	require.Equal(t, 0, len(ctx.EventManager().Events()))
+	require.Equal(t, int64(10), input.tk.GetTokenSnapshot(ctx, 1).Height)
+
+	// the snapshot and its balances are exported
+	state := asset.ExportGenesis(ctx, input.tk)
+	require.Equal(t, 1, len(state.Snapshots))
+	require.Equal(t, 2, len(state.SnapshotBalances))
+	require.NoError(t, asset.ValidateGenesis(state))
+	state.SnapshotBalances = state.SnapshotBalances[:1]
+	require.Error(t, asset.ValidateGenesis(state))
+}
+
+func TestEndBlocker_SettleSymbolAuction(t *testing.T) {
+	input := createTestInput()
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+	symbol := "abc"
+	winner := mockAddrList()[0]
+	h := asset.NewHandler(input.tk)
+
+	err := input.tk.AddToken(ctx, testAddr, dex.NewCetCoins(1e18))
+	require.NoError(t, err)
+	res := h(ctx, asset.NewMsgIssueToken("CET Token", dex.CET, sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString))
+	require.True(t, res.IsOK())
+	bid := sdk.NewInt(input.tk.GetParams(ctx).GetIssueTokenFee(symbol))
+	err = input.tk.AddToken(ctx, winner, types.NewTokenCoins(dex.CET, bid))
+	require.NoError(t, err)
+
+	res = h(ctx, asset.NewMsgOpenSymbolAuction(symbol, winner, bid))
+	require.True(t, res.IsOK())
+	event := res.Events[len(res.Events)-1]
+	require.Equal(t, types.EventTypeOpenSymbolAuction, event.Type)
+	res = h(ctx, asset.NewMsgOpenSymbolAuction("xyz", testAddr, bid))
+	require.True(t, res.IsOK())
+	res = h(ctx, asset.NewMsgBidSymbolAuction(symbol, testAddr, bid))
+	require.Equal(t, types.CodeSymbolBidTooLow, res.Code)
+
+	ctx = ctx.WithBlockTime(time.Unix(1000+types.SymbolAuctionDuration, 0)).WithEventManager(sdk.NewEventManager())
+	asset.EndBlocker(ctx, input.tk)
+	events := ctx.EventManager().Events()
+	require.Equal(t, 2, len(events))
+	require.Equal(t, types.EventTypeSettleSymbolAuction, events[0].Type)
+	require.Equal(t, 0, len(input.tk.GetAllSymbolAuctions(ctx)))
+	require.Equal(t, 2, len(input.tk.GetAllSymbolReservations(ctx)))
+
+	// the winner has paid for the symbol and issues the token without the issue fee
+	require.True(t, input.tk.GetAccTotalToken(ctx, winner).AmountOf(dex.CET).IsZero())
+	res = h(ctx, asset.NewMsgIssueToken("ABC Token", symbol, sdk.NewInt(2100), winner,
+		false, false, false, false, "", "", types.TestIdentityString))
+	require.True(t, res.IsOK())
+	require.Nil(t, input.tk.GetSymbolReservation(ctx, symbol))
+
+	// the reservation not used before the deadline expires
+	deadline := int64(1000 + types.SymbolAuctionDuration + types.SymbolReservationTimeout)
+	ctx = ctx.WithBlockTime(time.Unix(deadline, 0)).WithEventManager(sdk.NewEventManager())
+	asset.EndBlocker(ctx, input.tk)
+	events = ctx.EventManager().Events()
+	require.Equal(t, 1, len(events))
+	require.Equal(t, types.EventTypeExpireReservation, events[0].Type)
+	require.Equal(t, 0, len(input.tk.GetAllSymbolReservations(ctx)))
+}
+
+func TestEndBlocker_DistributeToHolders(t *testing.T) {
+	input := createTestInput()
+	ctx := input.ctx.WithBlockHeight(10)
+	symbol := "abc"
+	holder := mockAddrList()[0]
+	h := asset.NewHandler(input.tk)
+
+	err := input.tk.AddToken(ctx, testAddr, dex.NewCetCoins(1e18))
+	require.NoError(t, err)
+	res := h(ctx, asset.NewMsgIssueToken("ABC Token", symbol, sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString))
+	require.True(t, res.IsOK())
+	err = input.tk.AddToken(ctx, holder, types.NewTokenCoins(symbol, sdk.NewInt(100)))
+	require.NoError(t, err)
+
+	res = h(ctx, asset.NewMsgDistributeToHolders(symbol, holder, symbol, sdk.NewInt(50), nil))
+	require.Equal(t, types.CodeNeedTokenOwner, res.Code)
+	res = h(ctx, asset.NewMsgDistributeToHolders(symbol, testAddr, symbol, sdk.NewInt(50), []sdk.AccAddress{testAddr}))
+	require.True(t, res.IsOK())
+	event := res.Events[len(res.Events)-1]
+	require.Equal(t, types.EventTypeDistributeToHolders, event.Type)
+
+	// the snapshot is taken and the distribution is settled in the same block
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	asset.EndBlocker(ctx, input.tk)
+	events := ctx.EventManager().Events()
+	require.Equal(t, 2, len(events))
+	require.Equal(t, types.EventTypeTakeSnapshot, events[0].Type)
+	require.Equal(t, types.EventTypeSettleDistribution, events[1].Type)
+	require.Equal(t, sdk.NewInt(150), input.tk.GetAccTotalToken(ctx, holder).AmountOf(symbol))
+	require.True(t, input.tk.GetDistribution(ctx, 1).Settled())
+
+	// the distribution is exported with its snapshot
+	state := asset.ExportGenesis(ctx, input.tk)
+	require.Equal(t, 1, len(state.Distributions))
+	require.NoError(t, asset.ValidateGenesis(state))
+	// the snapshot of a settled distribution may have expired, but a pending one needs its snapshot
+	state.Snapshots = nil
+	state.SnapshotBalances = nil
+	require.NoError(t, asset.ValidateGenesis(state))
+	state.Distributions[0].SettleHeight = 0
+	require.Error(t, asset.ValidateGenesis(state))
+}
+
+func TestEndBlocker_StopMintSchedule(t *testing.T) {
+	input := createTestInput()
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+	symbol := "abc"
+	recipient := mockAddrList()[0]
+
+	err := input.tk.IssueToken(ctx, "ABC token", symbol, sdk.NewInt(2100), testAddr,
+		true, false, true, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+	err = input.tk.SetMintSchedule(ctx, symbol, testAddr, asset.MintSchedule{Tranches: []asset.MintTranche{
+		{Time: 2000, Amount: sdk.NewInt(100), Recipient: recipient},
+		{Time: 3000, Amount: sdk.NewInt(200), Recipient: recipient},
+	}})
+	require.NoError(t, err)
+	require.Empty(t, input.tk.GetDueMintSchedules(ctx))
+
+	// nothing is minted to a forbidden recipient, and the schedule is stopped
+	err = input.tk.ForbidAddress(ctx, symbol, testAddr, []sdk.AccAddress{recipient})
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0)).WithEventManager(sdk.NewEventManager())
+	require.Equal(t, 1, len(input.tk.GetDueMintSchedules(ctx)))
+	asset.EndBlocker(ctx, input.tk)
+	require.True(t, input.tk.GetAccTotalToken(ctx, recipient).AmountOf(symbol).IsZero())
+	require.Equal(t, types.EventTypeStopMintSchedule, ctx.EventManager().Events()[0].Type)
+	require.True(t, input.tk.GetMintSchedule(ctx, symbol).Stopped)
+	require.True(t, input.tk.GetToken(ctx, symbol).GetScheduledSupply().IsZero())
+
+	// the stopped schedule is never tried again
+	err = input.tk.UnForbidAddress(ctx, symbol, testAddr, []sdk.AccAddress{recipient})
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(time.Unix(3000, 0))
+	require.Empty(t, input.tk.GetDueMintSchedules(ctx))
+	asset.EndBlocker(ctx, input.tk)
+	require.True(t, input.tk.GetAccTotalToken(ctx, recipient).AmountOf(symbol).IsZero())
+	require.Equal(t, sdk.NewInt(2100), input.tk.GetToken(ctx, symbol).GetTotalSupply())
+}
@@ -28,6 +28,56 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 			panic(err)
 		}
 	}
+	for _, info := range data.MintSchedules {
+		keeper.ImportGenesisMintSchedule(ctx, info)
+	}
+	for _, c := range data.CoOwners {
+		keeper.ImportGenesisTokenCoOwners(ctx, c)
+	}
+	var maxProposalID uint64
+	for _, p := range data.Proposals {
+		keeper.SetTokenActionProposal(ctx, p)
+		if p.ID > maxProposalID {
+			maxProposalID = p.ID
+		}
+	}
+	keeper.SetNextTokenActionProposalID(ctx, maxProposalID+1)
+	for _, transfer := range data.PendingTransfers {
+		keeper.SetPendingOwnershipTransfer(ctx, transfer)
+	}
+	for _, f := range data.FrozenAmounts {
+		keeper.SetIssuerFrozenAmount(ctx, f)
+	}
+	var maxSnapshotID uint64
+	for _, s := range data.Snapshots {
+		keeper.ImportGenesisTokenSnapshot(ctx, s)
+		if s.ID > maxSnapshotID {
+			maxSnapshotID = s.ID
+		}
+	}
+	keeper.SetNextSnapshotID(ctx, maxSnapshotID+1)
+	for _, b := range data.SnapshotBalances {
+		keeper.SetSnapshotBalance(ctx, b)
+	}
+	for _, auction := range data.SymbolAuctions {
+		keeper.SetSymbolAuction(ctx, auction)
+	}
+	for _, r := range data.SymbolReservations {
+		keeper.SetSymbolReservation(ctx, r)
+	}
+	var maxDistributionID uint64
+	for _, d := range data.Distributions {
+		keeper.ImportGenesisDistribution(ctx, d)
+		if d.ID > maxDistributionID {
+			maxDistributionID = d.ID
+		}
+	}
+	keeper.SetNextDistributionID(ctx, maxDistributionID+1)
+	for _, r := range data.MintRecords {
+		keeper.SetMintRecord(ctx, r)
+	}
+	// the holder index is not exported, it is rebuilt from the accounts imported before
+	keeper.RebuildHolderIndex(ctx)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
@@ -36,7 +86,18 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 		keeper.GetParams(ctx),
 		keeper.GetAllTokens(ctx),
 		keeper.ExportGenesisAddrKeys(ctx, types.WhitelistKey),
-		keeper.ExportGenesisAddrKeys(ctx, types.ForbiddenAddrKey))
+		keeper.ExportGenesisAddrKeys(ctx, types.ForbiddenAddrKey),
+		keeper.GetAllMintSchedules(ctx),
+		keeper.GetAllTokenCoOwners(ctx),
+		keeper.GetAllTokenActionProposals(ctx),
+		keeper.GetAllPendingOwnershipTransfers(ctx),
+		keeper.GetAllIssuerFrozenAmounts(ctx),
+		keeper.GetAllTokenSnapshots(ctx),
+		keeper.GetAllSnapshotBalances(ctx),
+		keeper.GetAllSymbolAuctions(ctx),
+		keeper.GetAllSymbolReservations(ctx),
+		keeper.GetAllDistributions(ctx),
+		keeper.GetAllMintRecords(ctx))
 }
 
 // ValidateGenesis performs basic validation of asset genesis data returning an
@@ -74,6 +135,179 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	for _, info := range data.MintSchedules {
+		if err := info.Validate(); err != nil {
+			return err
+		}
+		token, exists := tokenSymbols[info.Symbol]
+		if !exists {
+			return errors.New("mint schedule of unknown token found in GenesisState")
+		}
+		if !token.GetMintScheduled() {
+			return errors.New("mint schedule of unscheduled token found in GenesisState")
+		}
+		if token.GetMintPolicy() != nil {
+			return errors.New("mint schedule of token with mint policy found in GenesisState")
+		}
+	}
+
+	coOwners := make(map[string]TokenCoOwners)
+	for _, c := range data.CoOwners {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+		if _, exists := tokenSymbols[c.Symbol]; !exists {
+			return errors.New("co-owners of unknown token found in GenesisState")
+		}
+		if _, exists := coOwners[c.Symbol]; exists {
+			return errors.New("duplicate co-owners found in GenesisState")
+		}
+		coOwners[c.Symbol] = c
+	}
+
+	proposalIDs := make(map[uint64]bool)
+	for _, p := range data.Proposals {
+		if proposalIDs[p.ID] {
+			return errors.New("duplicate proposal id found in GenesisState")
+		}
+		proposalIDs[p.ID] = true
+		if _, exists := coOwners[p.Symbol]; !exists {
+			return errors.New("proposal of token without co-owners found in GenesisState")
+		}
+		if p.Action == nil {
+			return errors.New("proposal without action found in GenesisState")
+		}
+		if symbol, _, ok := types.GetTokenAdminAction(p.Action); !ok || symbol != p.Symbol {
+			return errors.New("proposal with invalid action found in GenesisState")
+		}
+	}
+
+	pendingTransfers := make(map[string]bool)
+	for _, transfer := range data.PendingTransfers {
+		if err := transfer.Validate(); err != nil {
+			return err
+		}
+		if _, exists := tokenSymbols[transfer.Symbol]; !exists {
+			return errors.New("ownership transfer of unknown token found in GenesisState")
+		}
+		if pendingTransfers[transfer.Symbol] {
+			return errors.New("duplicate ownership transfer found in GenesisState")
+		}
+		pendingTransfers[transfer.Symbol] = true
+	}
+
+	frozenAmounts := make(map[string]bool)
+	for _, f := range data.FrozenAmounts {
+		if err := f.Validate(); err != nil {
+			return err
+		}
+		token, exists := tokenSymbols[f.Symbol]
+		if !exists {
+			return errors.New("frozen amount of unknown token found in GenesisState")
+		}
+		if !token.GetAddrForbiddable() {
+			return errors.New("frozen amount of token which is not addr forbiddable found in GenesisState")
+		}
+		if f.Address.Equals(token.GetOwner()) {
+			return types.ErrTokenOwnerSelfForbidden()
+		}
+		key := string(types.GetIssuerFrozenStoreKey(f.Symbol, f.Address))
+		if frozenAmounts[key] {
+			return errors.New("duplicate frozen amount found in GenesisState")
+		}
+		frozenAmounts[key] = true
+	}
+
+	snapshots := make(map[uint64]types.TokenSnapshot)
+	for _, s := range data.Snapshots {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		if _, exists := snapshots[s.ID]; exists {
+			return errors.New("duplicate snapshot id found in GenesisState")
+		}
+		snapshots[s.ID] = s
+	}
+
+	snapshotBalances := make(map[string]bool)
+	holders := make(map[uint64]uint64)
+	for _, b := range data.SnapshotBalances {
+		if err := b.Validate(); err != nil {
+			return err
+		}
+		if s, exists := snapshots[b.SnapshotID]; !exists || !s.Taken() {
+			return errors.New("balance of unknown or pending snapshot found in GenesisState")
+		}
+		key := string(types.GetSnapshotBalanceStoreKey(b.SnapshotID, b.Address))
+		if snapshotBalances[key] {
+			return errors.New("duplicate snapshot balance found in GenesisState")
+		}
+		snapshotBalances[key] = true
+		holders[b.SnapshotID]++
+	}
+	for id, s := range snapshots {
+		if holders[id] != s.Holders {
+			return errors.New("snapshot holders mismatch its balances in GenesisState")
+		}
+	}
+
+	auctionedSymbols := make(map[string]bool)
+	for _, auction := range data.SymbolAuctions {
+		if err := auction.Validate(); err != nil {
+			return err
+		}
+		if _, exists := tokenSymbols[auction.Symbol]; exists {
+			return errors.New("auction of issued symbol found in GenesisState")
+		}
+		if auctionedSymbols[auction.Symbol] {
+			return errors.New("duplicate symbol auction found in GenesisState")
+		}
+		auctionedSymbols[auction.Symbol] = true
+	}
+	for _, r := range data.SymbolReservations {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		if _, exists := tokenSymbols[r.Symbol]; exists {
+			return errors.New("reservation of issued symbol found in GenesisState")
+		}
+		if auctionedSymbols[r.Symbol] {
+			return errors.New("duplicate symbol auction or reservation found in GenesisState")
+		}
+		auctionedSymbols[r.Symbol] = true
+	}
+
+	distributions := make(map[uint64]bool)
+	for _, d := range data.Distributions {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+		// the snapshot of a settled distribution may have been removed after it expired
+		if s, exists := snapshots[d.SnapshotID]; (!exists && !d.Settled()) || (exists && s.Symbol != d.Symbol) {
+			return errors.New("distribution of unknown snapshot found in GenesisState")
+		}
+		if distributions[d.ID] {
+			return errors.New("duplicate distribution id found in GenesisState")
+		}
+		distributions[d.ID] = true
+	}
+
+	mintRecords := make(map[string]bool)
+	for _, r := range data.MintRecords {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		token, exists := tokenSymbols[r.Symbol]
+		if !exists || token.GetMintPolicy() == nil || !token.GetMintPolicy().HasRateLimit() {
+			return errors.New("mint record of token without rate limit found in GenesisState")
+		}
+		key := string(types.GetMintRecordStoreKey(r.Symbol, r.Time))
+		if mintRecords[key] {
+			return errors.New("duplicate mint record found in GenesisState")
+		}
+		mintRecords[key] = true
+	}
+
 	for _, addr := range data.ForbiddenAddresses {
 		// symbol | : | address
 		split := strings.SplitAfterN(addr, string(types.SeparateKey), 2)
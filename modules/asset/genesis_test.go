@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/coinexchain/cet-sdk/modules/asset"
+	dex "github.com/coinexchain/cet-sdk/types"
 )
 
 func TestGenesis(t *testing.T) {
@@ -45,6 +46,9 @@ func TestGenesis(t *testing.T) {
 		TotalMint:        sdk.ZeroInt(),
 		IsForbidden:      false,
 		Identity:         asset.TestIdentityString,
+		Metadata: &asset.TokenMetadata{Display: "ABC", Decimals: 8,
+			DenomUnits: []asset.DenomUnit{{Denom: "abc", Exponent: 0}, {Denom: "ABC", Exponent: 8}}},
+		MintPolicy: &asset.MintPolicy{SupplyCap: sdk.NewInt(1e18), WindowAmount: sdk.NewInt(1e8), WindowSeconds: 3600},
 	}
 	abcDump := &asset.BaseToken{
 		Name:             "ABC Chain Native Token",
@@ -86,6 +90,25 @@ func TestGenesis(t *testing.T) {
 	forbiddenList := []string{"abc:coinex1p9ek7d3r9z4l288v4lrkwwrnh9k5htezk2q68g"}
 	state.ForbiddenAddresses = append(state.ForbiddenAddresses, forbiddenList...)
 
+	frozenAddr, _ := sdk.AccAddressFromBech32("coinex1y5kdxnzn2tfwayyntf2n28q8q2s80mcul852ke")
+	frozenAmounts := []asset.IssuerFrozenAmount{asset.NewIssuerFrozenAmount("abc", frozenAddr, sdk.NewInt(100))}
+	state.FrozenAmounts = append(state.FrozenAmounts, frozenAmounts...)
+
+	// a pending snapshot is taken after the import
+	snapshots := []asset.TokenSnapshot{asset.NewTokenSnapshot(3, "abc", owner, 1)}
+	state.Snapshots = append(state.Snapshots, snapshots...)
+
+	auctions := []asset.SymbolAuction{asset.NewSymbolAuction("xyz", owner, sdk.NewInt(1000e8), 1000)}
+	state.SymbolAuctions = append(state.SymbolAuctions, auctions...)
+	reservations := []asset.SymbolReservation{asset.NewSymbolReservation("eth", owner, sdk.NewInt(1000e8), 2000)}
+	state.SymbolReservations = append(state.SymbolReservations, reservations...)
+
+	distributions := []asset.Distribution{asset.NewDistribution(2, "abc", owner, "cet", sdk.NewInt(100), nil, 3)}
+	state.Distributions = append(state.Distributions, distributions...)
+
+	mintRecords := []asset.MintRecord{asset.NewMintRecord("abc", 900, sdk.NewInt(100))}
+	state.MintRecords = append(state.MintRecords, mintRecords...)
+
 	require.NoError(t, asset.ValidateGenesis(state))
 	asset.InitGenesis(input.ctx, input.tk, state)
 
@@ -107,6 +130,36 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, 2, len(export.Tokens))
 	require.Equal(t, whitelist, export.Whitelist)
 	require.Equal(t, forbiddenList, export.ForbiddenAddresses)
+	require.Equal(t, frozenAmounts, export.FrozenAmounts)
+	require.Equal(t, snapshots, export.Snapshots)
+	require.Equal(t, auctions, export.SymbolAuctions)
+	require.Equal(t, reservations, export.SymbolReservations)
+	require.Equal(t, distributions, export.Distributions)
+	require.Equal(t, abc.Metadata, input.tk.GetToken(input.ctx, "abc").GetMetadata())
+	require.Equal(t, abc.MintPolicy, input.tk.GetToken(input.ctx, "abc").GetMintPolicy())
+	require.Equal(t, mintRecords, export.MintRecords)
+	require.Equal(t, 1, len(input.tk.TakePendingSnapshots(input.ctx, asset.SnapshotEntriesPerBlock)))
+	require.NoError(t, input.tk.AddToken(input.ctx, owner, dex.NewCetCoins(asset.DefaultSnapshotFee)))
+	snapshot, err := input.tk.RequestTokenSnapshot(input.ctx, "abc", owner)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), snapshot.ID)
+
+	state.FrozenAmounts = append(state.FrozenAmounts, frozenAmounts...)
+	require.Error(t, asset.ValidateGenesis(state))
+	state.FrozenAmounts = frozenAmounts
+
+	// an issued symbol or a symbol both in auction and reserved is invalid
+	state.SymbolAuctions = append(state.SymbolAuctions, asset.NewSymbolAuction("abc", owner, sdk.NewInt(1000e8), 1000))
+	require.Error(t, asset.ValidateGenesis(state))
+	state.SymbolAuctions = auctions
+	state.SymbolReservations = append(state.SymbolReservations, asset.NewSymbolReservation("xyz", owner, sdk.NewInt(1000e8), 2000))
+	require.Error(t, asset.ValidateGenesis(state))
+	state.SymbolReservations = reservations
+
+	// a mint record must belong to a token with a rate limit
+	state.MintRecords = append(state.MintRecords, asset.NewMintRecord("cet", 900, sdk.NewInt(100)))
+	require.Error(t, asset.ValidateGenesis(state))
+	state.MintRecords = mintRecords
 
 	forbiddenList = []string{"abc:coinex15fvnexrvsm9ryw3nn4mcrnqyhvhazkkrd4aqvd"}
 	state.ForbiddenAddresses = append(state.ForbiddenAddresses, forbiddenList...)
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+	"github.com/coinexchain/cet-sdk/msgqueue"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
 
@@ -38,6 +39,34 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgUnForbidAddr(ctx, keeper, msg)
 		case types.MsgModifyTokenInfo:
 			return handleMsgModifyTokenInfo(ctx, keeper, msg)
+		case types.MsgSetMintSchedule:
+			return handleMsgSetMintSchedule(ctx, keeper, msg)
+		case types.MsgSetTokenCoOwners:
+			return handleMsgSetTokenCoOwners(ctx, keeper, msg)
+		case types.MsgProposeTokenAction:
+			return handleMsgProposeTokenAction(ctx, keeper, msg)
+		case types.MsgApproveTokenAction:
+			return handleMsgApproveTokenAction(ctx, keeper, msg)
+		case types.MsgAcceptOwnership:
+			return handleMsgAcceptOwnership(ctx, keeper, msg)
+		case types.MsgCancelOwnershipTransfer:
+			return handleMsgCancelOwnershipTransfer(ctx, keeper, msg)
+		case types.MsgHolderBurnToken:
+			return handleMsgHolderBurnToken(ctx, keeper, msg)
+		case types.MsgFreezeTokenAmount:
+			return handleMsgFreezeTokenAmount(ctx, keeper, msg)
+		case types.MsgUnfreezeTokenAmount:
+			return handleMsgUnfreezeTokenAmount(ctx, keeper, msg)
+		case types.MsgRequestTokenSnapshot:
+			return handleMsgRequestTokenSnapshot(ctx, keeper, msg)
+		case types.MsgOpenSymbolAuction:
+			return handleMsgOpenSymbolAuction(ctx, keeper, msg)
+		case types.MsgBidSymbolAuction:
+			return handleMsgBidSymbolAuction(ctx, keeper, msg)
+		case types.MsgDistributeToHolders:
+			return handleMsgDistributeToHolders(ctx, keeper, msg)
+		case types.MsgSetMintPolicy:
+			return handleMsgSetMintPolicy(ctx, keeper, msg)
 		default:
 			return dex.ErrUnknownRequest(ModuleName, msg)
 		}
@@ -46,10 +75,12 @@ func NewHandler(keeper Keeper) sdk.Handler {
 
 // handleMsgIssueToken - Handle MsgIssueToken
 func handleMsgIssueToken(ctx sdk.Context, keeper Keeper, msg types.MsgIssueToken) sdk.Result {
-	issueFee := keeper.GetParams(ctx).GetIssueTokenFee(msg.Symbol)
-
-	if err := keeper.DeductIssueFee(ctx, msg.Owner, issueFee); err != nil {
-		return err.Result()
+	// the auction winner has paid for the symbol
+	if r := keeper.GetActiveSymbolReservation(ctx, msg.Symbol); r == nil || !r.Winner.Equals(msg.Owner) {
+		issueFee := keeper.GetParams(ctx).GetIssueTokenFee(msg.Symbol)
+		if err := keeper.DeductIssueFee(ctx, msg.Owner, issueFee); err != nil {
+			return err.Result()
+		}
 	}
 
 	err := keeper.IssueToken(ctx, msg.Name, msg.Symbol, msg.TotalSupply, msg.Owner,
@@ -63,6 +94,24 @@ func handleMsgIssueToken(ctx sdk.Context, keeper Keeper, msg types.MsgIssueToken
 		return err.Result()
 	}
 
+	if msg.MintSchedule != nil {
+		if err := keeper.SetMintSchedule(ctx, msg.Symbol, msg.Owner, *msg.MintSchedule); err != nil {
+			return err.Result()
+		}
+	}
+
+	if msg.HolderBurnable {
+		if err := keeper.SetTokenHolderBurnable(ctx, msg.Symbol, msg.Owner, true); err != nil {
+			return err.Result()
+		}
+	}
+
+	if msg.Permissioned {
+		if err := keeper.SetTokenPermissioned(ctx, msg.Symbol, msg.Owner); err != nil {
+			return err.Result()
+		}
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -75,17 +124,21 @@ func handleMsgIssueToken(ctx sdk.Context, keeper Keeper, msg types.MsgIssueToken
 			sdk.NewAttribute(types.AttributeKeyTokenOwner, msg.Owner.String()),
 		),
 	})
+	if msg.MintSchedule != nil {
+		ctx.EventManager().EmitEvent(newSetMintScheduleEvent(msg.Symbol, *msg.MintSchedule))
+	}
 
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
 	}
 }
 
-// handleMsgTransferOwnership - Handle MsgTransferOwnership
+// handleMsgTransferOwnership - Handle MsgTransferOwnership, which nominates the new owner
 func handleMsgTransferOwnership(ctx sdk.Context, keeper Keeper, msg types.MsgTransferOwnership) sdk.Result {
 	if err := keeper.TransferOwnership(ctx, msg.Symbol, msg.OriginalOwner, msg.NewOwner); err != nil {
 		return err.Result()
 	}
+	transfer := keeper.GetPendingOwnershipTransfer(ctx, msg.Symbol)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -93,13 +146,66 @@ func handleMsgTransferOwnership(ctx sdk.Context, keeper Keeper, msg types.MsgTra
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.OriginalOwner.String()),
 		),
+		sdk.NewEvent(
+			types.EventTypeNominateOwner,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyPendingOwner, msg.NewOwner.String()),
+			sdk.NewAttribute(types.AttributeKeyTokenOwner, msg.OriginalOwner.String()),
+			sdk.NewAttribute(types.AttributeKeyDeadline, strconv.FormatInt(transfer.Deadline, 10)),
+		),
+	})
+	fillMsgQueue(ctx, keeper, types.KafkaNominateOwner, *transfer)
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgAcceptOwnership - Handle MsgAcceptOwnership
+func handleMsgAcceptOwnership(ctx sdk.Context, keeper Keeper, msg types.MsgAcceptOwnership) sdk.Result {
+	transfer, err := keeper.AcceptOwnership(ctx, msg.Symbol, msg.NewOwner)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.NewOwner.String()),
+		),
 		sdk.NewEvent(
 			types.EventTypeTransferOwnership,
 			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
 			sdk.NewAttribute(types.AttributeKeyTokenOwner, msg.NewOwner.String()),
-			sdk.NewAttribute(types.AttributeKeyOriginalOwner, msg.OriginalOwner.String()),
+			sdk.NewAttribute(types.AttributeKeyOriginalOwner, transfer.OriginalOwner.String()),
+		),
+	})
+	fillMsgQueue(ctx, keeper, types.KafkaAcceptOwnership, transfer)
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgCancelOwnershipTransfer - Handle MsgCancelOwnershipTransfer
+func handleMsgCancelOwnershipTransfer(ctx sdk.Context, keeper Keeper, msg types.MsgCancelOwnershipTransfer) sdk.Result {
+	transfer, err := keeper.CancelOwnershipTransfer(ctx, msg.Symbol, msg.OwnerAddress)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeCancelOwnerTransfer,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyPendingOwner, transfer.NewOwner.String()),
 		),
 	})
+	fillMsgQueue(ctx, keeper, types.KafkaCancelOwnerTransfer, transfer)
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
 	}
@@ -108,7 +214,17 @@ func handleMsgTransferOwnership(ctx sdk.Context, keeper Keeper, msg types.MsgTra
 // handleMsgMintToken - Handle MsgMintToken
 func handleMsgMintToken(ctx sdk.Context, keeper Keeper, msg types.MsgMintToken) sdk.Result {
 	if err := keeper.MintToken(ctx, msg.Symbol, msg.OwnerAddress, msg.Amount); err != nil {
-		return err.Result()
+		res := err.Result()
+		// the failed tx still reports which limit of the mint policy rejects it
+		if err.Codespace() == types.CodeSpaceAsset {
+			switch err.Code() {
+			case types.CodeMintCapExceeded:
+				res.Events = sdk.Events{newMintLimitReachedEvent(msg.Symbol, msg.Amount, types.MintLimitSupplyCap)}
+			case types.CodeMintRateLimited:
+				res.Events = sdk.Events{newMintLimitReachedEvent(msg.Symbol, msg.Amount, types.MintLimitWindowAmount)}
+			}
+		}
+		return res
 	}
 	if err := keeper.SendCoinsFromAssetModuleToAccount(ctx, msg.OwnerAddress, types.NewTokenCoins(msg.Symbol, msg.Amount)); err != nil {
 		return err.Result()
@@ -126,6 +242,9 @@ func handleMsgMintToken(ctx sdk.Context, keeper Keeper, msg types.MsgMintToken)
 			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
 		),
 	})
+	if limit := reachedMintLimit(ctx, keeper, msg.Symbol); limit != "" {
+		ctx.EventManager().EmitEvent(newMintLimitReachedEvent(msg.Symbol, msg.Amount, limit))
+	}
 
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
@@ -159,6 +278,37 @@ func handleMsgBurnToken(ctx sdk.Context, keeper Keeper, msg types.MsgBurnToken)
 	}
 }
 
+// handleMsgHolderBurnToken - Handle MsgHolderBurnToken
+func handleMsgHolderBurnToken(ctx sdk.Context, keeper Keeper, msg types.MsgHolderBurnToken) sdk.Result {
+	redemption, err := keeper.HolderBurnToken(ctx, msg.Symbol, msg.HolderAddress, msg.Amount, msg.Memo)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.HolderAddress.String()),
+		),
+		sdk.NewEvent(types.EventTypeBurnToken,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(types.EventTypeRedeemToken,
+			sdk.NewAttribute(types.AttributeKeySymbol, redemption.Symbol),
+			sdk.NewAttribute(types.AttributeKeyHolder, redemption.Holder.String()),
+			sdk.NewAttribute(types.AttributeKeyTokenOwner, redemption.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, redemption.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyMemo, redemption.Memo),
+		),
+	})
+	fillMsgQueue(ctx, keeper, types.KafkaRedeemToken, redemption)
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
 // handleMsgForbidToken - Handle ForbidToken msg
 func handleMsgForbidToken(ctx sdk.Context, keeper Keeper, msg types.MsgForbidToken) sdk.Result {
 	if err := keeper.ForbidToken(ctx, msg.Symbol, msg.OwnerAddress); err != nil {
@@ -286,6 +436,156 @@ func handleMsgForbidAddr(ctx sdk.Context, keeper Keeper, msg types.MsgForbidAddr
 	}
 }
 
+// handleMsgFreezeTokenAmount - Handle MsgFreezeTokenAmount
+func handleMsgFreezeTokenAmount(ctx sdk.Context, keeper Keeper, msg types.MsgFreezeTokenAmount) sdk.Result {
+	frozen, err := keeper.FreezeTokenAmount(ctx, msg.Symbol, msg.OwnerAddress, msg.Address, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress.String()),
+		),
+		sdk.NewEvent(types.EventTypeFreezeTokenAmount,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyFrozenAmount, frozen.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgUnfreezeTokenAmount - Handle MsgUnfreezeTokenAmount
+func handleMsgUnfreezeTokenAmount(ctx sdk.Context, keeper Keeper, msg types.MsgUnfreezeTokenAmount) sdk.Result {
+	frozen, err := keeper.UnfreezeTokenAmount(ctx, msg.Symbol, msg.OwnerAddress, msg.Address, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress.String()),
+		),
+		sdk.NewEvent(types.EventTypeUnfreezeTokenAmount,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyFrozenAmount, frozen.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgRequestTokenSnapshot(ctx sdk.Context, keeper Keeper, msg types.MsgRequestTokenSnapshot) sdk.Result {
+	snapshot, err := keeper.RequestTokenSnapshot(ctx, msg.Symbol, msg.Requester)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Requester.String()),
+		),
+		sdk.NewEvent(types.EventTypeRequestSnapshot,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeySnapshotID, strconv.FormatUint(snapshot.ID, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgDistributeToHolders - Handle MsgDistributeToHolders
+func handleMsgDistributeToHolders(ctx sdk.Context, keeper Keeper, msg types.MsgDistributeToHolders) sdk.Result {
+	d, err := keeper.DistributeToHolders(ctx, msg.Symbol, msg.OwnerAddress, msg.Denom, msg.Amount, msg.Excluded)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress.String()),
+		),
+		sdk.NewEvent(types.EventTypeDistributeToHolders,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyDistributionID, strconv.FormatUint(d.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeySnapshotID, strconv.FormatUint(d.SnapshotID, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgOpenSymbolAuction - Handle MsgOpenSymbolAuction
+func handleMsgOpenSymbolAuction(ctx sdk.Context, keeper Keeper, msg types.MsgOpenSymbolAuction) sdk.Result {
+	auction, err := keeper.OpenSymbolAuction(ctx, msg.Symbol, msg.Bidder, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Bidder.String()),
+		),
+		sdk.NewEvent(types.EventTypeOpenSymbolAuction,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyBid, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, strconv.FormatInt(auction.EndTime, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgBidSymbolAuction - Handle MsgBidSymbolAuction
+func handleMsgBidSymbolAuction(ctx sdk.Context, keeper Keeper, msg types.MsgBidSymbolAuction) sdk.Result {
+	if _, err := keeper.BidSymbolAuction(ctx, msg.Symbol, msg.Bidder, msg.Amount); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Bidder.String()),
+		),
+		sdk.NewEvent(types.EventTypeBidSymbolAuction,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyBid, msg.Amount.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
 // handleMsgUnForbidAddr - Handle MsgUnForbidAddr
 func handleMsgUnForbidAddr(ctx sdk.Context, keeper Keeper, msg types.MsgUnForbidAddr) (res sdk.Result) {
 	if err := keeper.UnForbidAddress(ctx, msg.Symbol, msg.OwnerAddr, msg.Addresses); err != nil {
@@ -335,6 +635,21 @@ func handleMsgModifyTokenInfo(ctx sdk.Context, keeper Keeper, msg types.MsgModif
 		return err.Result()
 	}
 
+	if msg.HolderBurnable != "" && msg.HolderBurnable != types.DoNotModifyTokenInfo {
+		holderBurnable, err := strconv.ParseBool(msg.HolderBurnable)
+		if err != nil {
+			return types.ErrInvalidTokenInfo("HolderBurnable", msg.HolderBurnable).Result()
+		}
+		if err := keeper.SetTokenHolderBurnable(ctx, msg.Symbol, msg.OwnerAddress, holderBurnable); err != nil {
+			return err.Result()
+		}
+	}
+	if msg.Metadata != nil {
+		if err := keeper.SetTokenMetadata(ctx, msg.Symbol, msg.OwnerAddress, *msg.Metadata); err != nil {
+			return err.Result()
+		}
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -353,6 +668,193 @@ func handleMsgModifyTokenInfo(ctx sdk.Context, keeper Keeper, msg types.MsgModif
 	}
 }
 
+// handleMsgSetMintSchedule - Handle MsgSetMintSchedule
+func handleMsgSetMintSchedule(ctx sdk.Context, keeper Keeper, msg types.MsgSetMintSchedule) sdk.Result {
+	if err := keeper.SetMintSchedule(ctx, msg.Symbol, msg.OwnerAddress, msg.Schedule); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress.String()),
+		),
+		newSetMintScheduleEvent(msg.Symbol, msg.Schedule),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func newSetMintScheduleEvent(symbol string, schedule types.MintSchedule) sdk.Event {
+	return sdk.NewEvent(types.EventTypeSetMintSchedule,
+		sdk.NewAttribute(types.AttributeKeySymbol, symbol),
+		sdk.NewAttribute(types.AttributeKeyScheduled, schedule.TotalAmount().String()),
+	)
+}
+
+func newMintLimitReachedEvent(symbol string, amount sdk.Int, limit string) sdk.Event {
+	return sdk.NewEvent(types.EventTypeMintLimitReached,
+		sdk.NewAttribute(types.AttributeKeySymbol, symbol),
+		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(types.AttributeKeyLimit, limit),
+	)
+}
+
+// reachedMintLimit returns the limit of the mint policy which is used up by the mints, if any
+func reachedMintLimit(ctx sdk.Context, keeper Keeper, symbol string) string {
+	info := keeper.GetMintPolicyInfo(ctx, symbol)
+	if info == nil || info.Mintable.IsPositive() {
+		return ""
+	}
+	if info.Policy.HasSupplyCap() && keeper.GetToken(ctx, symbol).GetTotalSupply().Equal(info.Policy.SupplyCap) {
+		return types.MintLimitSupplyCap
+	}
+	return types.MintLimitWindowAmount
+}
+
+// handleMsgSetMintPolicy - Handle MsgSetMintPolicy
+func handleMsgSetMintPolicy(ctx sdk.Context, keeper Keeper, msg types.MsgSetMintPolicy) sdk.Result {
+	if err := keeper.SetMintPolicy(ctx, msg.Symbol, msg.OwnerAddress, msg.Policy); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress.String()),
+		),
+		sdk.NewEvent(types.EventTypeSetMintPolicy,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeySupplyCap, msg.Policy.SupplyCap.String()),
+			sdk.NewAttribute(types.AttributeKeyWindowAmount, msg.Policy.WindowAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyWindowSeconds, strconv.FormatInt(msg.Policy.WindowSeconds, 10)),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgSetTokenCoOwners - Handle MsgSetTokenCoOwners
+func handleMsgSetTokenCoOwners(ctx sdk.Context, keeper Keeper, msg types.MsgSetTokenCoOwners) sdk.Result {
+	if err := keeper.SetTokenCoOwners(ctx, msg.Symbol, msg.OwnerAddress, msg.CoOwners, msg.Threshold); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress.String()),
+		),
+		newSetTokenCoOwnersEvent(msg),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func newSetTokenCoOwnersEvent(msg types.MsgSetTokenCoOwners) sdk.Event {
+	var str string
+	for _, addr := range msg.CoOwners {
+		str = str + addr.String() + ","
+	}
+	return sdk.NewEvent(types.EventTypeSetTokenCoOwners,
+		sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+		sdk.NewAttribute(types.AttributeKeyAddrList, str),
+		sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatUint(uint64(msg.Threshold), 10)),
+	)
+}
+
+// handleMsgProposeTokenAction - Handle MsgProposeTokenAction
+func handleMsgProposeTokenAction(ctx sdk.Context, keeper Keeper, msg types.MsgProposeTokenAction) sdk.Result {
+	proposal, ready, err := keeper.ProposeTokenAction(ctx, msg.Proposer, msg.Action, msg.Lifetime)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Proposer.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeProposeTokenAction,
+			sdk.NewAttribute(types.AttributeKeySymbol, proposal.Symbol),
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposal.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyAction, proposal.Action.Type()),
+			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
+		),
+	})
+	if ready {
+		executeTokenAction(ctx, keeper, proposal)
+	}
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgApproveTokenAction - Handle MsgApproveTokenAction
+func handleMsgApproveTokenAction(ctx sdk.Context, keeper Keeper, msg types.MsgApproveTokenAction) sdk.Result {
+	proposal, ready, err := keeper.ApproveTokenAction(ctx, msg.Symbol, msg.ProposalID, msg.Approver)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Approver.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeApproveTokenAction,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(msg.ProposalID, 10)),
+			sdk.NewAttribute(types.AttributeKeyApprover, msg.Approver.String()),
+			sdk.NewAttribute(types.AttributeKeyApprovals, strconv.Itoa(len(proposal.Approvals))),
+		),
+	})
+	if ready {
+		executeTokenAction(ctx, keeper, proposal)
+	}
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// executeTokenAction executes an approved proposal with the token owner's authority. The proposal
+// is removed even if its action fails, because it has got all its approvals. A succeeded action emits
+// the execute_token_action event, and a failed one emits the fail_token_action event with the error,
+// while the approval itself succeeds
+func executeTokenAction(ctx sdk.Context, keeper Keeper, proposal types.TokenActionProposal) {
+	cacheCtx, write := ctx.CacheContext()
+	res := NewHandler(keeper.WithApprovedAction())(cacheCtx, proposal.Action)
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeySymbol, proposal.Symbol),
+		sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposal.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeyAction, proposal.Action.Type()),
+	}
+	if !res.IsOK() {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyReason, res.Log))
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeFailTokenAction, attrs...))
+		return
+	}
+	write()
+	ctx.EventManager().EmitEvents(res.Events)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeExecuteTokenAction, attrs...))
+}
+
+func fillMsgQueue(ctx sdk.Context, keeper Keeper, key string, msg interface{}) {
+	if keeper.IsSubscribed(types.Topic) {
+		msgqueue.FillMsgs(ctx, key, msg)
+	}
+}
+
 func CollectTokenModificationInfo(token types.Token, msg types.MsgModifyTokenInfo) (
 	newURL, newDesc, newID, newName string, newSupply sdk.Int,
 	newMintable, newBurnable, newAddrForbiddable, newTokenForbiddable bool,
@@ -3,6 +3,7 @@ package asset_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -51,6 +52,16 @@ func Test_handleMsg(t *testing.T) {
 			asset.NewMsgTransferOwnership("abc", testAddr, owner),
 			true,
 		},
+		{
+			"accept_ownership_invalid",
+			asset.NewMsgAcceptOwnership("abc", testAddr),
+			false,
+		},
+		{
+			"accept_ownership",
+			asset.NewMsgAcceptOwnership("abc", owner),
+			true,
+		},
 		{
 			"transfer_ownership_invalid",
 			asset.NewMsgTransferOwnership("abc", testAddr, owner),
@@ -324,3 +335,200 @@ func newMsgModifyTokenInfo() asset.MsgModifyTokenInfo {
 		TokenForbiddable: types.DoNotModifyTokenInfo,
 	}
 }
+
+func Test_TokenCoOwners(t *testing.T) {
+	input := createTestInput()
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+	symbol := "abc"
+	coOwners := mockAddrList()
+	h := asset.NewHandler(input.tk)
+
+	err := input.tk.AddToken(ctx, testAddr, dex.NewCetCoins(1e18))
+	require.NoError(t, err)
+	res := h(ctx, asset.NewMsgIssueToken("ABC Token", symbol, sdk.NewInt(2100), testAddr,
+		true, true, false, false, "", "", types.TestIdentityString))
+	require.True(t, res.IsOK())
+	res = h(ctx, asset.NewMsgSetTokenCoOwners(symbol, testAddr, coOwners, 2))
+	require.True(t, res.IsOK())
+
+	// the owner can not mint alone any more
+	mint := asset.NewMsgMintToken(symbol, sdk.NewInt(100), testAddr)
+	res = h(ctx, mint)
+	require.Equal(t, types.CodeTokenActionNeedsApproval, res.Code)
+
+	res = h(ctx, asset.NewMsgProposeTokenAction(coOwners[0], mint, 3600))
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(2100), input.tk.GetToken(ctx, symbol).GetTotalSupply())
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res = h(ctx, asset.NewMsgApproveTokenAction(symbol, 1, coOwners[1]))
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(2200), input.tk.GetToken(ctx, symbol).GetTotalSupply())
+	require.Equal(t, types.EventTypeExecuteTokenAction, res.Events[len(res.Events)-1].Type)
+	res = h(ctx, asset.NewMsgApproveTokenAction(symbol, 1, coOwners[2]))
+	require.Equal(t, types.CodeTokenProposalNotFound, res.Code)
+
+	// a failed action is dropped, the approval itself succeeds
+	res = h(ctx, asset.NewMsgProposeTokenAction(coOwners[0], asset.NewMsgBurnToken(symbol, sdk.NewInt(1e10), testAddr), 3600))
+	require.True(t, res.IsOK())
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res = h(ctx, asset.NewMsgApproveTokenAction(symbol, 2, coOwners[2]))
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(2200), input.tk.GetToken(ctx, symbol).GetTotalSupply())
+	require.Equal(t, types.EventTypeFailTokenAction, res.Events[len(res.Events)-1].Type)
+	require.Empty(t, input.tk.GetTokenActionProposals(ctx, symbol))
+
+	// pending proposals are exported and expired by the EndBlocker
+	res = h(ctx, asset.NewMsgProposeTokenAction(coOwners[0], mint, 3600))
+	require.True(t, res.IsOK())
+	state := asset.ExportGenesis(ctx, input.tk)
+	require.Equal(t, 1, len(state.CoOwners))
+	require.Equal(t, 1, len(state.Proposals))
+	require.NoError(t, asset.ValidateGenesis(state))
+
+	// nothing expires before the expire time
+	asset.EndBlocker(ctx.WithBlockTime(time.Unix(4599, 0)), input.tk)
+	require.Equal(t, 1, len(input.tk.GetTokenActionProposals(ctx, symbol)))
+	ctx = ctx.WithBlockTime(time.Unix(4600, 0)).WithEventManager(sdk.NewEventManager())
+	asset.EndBlocker(ctx, input.tk)
+	require.Empty(t, input.tk.GetTokenActionProposals(ctx, symbol))
+	require.Equal(t, types.EventTypeExpireTokenAction, ctx.EventManager().Events()[0].Type)
+}
+
+func Test_OwnershipTransfer(t *testing.T) {
+	input := createTestInput()
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+	symbol := "abc"
+	newOwner := mockAddrList()[0]
+	h := asset.NewHandler(input.tk)
+
+	err := input.tk.AddToken(ctx, testAddr, dex.NewCetCoins(1e18))
+	require.NoError(t, err)
+	res := h(ctx, asset.NewMsgIssueToken("ABC Token", symbol, sdk.NewInt(2100), testAddr,
+		true, true, false, false, "", "", types.TestIdentityString))
+	require.True(t, res.IsOK())
+
+	// nominate and cancel
+	res = h(ctx, asset.NewMsgTransferOwnership(symbol, testAddr, newOwner))
+	require.True(t, res.IsOK())
+	require.Equal(t, types.EventTypeNominateOwner, res.Events[1].Type)
+	res = h(ctx, asset.NewMsgCancelOwnershipTransfer(symbol, testAddr))
+	require.True(t, res.IsOK())
+	res = h(ctx, asset.NewMsgAcceptOwnership(symbol, newOwner))
+	require.Equal(t, types.CodeNoPendingOwnershipTransfer, res.Code)
+
+	// the pending transfer is exported, and removed by the EndBlocker after the deadline
+	res = h(ctx, asset.NewMsgTransferOwnership(symbol, testAddr, newOwner))
+	require.True(t, res.IsOK())
+	state := asset.ExportGenesis(ctx, input.tk)
+	require.Equal(t, 1, len(state.PendingTransfers))
+	require.NoError(t, asset.ValidateGenesis(state))
+
+	// a later nomination replaces the earlier one together with its deadline
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0))
+	res = h(ctx, asset.NewMsgTransferOwnership(symbol, testAddr, newOwner))
+	require.True(t, res.IsOK())
+	asset.EndBlocker(ctx.WithBlockTime(time.Unix(1000+types.OwnershipTransferTimeout, 0)), input.tk)
+	require.NotNil(t, input.tk.GetPendingOwnershipTransfer(ctx, symbol))
+
+	ctx = ctx.WithBlockTime(time.Unix(2000+types.OwnershipTransferTimeout, 0)).WithEventManager(sdk.NewEventManager())
+	asset.EndBlocker(ctx, input.tk)
+	require.Equal(t, types.EventTypeExpireOwnerTransfer, ctx.EventManager().Events()[0].Type)
+	res = h(ctx, asset.NewMsgAcceptOwnership(symbol, newOwner))
+	require.Equal(t, types.CodeNoPendingOwnershipTransfer, res.Code)
+	require.Equal(t, testAddr, input.tk.GetToken(ctx, symbol).GetOwner())
+}
+
+func Test_HolderBurnToken(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	holder := mockAddrList()[0]
+	h := asset.NewHandler(input.tk)
+
+	err := input.tk.AddToken(input.ctx, testAddr, dex.NewCetCoins(1e18))
+	require.NoError(t, err)
+	msgIssue := asset.NewMsgIssueToken("ABC Token", symbol, sdk.NewInt(2100), testAddr,
+		true, true, false, false, "", "", types.TestIdentityString)
+	msgIssue.HolderBurnable = true
+	res := h(input.ctx, msgIssue)
+	require.True(t, res.IsOK())
+	require.True(t, input.tk.GetToken(input.ctx, symbol).GetHolderBurnable())
+
+	err = input.tk.AddToken(input.ctx, holder, types.NewTokenCoins(symbol, sdk.NewInt(300)))
+	require.NoError(t, err)
+	res = h(input.ctx, asset.NewMsgHolderBurnToken(symbol, sdk.NewInt(100), holder, "withdrawal address"))
+	require.True(t, res.IsOK())
+	require.Equal(t, types.EventTypeRedeemToken, res.Events[len(res.Events)-1].Type)
+	require.Equal(t, sdk.NewInt(200), input.tk.GetAccTotalToken(input.ctx, holder).AmountOf(symbol))
+	require.Equal(t, sdk.NewInt(100), input.tk.GetToken(input.ctx, symbol).GetTotalBurn())
+
+	// holder burn can not be disabled after distribution
+	msgModify := asset.NewMsgModifyTokenInfo(symbol, types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo,
+		types.DoNotModifyTokenInfo, testAddr, types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo,
+		types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo)
+	msgModify.HolderBurnable = "false"
+	res = h(input.ctx, msgModify)
+	require.Equal(t, types.CodeTokenInfoSealed, res.Code)
+	res = h(input.ctx, asset.NewMsgHolderBurnToken(symbol, sdk.NewInt(300), holder, ""))
+	require.False(t, res.IsOK())
+}
+
+func Test_IssuePermissionedToken(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	holder := mockAddrList()[0]
+	h := asset.NewHandler(input.tk)
+
+	err := input.tk.AddToken(input.ctx, testAddr, dex.NewCetCoins(1e18))
+	require.NoError(t, err)
+	msgIssue := asset.NewMsgIssueToken("ABC Token", symbol, sdk.NewInt(2100), testAddr,
+		true, true, false, false, "", "", types.TestIdentityString)
+	msgIssue.Permissioned = true
+	res := h(input.ctx, msgIssue)
+	require.True(t, res.IsOK())
+	require.True(t, input.tk.GetToken(input.ctx, symbol).GetPermissioned())
+	require.False(t, input.tk.IsPermittedByTokenIssuer(input.ctx, symbol, holder))
+
+	res = h(input.ctx, asset.NewMsgAddTokenWhitelist(symbol, testAddr, []sdk.AccAddress{holder}))
+	require.True(t, res.IsOK())
+	require.True(t, input.tk.IsPermittedByTokenIssuer(input.ctx, symbol, holder))
+}
+
+func Test_FreezeTokenAmount(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	holder := mockAddrList()[0]
+	h := asset.NewHandler(input.tk)
+
+	err := input.tk.AddToken(input.ctx, testAddr, dex.NewCetCoins(1e18))
+	require.NoError(t, err)
+	msgIssue := asset.NewMsgIssueToken("ABC Token", symbol, sdk.NewInt(2100), testAddr,
+		false, true, true, false, "", "", types.TestIdentityString)
+	msgIssue.HolderBurnable = true
+	res := h(input.ctx, msgIssue)
+	require.True(t, res.IsOK())
+	err = input.tk.AddToken(input.ctx, holder, types.NewTokenCoins(symbol, sdk.NewInt(300)))
+	require.NoError(t, err)
+
+	res = h(input.ctx, asset.NewMsgFreezeTokenAmount(symbol, testAddr, holder, sdk.NewInt(200)))
+	require.True(t, res.IsOK())
+	event := res.Events[len(res.Events)-1]
+	require.Equal(t, types.EventTypeFreezeTokenAmount, event.Type)
+	require.Equal(t, types.AttributeKeyFrozenAmount, string(event.Attributes[3].Key))
+	require.Equal(t, "200", string(event.Attributes[3].Value))
+	res = h(input.ctx, asset.NewMsgFreezeTokenAmount(symbol, holder, testAddr, sdk.NewInt(200)))
+	require.Equal(t, types.CodeNeedTokenOwner, res.Code)
+
+	// the frozen amount can not be redeemed
+	res = h(input.ctx, asset.NewMsgHolderBurnToken(symbol, sdk.NewInt(101), holder, ""))
+	require.Equal(t, types.CodeHolderBurnForbidden, res.Code)
+	res = h(input.ctx, asset.NewMsgHolderBurnToken(symbol, sdk.NewInt(100), holder, ""))
+	require.True(t, res.IsOK())
+
+	res = h(input.ctx, asset.NewMsgUnfreezeTokenAmount(symbol, testAddr, holder, sdk.NewInt(300)))
+	require.Equal(t, types.CodeInsufficientFrozenAmount, res.Code)
+	res = h(input.ctx, asset.NewMsgUnfreezeTokenAmount(symbol, testAddr, holder, sdk.NewInt(200)))
+	require.True(t, res.IsOK())
+	require.Equal(t, types.EventTypeUnfreezeTokenAmount, res.Events[len(res.Events)-1].Type)
+	require.True(t, input.tk.GetIssuerFrozenAmount(input.ctx, symbol, holder).IsZero())
+}
@@ -0,0 +1,239 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+// OpenSymbolAuction - open an auction for an unissued reserved or short symbol with the first bid,
+// which must be no less than the issue fee of the symbol
+func (keeper BaseKeeper) OpenSymbolAuction(ctx sdk.Context, symbol string, bidder sdk.AccAddress, amount sdk.Int) (types.SymbolAuction, sdk.Error) {
+	if keeper.bkx.BlacklistedAddr(bidder) {
+		return types.SymbolAuction{}, types.ErrAccInBlackList(bidder)
+	}
+	if !types.IsAuctionableSymbol(symbol) || keeper.IsTokenExists(ctx, symbol) {
+		return types.SymbolAuction{}, types.ErrSymbolNotAuctionable(symbol)
+	}
+	if keeper.GetSymbolAuction(ctx, symbol) != nil {
+		return types.SymbolAuction{}, types.ErrSymbolInAuction(symbol)
+	}
+	if r := keeper.GetActiveSymbolReservation(ctx, symbol); r != nil {
+		return types.SymbolAuction{}, types.ErrSymbolReserved(symbol, r.Winner)
+	}
+
+	minBid := sdk.NewInt(keeper.GetParams(ctx).GetIssueTokenFee(symbol))
+	if amount.LT(minBid) {
+		return types.SymbolAuction{}, types.ErrSymbolBidTooLow(minBid)
+	}
+	if err := keeper.bkx.FreezeCoins(ctx, bidder, types.NewTokenCoins(dex.CET, amount)); err != nil {
+		return types.SymbolAuction{}, err
+	}
+
+	// an expired reservation is replaced by the new auction
+	keeper.RemoveSymbolReservation(ctx, symbol)
+	endTime := ctx.BlockHeader().Time.Unix() + types.SymbolAuctionDuration
+	auction := types.NewSymbolAuction(symbol, bidder, amount, endTime)
+	keeper.SetSymbolAuction(ctx, auction)
+	return auction, nil
+}
+
+// BidSymbolAuction - outbid the highest bid of an auction, the outbid amount is refunded
+func (keeper BaseKeeper) BidSymbolAuction(ctx sdk.Context, symbol string, bidder sdk.AccAddress, amount sdk.Int) (types.SymbolAuction, sdk.Error) {
+	if keeper.bkx.BlacklistedAddr(bidder) {
+		return types.SymbolAuction{}, types.ErrAccInBlackList(bidder)
+	}
+	auction := keeper.GetSymbolAuction(ctx, symbol)
+	if auction == nil || auction.EndTime <= ctx.BlockHeader().Time.Unix() {
+		return types.SymbolAuction{}, types.ErrSymbolAuctionNotFound(symbol)
+	}
+	if minBid := auction.MinNextBid(); amount.LT(minBid) {
+		return types.SymbolAuction{}, types.ErrSymbolBidTooLow(minBid)
+	}
+
+	// refund the outbid one before freezing the new bid, in case they are from the same bidder
+	if err := keeper.bkx.UnFreezeCoins(ctx, auction.Bidder, types.NewTokenCoins(dex.CET, auction.Bid)); err != nil {
+		return types.SymbolAuction{}, err
+	}
+	if err := keeper.bkx.FreezeCoins(ctx, bidder, types.NewTokenCoins(dex.CET, amount)); err != nil {
+		return types.SymbolAuction{}, err
+	}
+
+	auction.Bidder = bidder
+	auction.Bid = amount
+	keeper.SetSymbolAuction(ctx, *auction)
+	return *auction, nil
+}
+
+// SettleEndedSymbolAuctions - donate the winning bids of the ended auctions to the community pool,
+// and reserve the symbols for the winners. Unfreezing, donating and reserving are all or nothing,
+// and an auction is removed only after they are done. If they fail, the winning bid is refunded
+// without reservation, and the auction is kept to be settled again if even the refund fails
+func (keeper BaseKeeper) SettleEndedSymbolAuctions(ctx sdk.Context) []types.SymbolReservation {
+	store := ctx.KVStore(keeper.storeKey)
+	end := types.GetSymbolAuctionEndStoreKey(ctx.BlockHeader().Time.Unix()+1, "")
+	iter := store.Iterator(types.SymbolAuctionEndKey, end)
+	var ended []types.SymbolAuction
+	for ; iter.Valid(); iter.Next() {
+		symbol := string(iter.Key()[len(types.SymbolAuctionEndKey)+8:])
+		if auction := keeper.GetSymbolAuction(ctx, symbol); auction != nil {
+			ended = append(ended, *auction)
+		}
+	}
+	iter.Close()
+
+	reservations := make([]types.SymbolReservation, 0, len(ended))
+	for _, auction := range ended {
+		coins := types.NewTokenCoins(dex.CET, auction.Bid)
+		r := types.NewSymbolReservation(auction.Symbol, auction.Bidder, auction.Bid,
+			auction.EndTime+types.SymbolReservationTimeout)
+		cacheCtx, write := ctx.CacheContext()
+		err := keeper.bkx.UnFreezeCoins(cacheCtx, auction.Bidder, coins)
+		if err == nil {
+			err = keeper.dk.DonateToCommunityPool(cacheCtx, auction.Bidder, coins)
+		}
+		if err != nil {
+			ctx.Logger().Error("failed to settle symbol auction", "symbol", auction.Symbol, "error", err.Error())
+			if err := keeper.bkx.UnFreezeCoins(ctx, auction.Bidder, coins); err != nil {
+				ctx.Logger().Error("failed to refund winning bid", "symbol", auction.Symbol, "error", err.Error())
+				continue
+			}
+			keeper.RemoveSymbolAuction(ctx, auction.Symbol)
+			continue
+		}
+		keeper.SetSymbolReservation(cacheCtx, r)
+		write()
+		keeper.RemoveSymbolAuction(ctx, auction.Symbol)
+		reservations = append(reservations, r)
+	}
+	return reservations
+}
+
+// RemoveExpiredSymbolReservations - remove the reservations whose symbols are not issued before their deadlines
+func (keeper BaseKeeper) RemoveExpiredSymbolReservations(ctx sdk.Context) []types.SymbolReservation {
+	store := ctx.KVStore(keeper.storeKey)
+	end := types.GetSymbolReservationDeadlineStoreKey(ctx.BlockHeader().Time.Unix()+1, "")
+	iter := store.Iterator(types.SymbolReservationDeadlineKey, end)
+	var expired []types.SymbolReservation
+	for ; iter.Valid(); iter.Next() {
+		symbol := string(iter.Key()[len(types.SymbolReservationDeadlineKey)+8:])
+		if r := keeper.GetSymbolReservation(ctx, symbol); r != nil {
+			expired = append(expired, *r)
+		}
+	}
+	iter.Close()
+	for _, r := range expired {
+		keeper.RemoveSymbolReservation(ctx, r.Symbol)
+	}
+	return expired
+}
+
+// GetActiveSymbolReservation - return the reservation of a symbol if it has not expired, or nil
+func (keeper BaseTokenKeeper) GetActiveSymbolReservation(ctx sdk.Context, symbol string) *types.SymbolReservation {
+	r := keeper.GetSymbolReservation(ctx, symbol)
+	if r == nil || r.Deadline <= ctx.BlockHeader().Time.Unix() {
+		return nil
+	}
+	return r
+}
+
+// SetSymbolAuction - set the auction of a symbol, which is also used to import genesis.json.
+// The auctions are also indexed by their end time, so that the EndBlocker only loads the ended ones
+func (keeper BaseTokenKeeper) SetSymbolAuction(ctx sdk.Context, auction types.SymbolAuction) {
+	keeper.RemoveSymbolAuction(ctx, auction.Symbol)
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetSymbolAuctionStoreKey(auction.Symbol), keeper.cdc.MustMarshalBinaryBare(auction))
+	store.Set(types.GetSymbolAuctionEndStoreKey(auction.EndTime, auction.Symbol), []byte{})
+}
+
+func (keeper BaseTokenKeeper) RemoveSymbolAuction(ctx sdk.Context, symbol string) {
+	auction := keeper.GetSymbolAuction(ctx, symbol)
+	if auction == nil {
+		return
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetSymbolAuctionStoreKey(symbol))
+	store.Delete(types.GetSymbolAuctionEndStoreKey(auction.EndTime, symbol))
+}
+
+// GetSymbolAuction - return the auction of a symbol, or nil if it is not in auction
+func (keeper BaseTokenKeeper) GetSymbolAuction(ctx sdk.Context, symbol string) *types.SymbolAuction {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GetSymbolAuctionStoreKey(symbol))
+	if bz == nil {
+		return nil
+	}
+	var auction types.SymbolAuction
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &auction)
+	return &auction
+}
+
+func (keeper BaseTokenKeeper) GetAllSymbolAuctions(ctx sdk.Context) []types.SymbolAuction {
+	auctions := make([]types.SymbolAuction, 0)
+	keeper.IterateSymbolAuctions(ctx, func(auction types.SymbolAuction) {
+		auctions = append(auctions, auction)
+	})
+	return auctions
+}
+
+func (keeper BaseTokenKeeper) IterateSymbolAuctions(ctx sdk.Context, process func(auction types.SymbolAuction)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.SymbolAuctionKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var auction types.SymbolAuction
+		keeper.cdc.MustUnmarshalBinaryBare(iter.Value(), &auction)
+		process(auction)
+	}
+}
+
+// SetSymbolReservation - set the reservation of a symbol, which is also used to import genesis.json.
+// The reservations are also indexed by their deadlines, so that the EndBlocker only loads the expired ones
+func (keeper BaseTokenKeeper) SetSymbolReservation(ctx sdk.Context, r types.SymbolReservation) {
+	keeper.RemoveSymbolReservation(ctx, r.Symbol)
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetSymbolReservationStoreKey(r.Symbol), keeper.cdc.MustMarshalBinaryBare(r))
+	store.Set(types.GetSymbolReservationDeadlineStoreKey(r.Deadline, r.Symbol), []byte{})
+}
+
+func (keeper BaseTokenKeeper) RemoveSymbolReservation(ctx sdk.Context, symbol string) {
+	r := keeper.GetSymbolReservation(ctx, symbol)
+	if r == nil {
+		return
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetSymbolReservationStoreKey(symbol))
+	store.Delete(types.GetSymbolReservationDeadlineStoreKey(r.Deadline, symbol))
+}
+
+// GetSymbolReservation - return the reservation of a symbol, or nil if it is not reserved
+func (keeper BaseTokenKeeper) GetSymbolReservation(ctx sdk.Context, symbol string) *types.SymbolReservation {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GetSymbolReservationStoreKey(symbol))
+	if bz == nil {
+		return nil
+	}
+	var r types.SymbolReservation
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &r)
+	return &r
+}
+
+func (keeper BaseTokenKeeper) GetAllSymbolReservations(ctx sdk.Context) []types.SymbolReservation {
+	reservations := make([]types.SymbolReservation, 0)
+	keeper.IterateSymbolReservations(ctx, func(r types.SymbolReservation) {
+		reservations = append(reservations, r)
+	})
+	return reservations
+}
+
+func (keeper BaseTokenKeeper) IterateSymbolReservations(ctx sdk.Context, process func(r types.SymbolReservation)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.SymbolReservationKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var r types.SymbolReservation
+		keeper.cdc.MustUnmarshalBinaryBare(iter.Value(), &r)
+		process(r)
+	}
+}
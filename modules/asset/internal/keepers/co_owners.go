@@ -0,0 +1,239 @@
+package keepers
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// WithApprovedAction - return a copy of the keeper, which executes the actions approved by the
+// co-owners of a token, so the co-owner check in checkPrecondition is skipped
+func (keeper BaseKeeper) WithApprovedAction() BaseKeeper {
+	keeper.actionApproved = true
+	return keeper
+}
+
+// SetTokenCoOwners - set the co-owners of a token, an empty list removes them together with
+// their pending proposals. Once a token has co-owners, this needs their approval too
+func (keeper BaseKeeper) SetTokenCoOwners(ctx sdk.Context, symbol string, owner sdk.AccAddress,
+	coOwners []sdk.AccAddress, threshold uint32) sdk.Error {
+	if _, err := keeper.checkPrecondition(ctx, symbol, owner); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	if len(coOwners) == 0 && threshold == 0 {
+		store.Delete(types.GetCoOwnersStoreKey(symbol))
+		for _, p := range keeper.GetTokenActionProposals(ctx, symbol) {
+			keeper.RemoveTokenActionProposal(ctx, p)
+		}
+		return nil
+	}
+
+	c := types.NewTokenCoOwners(symbol, coOwners, threshold)
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	for _, addr := range coOwners {
+		if keeper.bkx.BlacklistedAddr(addr) {
+			return types.ErrAccInBlackList(addr)
+		}
+	}
+	keeper.setTokenCoOwners(ctx, c)
+	return nil
+}
+
+// ProposeTokenAction - a co-owner proposes an action on the token, which is regarded as its first
+// approval. ready is true when the proposal has got enough approvals and should be executed
+func (keeper BaseKeeper) ProposeTokenAction(ctx sdk.Context, proposer sdk.AccAddress, action sdk.Msg,
+	lifetime int64) (proposal types.TokenActionProposal, ready bool, err sdk.Error) {
+	symbol, owner, ok := types.GetTokenAdminAction(action)
+	if !ok {
+		return proposal, false, types.ErrInvalidTokenAction("action " + action.Type() + " can not be proposed")
+	}
+	token := keeper.GetToken(ctx, symbol)
+	if token == nil {
+		return proposal, false, types.ErrTokenNotFound(symbol)
+	}
+	if !token.GetOwner().Equals(owner) {
+		return proposal, false, types.ErrNeedTokenOwner(token.GetOwner())
+	}
+	coOwners := keeper.GetTokenCoOwners(ctx, symbol)
+	if coOwners == nil {
+		return proposal, false, types.ErrInvalidTokenAction("token " + symbol + " has no co-owners")
+	}
+	if !coOwners.IsCoOwner(proposer) {
+		return proposal, false, types.ErrNotTokenCoOwner(proposer)
+	}
+
+	proposal = types.TokenActionProposal{
+		ID:         keeper.nextTokenActionProposalID(ctx),
+		Symbol:     symbol,
+		Proposer:   proposer,
+		Action:     action,
+		Approvals:  []sdk.AccAddress{proposer},
+		ExpireTime: ctx.BlockHeader().Time.Unix() + lifetime,
+	}
+	if coOwners.CountApprovals(proposal.Approvals) >= coOwners.Threshold {
+		return proposal, true, nil
+	}
+	keeper.SetTokenActionProposal(ctx, proposal)
+	return proposal, false, nil
+}
+
+// ApproveTokenAction - a co-owner approves a pending proposal. When the threshold is met, the
+// proposal is removed from the store and ready is true, so that it can be executed
+func (keeper BaseKeeper) ApproveTokenAction(ctx sdk.Context, symbol string, id uint64,
+	approver sdk.AccAddress) (types.TokenActionProposal, bool, sdk.Error) {
+	p := keeper.GetTokenActionProposal(ctx, symbol, id)
+	if p == nil || p.ExpireTime <= ctx.BlockHeader().Time.Unix() {
+		return types.TokenActionProposal{}, false, types.ErrTokenProposalNotFound(symbol, id)
+	}
+	coOwners := keeper.GetTokenCoOwners(ctx, symbol)
+	if coOwners == nil || !coOwners.IsCoOwner(approver) {
+		return types.TokenActionProposal{}, false, types.ErrNotTokenCoOwner(approver)
+	}
+	if p.HasApproved(approver) {
+		return types.TokenActionProposal{}, false, types.ErrDuplicateApproval(approver)
+	}
+
+	p.Approvals = append(p.Approvals, approver)
+	if coOwners.CountApprovals(p.Approvals) >= coOwners.Threshold {
+		keeper.RemoveTokenActionProposal(ctx, *p)
+		return *p, true, nil
+	}
+	keeper.SetTokenActionProposal(ctx, *p)
+	return *p, false, nil
+}
+
+// RemoveExpiredTokenActionProposals - remove the proposals which expire at the current block time,
+// only the expired ones are loaded through the expiry index
+func (keeper BaseKeeper) RemoveExpiredTokenActionProposals(ctx sdk.Context) []types.TokenActionProposal {
+	store := ctx.KVStore(keeper.storeKey)
+	end := types.GetTokenProposalExpireStoreKey(ctx.BlockHeader().Time.Unix()+1, 0, "")
+	iter := store.Iterator(types.TokenProposalExpireKey, end)
+	var expired []types.TokenActionProposal
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[len(types.TokenProposalExpireKey)+8:]
+		id := binary.BigEndian.Uint64(key[:8])
+		if p := keeper.GetTokenActionProposal(ctx, string(key[8:]), id); p != nil {
+			expired = append(expired, *p)
+		}
+	}
+	iter.Close()
+	for _, p := range expired {
+		keeper.RemoveTokenActionProposal(ctx, p)
+	}
+	return expired
+}
+
+func (keeper BaseKeeper) nextTokenActionProposalID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	var id uint64 = 1
+	if bz := store.Get(types.NextProposalKey); bz != nil {
+		id = binary.BigEndian.Uint64(bz)
+	}
+	keeper.SetNextTokenActionProposalID(ctx, id+1)
+	return id
+}
+
+// SetNextTokenActionProposalID - set the id of the next proposal, used by genesis import
+func (keeper BaseKeeper) SetNextTokenActionProposalID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.NextProposalKey, sdk.Uint64ToBigEndian(id))
+}
+
+// SetTokenActionProposal - the proposals are also indexed by their expire time, which is never changed,
+// so that the EndBlocker only loads the expired ones
+func (keeper BaseKeeper) SetTokenActionProposal(ctx sdk.Context, p types.TokenActionProposal) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetTokenProposalStoreKey(p.Symbol, p.ID), keeper.cdc.MustMarshalBinaryBare(p))
+	store.Set(types.GetTokenProposalExpireStoreKey(p.ExpireTime, p.ID, p.Symbol), []byte{})
+}
+
+func (keeper BaseKeeper) RemoveTokenActionProposal(ctx sdk.Context, p types.TokenActionProposal) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetTokenProposalStoreKey(p.Symbol, p.ID))
+	store.Delete(types.GetTokenProposalExpireStoreKey(p.ExpireTime, p.ID, p.Symbol))
+}
+
+// ImportGenesisTokenCoOwners - import the co-owners of a token from genesis.json
+func (keeper BaseKeeper) ImportGenesisTokenCoOwners(ctx sdk.Context, c types.TokenCoOwners) {
+	keeper.setTokenCoOwners(ctx, c)
+}
+
+// GetTokenCoOwners - return the co-owners of a token, or nil if it has none
+func (keeper BaseTokenKeeper) GetTokenCoOwners(ctx sdk.Context, symbol string) *types.TokenCoOwners {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GetCoOwnersStoreKey(symbol))
+	if bz == nil {
+		return nil
+	}
+	var c types.TokenCoOwners
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &c)
+	return &c
+}
+
+func (keeper BaseTokenKeeper) GetAllTokenCoOwners(ctx sdk.Context) []types.TokenCoOwners {
+	res := make([]types.TokenCoOwners, 0)
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.CoOwnersKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var c types.TokenCoOwners
+		keeper.cdc.MustUnmarshalBinaryBare(iter.Value(), &c)
+		res = append(res, c)
+	}
+	return res
+}
+
+// GetTokenActionProposal - return a pending proposal, or nil if it does not exist
+func (keeper BaseTokenKeeper) GetTokenActionProposal(ctx sdk.Context, symbol string, id uint64) *types.TokenActionProposal {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GetTokenProposalStoreKey(symbol, id))
+	if bz == nil {
+		return nil
+	}
+	var p types.TokenActionProposal
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &p)
+	return &p
+}
+
+// GetTokenActionProposals - return the pending proposals of a token
+func (keeper BaseTokenKeeper) GetTokenActionProposals(ctx sdk.Context, symbol string) []types.TokenActionProposal {
+	res := make([]types.TokenActionProposal, 0)
+	keeper.iterateTokenActionProposals(ctx, types.GetTokenProposalKeyPrefix(symbol), func(p types.TokenActionProposal) {
+		res = append(res, p)
+	})
+	return res
+}
+
+func (keeper BaseTokenKeeper) GetAllTokenActionProposals(ctx sdk.Context) []types.TokenActionProposal {
+	res := make([]types.TokenActionProposal, 0)
+	keeper.IterateTokenActionProposals(ctx, func(p types.TokenActionProposal) {
+		res = append(res, p)
+	})
+	return res
+}
+
+func (keeper BaseTokenKeeper) IterateTokenActionProposals(ctx sdk.Context, process func(p types.TokenActionProposal)) {
+	keeper.iterateTokenActionProposals(ctx, types.TokenProposalKey, process)
+}
+
+func (keeper BaseTokenKeeper) iterateTokenActionProposals(ctx sdk.Context, prefix []byte, process func(p types.TokenActionProposal)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var p types.TokenActionProposal
+		keeper.cdc.MustUnmarshalBinaryBare(iter.Value(), &p)
+		process(p)
+	}
+}
+
+func (keeper BaseTokenKeeper) setTokenCoOwners(ctx sdk.Context, c types.TokenCoOwners) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetCoOwnersStoreKey(c.Symbol), keeper.cdc.MustMarshalBinaryBare(c))
+}
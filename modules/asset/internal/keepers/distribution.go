@@ -0,0 +1,230 @@
+package keepers
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// DistributeToHolders - freeze the amount on the token owner and request a snapshot of the token,
+// the amount is paid to the holders recorded in the snapshot pro-rata in the following EndBlocks
+func (keeper BaseKeeper) DistributeToHolders(ctx sdk.Context, symbol string, owner sdk.AccAddress,
+	denom string, amount sdk.Int, excluded []sdk.AccAddress) (types.Distribution, sdk.Error) {
+	if _, err := keeper.checkPrecondition(ctx, symbol, owner); err != nil {
+		return types.Distribution{}, err
+	}
+	if !keeper.IsTokenExists(ctx, denom) {
+		return types.Distribution{}, types.ErrTokenNotFound(denom)
+	}
+	if err := keeper.bkx.FreezeCoins(ctx, owner, types.NewTokenCoins(denom, amount)); err != nil {
+		return types.Distribution{}, err
+	}
+
+	// the owner pays the snapshot fee as any other requester
+	snapshot, err := keeper.RequestTokenSnapshot(ctx, symbol, owner)
+	if err != nil {
+		return types.Distribution{}, err
+	}
+	d := types.NewDistribution(keeper.nextDistributionID(ctx), symbol, owner, denom, amount, excluded, snapshot.ID)
+	keeper.SetDistribution(ctx, d)
+	keeper.setPendingDistribution(ctx, d.ID)
+	return d, nil
+}
+
+// ProcessDistributions - process at most limit snapshot balances for the pending distributions whose
+// snapshots are taken, the earliest distribution first, and return the distributions settled in this block
+func (keeper BaseKeeper) ProcessDistributions(ctx sdk.Context, limit int) []types.Distribution {
+	var ids []uint64
+	keeper.iteratePendingDistributionIDs(ctx, func(id uint64) {
+		ids = append(ids, id)
+	})
+
+	var settled []types.Distribution
+	for _, id := range ids {
+		if limit <= 0 {
+			break
+		}
+		d := keeper.GetDistribution(ctx, id)
+		if d == nil {
+			continue
+		}
+		if s := keeper.GetTokenSnapshot(ctx, d.SnapshotID); s == nil || !s.Taken() {
+			continue
+		}
+		processed, done := keeper.processDistribution(ctx, d, limit)
+		limit -= processed
+		if done {
+			settled = append(settled, *d)
+		}
+	}
+	return settled
+}
+
+// processDistribution sums up the eligible balances first, then pays the shares, and returns the number
+// of the processed balances and whether the distribution is settled
+func (keeper BaseKeeper) processDistribution(ctx sdk.Context, d *types.Distribution, limit int) (processed int, settled bool) {
+	for !settled {
+		if d.Counted && !d.Eligible.IsPositive() {
+			keeper.settleDistribution(ctx, d)
+			settled = true
+			break
+		}
+
+		exhausted := true
+		keeper.iterateSnapshotBalancesAfter(ctx, d.SnapshotID, d.Cursor, func(b types.SnapshotBalance) bool {
+			if processed == limit {
+				exhausted = false
+				return true
+			}
+			processed++
+			d.Cursor = b.Address
+			if d.IsExcluded(b.Address) || keeper.bkx.BlacklistedAddr(b.Address) {
+				return false
+			}
+			if d.Counted {
+				keeper.payShare(ctx, d, b.Address, d.ShareOf(b.Amount))
+			} else {
+				d.Eligible = d.Eligible.Add(b.Amount)
+			}
+			return false
+		})
+		if !exhausted {
+			break
+		}
+
+		d.Cursor = nil
+		if !d.Counted {
+			d.Counted = true
+		} else {
+			keeper.settleDistribution(ctx, d)
+			settled = true
+		}
+	}
+	keeper.SetDistribution(ctx, *d)
+	return
+}
+
+// payShare pays a holder all or nothing, the share which fails to be paid is returned as dust,
+// so is the share of a holder which is forbidden or not whitelisted by the issuer of the paid token
+func (keeper BaseKeeper) payShare(ctx sdk.Context, d *types.Distribution, addr sdk.AccAddress, share sdk.Int) {
+	if !share.IsPositive() {
+		return
+	}
+	if keeper.IsForbiddenByTokenIssuer(ctx, d.Denom, addr) || !keeper.IsPermittedByTokenIssuer(ctx, d.Denom, addr) {
+		return
+	}
+	coins := types.NewTokenCoins(d.Denom, share)
+	cacheCtx, write := ctx.CacheContext()
+	if err := keeper.bkx.UnFreezeCoins(cacheCtx, d.Distributor, coins); err != nil {
+		ctx.Logger().Error("failed to unfreeze distribution share", "id", d.ID, "error", err.Error())
+		return
+	}
+	if err := keeper.bkx.SendCoins(cacheCtx, d.Distributor, addr, coins); err != nil {
+		ctx.Logger().Error("failed to pay distribution share", "id", d.ID, "holder", addr.String(), "error", err.Error())
+		return
+	}
+	write()
+	d.Distributed = d.Distributed.Add(share)
+	d.Recipients++
+}
+
+// settleDistribution returns the rounding dust to the distributor
+func (keeper BaseKeeper) settleDistribution(ctx sdk.Context, d *types.Distribution) {
+	if dust := d.Dust(); dust.IsPositive() {
+		if err := keeper.bkx.UnFreezeCoins(ctx, d.Distributor, types.NewTokenCoins(d.Denom, dust)); err != nil {
+			ctx.Logger().Error("failed to return distribution dust", "id", d.ID, "error", err.Error())
+		}
+	}
+	d.SettleHeight = ctx.BlockHeight()
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetPendingDistributionStoreKey(d.ID))
+}
+
+func (keeper BaseKeeper) nextDistributionID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	var id uint64 = 1
+	if bz := store.Get(types.NextDistributionIDKey); bz != nil {
+		id = binary.BigEndian.Uint64(bz)
+	}
+	keeper.SetNextDistributionID(ctx, id+1)
+	return id
+}
+
+// SetNextDistributionID - set the id of the next distribution, used by genesis import
+func (keeper BaseKeeper) SetNextDistributionID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.NextDistributionIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// ImportGenesisDistribution - import a distribution from genesis.json, the one which is not settled yet
+// will be processed from the next EndBlock
+func (keeper BaseKeeper) ImportGenesisDistribution(ctx sdk.Context, d types.Distribution) {
+	keeper.SetDistribution(ctx, d)
+	if !d.Settled() {
+		keeper.setPendingDistribution(ctx, d.ID)
+	}
+}
+
+func (keeper BaseKeeper) setPendingDistribution(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetPendingDistributionStoreKey(id), []byte{})
+}
+
+func (keeper BaseKeeper) iteratePendingDistributionIDs(ctx sdk.Context, process func(id uint64)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PendingDistributionKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		process(binary.BigEndian.Uint64(iter.Key()[len(types.PendingDistributionKey):]))
+	}
+}
+
+func (keeper BaseTokenKeeper) SetDistribution(ctx sdk.Context, d types.Distribution) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetDistributionStoreKey(d.ID), keeper.cdc.MustMarshalBinaryBare(d))
+}
+
+// GetDistribution - return the distribution of the id, or nil if it does not exist
+func (keeper BaseTokenKeeper) GetDistribution(ctx sdk.Context, id uint64) *types.Distribution {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GetDistributionStoreKey(id))
+	if bz == nil {
+		return nil
+	}
+	var d types.Distribution
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &d)
+	return &d
+}
+
+// GetTokenDistributions - return the distributions to the holders of a token, the earliest first
+func (keeper BaseTokenKeeper) GetTokenDistributions(ctx sdk.Context, symbol string) []types.Distribution {
+	res := make([]types.Distribution, 0)
+	keeper.iterateDistributions(ctx, func(d types.Distribution) {
+		if d.Symbol == symbol {
+			res = append(res, d)
+		}
+	})
+	return res
+}
+
+// GetAllDistributions - return all the distributions, which is used to export genesis.json
+func (keeper BaseTokenKeeper) GetAllDistributions(ctx sdk.Context) []types.Distribution {
+	res := make([]types.Distribution, 0)
+	keeper.iterateDistributions(ctx, func(d types.Distribution) {
+		res = append(res, d)
+	})
+	return res
+}
+
+func (keeper BaseTokenKeeper) iterateDistributions(ctx sdk.Context, process func(d types.Distribution)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DistributionKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var d types.Distribution
+		keeper.cdc.MustUnmarshalBinaryBare(iter.Value(), &d)
+		process(d)
+	}
+}
@@ -0,0 +1,139 @@
+package keepers
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// AfterBalanceChanged - implement bankx hooks, update the holder index of the tokens whose amounts held by addr
+// may have been changed, CET is not indexed
+func (keeper BaseTokenKeeper) AfterBalanceChanged(ctx sdk.Context, addr sdk.AccAddress, totalCoins sdk.Coins, denoms []string) {
+	for _, denom := range denoms {
+		if types.IsHolderIndexed(denom) && keeper.IsTokenExists(ctx, denom) {
+			keeper.SetHolderAmount(ctx, denom, addr, totalCoins.AmountOf(denom))
+		}
+	}
+}
+
+// updateHolderIndex - update the holder index for the coins moved by supply keeper, which bypass bankx hooks
+func (keeper BaseKeeper) updateHolderIndex(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) {
+	totalCoins := keeper.bkx.GetTotalCoins(ctx, addr)
+	for _, coin := range amt {
+		if types.IsHolderIndexed(coin.Denom) {
+			keeper.SetHolderAmount(ctx, coin.Denom, addr, totalCoins.AmountOf(coin.Denom))
+		}
+	}
+}
+
+// RebuildHolderIndex - build the holder index of all the tokens from the balances of all the accounts,
+// which is used to import genesis.json after the accounts are imported
+func (keeper BaseKeeper) RebuildHolderIndex(ctx sdk.Context) {
+	keeper.bkx.IterateTotalCoins(ctx, func(addr sdk.AccAddress, coins sdk.Coins) bool {
+		for _, coin := range coins {
+			if types.IsHolderIndexed(coin.Denom) && keeper.IsTokenExists(ctx, coin.Denom) {
+				keeper.SetHolderAmount(ctx, coin.Denom, addr, coin.Amount)
+			}
+		}
+		return false
+	})
+	ctx.KVStore(keeper.storeKey).Set(types.HolderIndexBuiltKey, []byte{})
+}
+
+// BuildHolderIndexOnce - build the holder index if it has never been built, which is the case of the
+// chains upgraded in place from a version without the index. Returns true if the index is built
+func (keeper BaseKeeper) BuildHolderIndexOnce(ctx sdk.Context) bool {
+	if ctx.KVStore(keeper.storeKey).Has(types.HolderIndexBuiltKey) {
+		return false
+	}
+	keeper.RebuildHolderIndex(ctx)
+	return true
+}
+
+// SetHolderAmount - set the amount of a token held by addr in the holder index, a zero amount removes addr
+func (keeper BaseTokenKeeper) SetHolderAmount(ctx sdk.Context, symbol string, addr sdk.AccAddress, amount sdk.Int) {
+	store := ctx.KVStore(keeper.storeKey)
+	key := types.GetHolderStoreKey(symbol, addr)
+	bz := store.Get(key)
+	if bz == nil && !amount.IsPositive() {
+		return
+	}
+
+	count := keeper.GetHolderCount(ctx, symbol)
+	if bz != nil {
+		var old sdk.Int
+		keeper.cdc.MustUnmarshalBinaryBare(bz, &old)
+		if old.Equal(amount) {
+			return
+		}
+		store.Delete(types.GetHolderRankStoreKey(symbol, old, addr))
+		store.Delete(key)
+		count--
+	}
+
+	if amount.IsPositive() {
+		store.Set(key, keeper.cdc.MustMarshalBinaryBare(amount))
+		store.Set(types.GetHolderRankStoreKey(symbol, amount, addr), []byte{})
+		count++
+	}
+	store.Set(types.GetHolderCountStoreKey(symbol), sdk.Uint64ToBigEndian(count))
+}
+
+// GetHolderCount - return the number of the addresses holding a token
+func (keeper BaseTokenKeeper) GetHolderCount(ctx sdk.Context, symbol string) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GetHolderCountStoreKey(symbol))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// GetHolderAmount - return the amount of a token held by addr in the holder index
+func (keeper BaseTokenKeeper) GetHolderAmount(ctx sdk.Context, symbol string, addr sdk.AccAddress) sdk.Int {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GetHolderStoreKey(symbol, addr))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var amount sdk.Int
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &amount)
+	return amount
+}
+
+// GetHolderRank - return the rank of addr among the holders of a token, nil if addr does not hold it
+func (keeper BaseTokenKeeper) GetHolderRank(ctx sdk.Context, symbol string, addr sdk.AccAddress) *types.TokenHolder {
+	amount := keeper.GetHolderAmount(ctx, symbol, addr)
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	// the holders ranked before addr are those after it in the rank keys
+	store := ctx.KVStore(keeper.storeKey)
+	start := append(types.GetHolderRankStoreKey(symbol, amount, addr), 0)
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.GetHolderRankKeyPrefix(symbol)))
+	defer iter.Close()
+	var rank uint64 = 1
+	for ; iter.Valid(); iter.Next() {
+		rank++
+	}
+	holder := types.NewTokenHolder(addr, amount, rank)
+	return &holder
+}
+
+// IterateTopHolders - iterate the holders of a token from the largest amount
+func (keeper BaseTokenKeeper) IterateTopHolders(ctx sdk.Context, symbol string, process func(h types.TokenHolder) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStoreReversePrefixIterator(store, types.GetHolderRankKeyPrefix(symbol))
+	defer iter.Close()
+	var rank uint64
+	for ; iter.Valid(); iter.Next() {
+		rank++
+		amount, addr := types.SplitHolderRankStoreKey(iter.Key(), symbol)
+		if process(types.NewTokenHolder(addr, amount, rank)) {
+			return
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// SetTokenHolderBurnable - allow or disallow the holders to burn their own tokens for redemption
+func (keeper BaseKeeper) SetTokenHolderBurnable(ctx sdk.Context, symbol string, owner sdk.AccAddress, enable bool) sdk.Error {
+	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	if err != nil {
+		return err
+	}
+
+	if enable == token.GetHolderBurnable() {
+		return nil
+	}
+	if enable && !token.GetBurnable() {
+		return types.ErrTokenBurnNotSupported(symbol)
+	}
+
+	// the holders may rely on the redemption once the token has been distributed
+	ownerAmt := keeper.bkx.GetTotalCoins(ctx, owner).AmountOf(symbol)
+	distributed := !ownerAmt.Equal(token.GetTotalSupply())
+	if distributed && !enable {
+		return types.ErrCodeTokenInfoSealed("HolderBurnable")
+	}
+
+	token.SetHolderBurnable(enable)
+	return keeper.SetToken(ctx, token)
+}
+
+// HolderBurnToken - burn the tokens of a holder, who expects the issuer to redeem them off chain
+func (keeper BaseKeeper) HolderBurnToken(ctx sdk.Context, symbol string, holder sdk.AccAddress, amount sdk.Int, memo string) (types.TokenRedemption, sdk.Error) {
+	token := keeper.GetToken(ctx, symbol)
+	if token == nil {
+		return types.TokenRedemption{}, types.ErrTokenNotFound(symbol)
+	}
+
+	if !token.GetBurnable() || !token.GetHolderBurnable() {
+		return types.TokenRedemption{}, types.ErrTokenHolderBurnNotSupported(symbol)
+	}
+
+	if keeper.IsForbiddenByTokenIssuer(ctx, symbol, holder) {
+		return types.TokenRedemption{}, types.ErrHolderBurnForbidden(symbol, holder)
+	}
+
+	// the amount frozen by the owner can not be redeemed either
+	frozen := keeper.GetIssuerFrozenAmount(ctx, symbol, holder)
+	if frozen.IsPositive() && keeper.bkx.GetCoins(ctx, holder).AmountOf(symbol).Sub(amount).LT(frozen) {
+		return types.TokenRedemption{}, types.ErrHolderBurnForbidden(symbol, holder)
+	}
+
+	if err := types.ValidateRedemptionMemo(memo); err != nil {
+		return types.TokenRedemption{}, err
+	}
+
+	burntCoins := types.NewTokenCoins(symbol, amount)
+	if err := keeper.SendCoinsFromAccountToAssetModule(ctx, holder, burntCoins); err != nil {
+		return types.TokenRedemption{}, err
+	}
+
+	if err := token.SetTotalBurn(token.GetTotalBurn().Add(amount)); err != nil {
+		return types.TokenRedemption{}, err
+	}
+
+	if err := token.SetTotalSupply(token.GetTotalSupply().Sub(amount)); err != nil {
+		return types.TokenRedemption{}, err
+	}
+
+	if err := keeper.SetToken(ctx, token); err != nil {
+		return types.TokenRedemption{}, err
+	}
+
+	if err := keeper.sk.BurnCoins(ctx, types.ModuleName, burntCoins); err != nil {
+		return types.TokenRedemption{}, err
+	}
+
+	return types.NewTokenRedemption(symbol, holder, token.GetOwner(), amount, memo, ctx.BlockHeight()), nil
+}
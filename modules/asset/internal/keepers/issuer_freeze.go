@@ -0,0 +1,128 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// FreezeTokenAmount - freeze an amount of token on an address, which can not be moved until it is unfrozen,
+// the frozen amounts of an address add up
+func (keeper BaseKeeper) FreezeTokenAmount(ctx sdk.Context, symbol string, owner sdk.AccAddress, addr sdk.AccAddress, amount sdk.Int) (sdk.Int, sdk.Error) {
+	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	if !token.GetAddrForbiddable() {
+		return sdk.ZeroInt(), types.ErrAddressForbiddenNotSupported(symbol)
+	}
+	if addr.Equals(owner) {
+		return sdk.ZeroInt(), types.ErrTokenOwnerSelfForbidden()
+	}
+	if !amount.IsPositive() {
+		return sdk.ZeroInt(), types.ErrInvalidFreezeAmount(amount.String())
+	}
+
+	frozen := keeper.GetIssuerFrozenAmount(ctx, symbol, addr).Add(amount)
+	keeper.SetIssuerFrozenAmount(ctx, types.NewIssuerFrozenAmount(symbol, addr, frozen))
+	return frozen, nil
+}
+
+// UnfreezeTokenAmount - unfreeze a part or all of the amount frozen on an address
+func (keeper BaseKeeper) UnfreezeTokenAmount(ctx sdk.Context, symbol string, owner sdk.AccAddress, addr sdk.AccAddress, amount sdk.Int) (sdk.Int, sdk.Error) {
+	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	if !token.GetAddrForbiddable() {
+		return sdk.ZeroInt(), types.ErrAddressForbiddenNotSupported(symbol)
+	}
+	if !amount.IsPositive() {
+		return sdk.ZeroInt(), types.ErrInvalidFreezeAmount(amount.String())
+	}
+
+	frozen := keeper.GetIssuerFrozenAmount(ctx, symbol, addr)
+	if frozen.LT(amount) {
+		return sdk.ZeroInt(), types.ErrInsufficientFrozenAmount(frozen)
+	}
+
+	frozen = frozen.Sub(amount)
+	if frozen.IsZero() {
+		keeper.RemoveIssuerFrozenAmount(ctx, symbol, addr)
+	} else {
+		keeper.SetIssuerFrozenAmount(ctx, types.NewIssuerFrozenAmount(symbol, addr, frozen))
+	}
+	return frozen, nil
+}
+
+// removeIssuerFrozenAmounts - unfreeze all the amounts of a token, when it is no longer addr forbiddable
+func (keeper BaseKeeper) removeIssuerFrozenAmounts(ctx sdk.Context, symbol string) {
+	for _, f := range keeper.GetIssuerFrozenAmounts(ctx, symbol) {
+		keeper.RemoveIssuerFrozenAmount(ctx, symbol, f.Address)
+	}
+}
+
+// SetIssuerFrozenAmount - set the frozen amount of an address, which is also used to import genesis.json
+func (keeper BaseTokenKeeper) SetIssuerFrozenAmount(ctx sdk.Context, f types.IssuerFrozenAmount) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetIssuerFrozenStoreKey(f.Symbol, f.Address), keeper.cdc.MustMarshalBinaryBare(f))
+}
+
+func (keeper BaseTokenKeeper) RemoveIssuerFrozenAmount(ctx sdk.Context, symbol string, addr sdk.AccAddress) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetIssuerFrozenStoreKey(symbol, addr))
+}
+
+// GetIssuerFrozenAmount - return the amount of token frozen on an address by the token owner
+func (keeper BaseTokenKeeper) GetIssuerFrozenAmount(ctx sdk.Context, symbol string, addr sdk.AccAddress) sdk.Int {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GetIssuerFrozenStoreKey(symbol, addr))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var f types.IssuerFrozenAmount
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &f)
+	return f.Amount
+}
+
+// GetIssuerFrozenAmounts - return the frozen amounts of a token
+func (keeper BaseTokenKeeper) GetIssuerFrozenAmounts(ctx sdk.Context, symbol string) []types.IssuerFrozenAmount {
+	res := make([]types.IssuerFrozenAmount, 0)
+	keeper.iterateIssuerFrozenAmounts(ctx, types.GetIssuerFrozenKeyPrefix(symbol), func(f types.IssuerFrozenAmount) {
+		res = append(res, f)
+	})
+	return res
+}
+
+// GetAddrIssuerFrozenAmounts - return the frozen amounts of an address, over all the tokens
+func (keeper BaseTokenKeeper) GetAddrIssuerFrozenAmounts(ctx sdk.Context, addr sdk.AccAddress) []types.IssuerFrozenAmount {
+	res := make([]types.IssuerFrozenAmount, 0)
+	keeper.iterateIssuerFrozenAmounts(ctx, types.IssuerFrozenKey, func(f types.IssuerFrozenAmount) {
+		if f.Address.Equals(addr) {
+			res = append(res, f)
+		}
+	})
+	return res
+}
+
+// GetAllIssuerFrozenAmounts - return the frozen amounts of all the tokens, which is used to export genesis.json
+func (keeper BaseTokenKeeper) GetAllIssuerFrozenAmounts(ctx sdk.Context) []types.IssuerFrozenAmount {
+	res := make([]types.IssuerFrozenAmount, 0)
+	keeper.iterateIssuerFrozenAmounts(ctx, types.IssuerFrozenKey, func(f types.IssuerFrozenAmount) {
+		res = append(res, f)
+	})
+	return res
+}
+
+func (keeper BaseTokenKeeper) iterateIssuerFrozenAmounts(ctx sdk.Context, prefix []byte, process func(f types.IssuerFrozenAmount)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var f types.IssuerFrozenAmount
+		keeper.cdc.MustUnmarshalBinaryBare(iter.Value(), &f)
+		process(f)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+	"github.com/coinexchain/cet-sdk/msgqueue"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
 
@@ -26,6 +27,8 @@ type Keeper interface {
 		mintable bool, burnable bool, addrForbiddable bool, tokenForbiddable bool,
 		url string, description string, identity string) sdk.Error
 	TransferOwnership(ctx sdk.Context, symbol string, originalOwner sdk.AccAddress, newOwner sdk.AccAddress) sdk.Error
+	AcceptOwnership(ctx sdk.Context, symbol string, newOwner sdk.AccAddress) (types.PendingOwnershipTransfer, sdk.Error)
+	CancelOwnershipTransfer(ctx sdk.Context, symbol string, owner sdk.AccAddress) (types.PendingOwnershipTransfer, sdk.Error)
 	MintToken(ctx sdk.Context, symbol string, owner sdk.AccAddress, amount sdk.Int) sdk.Error
 	BurnToken(ctx sdk.Context, symbol string, owner sdk.AccAddress, amount sdk.Int) sdk.Error
 	ForbidToken(ctx sdk.Context, symbol string, owner sdk.AccAddress) sdk.Error
@@ -37,6 +40,30 @@ type Keeper interface {
 	ModifyTokenInfo(ctx sdk.Context, symbol string, owner sdk.AccAddress,
 		url, description, identity, name string, totalSupply sdk.Int,
 		mintable, burnable, addrForbiddable, tokenForbiddable bool) sdk.Error
+	SetMintSchedule(ctx sdk.Context, symbol string, owner sdk.AccAddress, schedule types.MintSchedule) sdk.Error
+	ExecuteMintSchedule(ctx sdk.Context, info types.MintScheduleInfo) ([]types.MintTranche, sdk.Error)
+	StopMintSchedule(ctx sdk.Context, info types.MintScheduleInfo)
+	SetTokenCoOwners(ctx sdk.Context, symbol string, owner sdk.AccAddress, coOwners []sdk.AccAddress, threshold uint32) sdk.Error
+	ProposeTokenAction(ctx sdk.Context, proposer sdk.AccAddress, action sdk.Msg, lifetime int64) (types.TokenActionProposal, bool, sdk.Error)
+	ApproveTokenAction(ctx sdk.Context, symbol string, id uint64, approver sdk.AccAddress) (types.TokenActionProposal, bool, sdk.Error)
+	SetTokenHolderBurnable(ctx sdk.Context, symbol string, owner sdk.AccAddress, enable bool) sdk.Error
+	SetTokenPermissioned(ctx sdk.Context, symbol string, owner sdk.AccAddress) sdk.Error
+	SetTokenMetadata(ctx sdk.Context, symbol string, owner sdk.AccAddress, metadata types.TokenMetadata) sdk.Error
+	HolderBurnToken(ctx sdk.Context, symbol string, holder sdk.AccAddress, amount sdk.Int, memo string) (types.TokenRedemption, sdk.Error)
+	FreezeTokenAmount(ctx sdk.Context, symbol string, owner sdk.AccAddress, addr sdk.AccAddress, amount sdk.Int) (sdk.Int, sdk.Error)
+	UnfreezeTokenAmount(ctx sdk.Context, symbol string, owner sdk.AccAddress, addr sdk.AccAddress, amount sdk.Int) (sdk.Int, sdk.Error)
+	RequestTokenSnapshot(ctx sdk.Context, symbol string, requester sdk.AccAddress) (types.TokenSnapshot, sdk.Error)
+	TakePendingSnapshots(ctx sdk.Context, limit int) []types.TokenSnapshot
+	RemoveExpiredSnapshots(ctx sdk.Context, limit int)
+	OpenSymbolAuction(ctx sdk.Context, symbol string, bidder sdk.AccAddress, amount sdk.Int) (types.SymbolAuction, sdk.Error)
+	BidSymbolAuction(ctx sdk.Context, symbol string, bidder sdk.AccAddress, amount sdk.Int) (types.SymbolAuction, sdk.Error)
+	SettleEndedSymbolAuctions(ctx sdk.Context) []types.SymbolReservation
+	RemoveExpiredSymbolReservations(ctx sdk.Context) []types.SymbolReservation
+	DistributeToHolders(ctx sdk.Context, symbol string, owner sdk.AccAddress, denom string, amount sdk.Int, excluded []sdk.AccAddress) (types.Distribution, sdk.Error)
+	ProcessDistributions(ctx sdk.Context, limit int) []types.Distribution
+	SetMintPolicy(ctx sdk.Context, symbol string, owner sdk.AccAddress, policy types.MintPolicy) sdk.Error
+	RebuildHolderIndex(ctx sdk.Context)
+	BuildHolderIndexOnce(ctx sdk.Context) bool
 
 	SetParams(ctx sdk.Context, params types.Params)
 	GetParams(ctx sdk.Context) (params types.Params)
@@ -57,11 +84,18 @@ type BaseKeeper struct {
 
 	bkx types.ExpectedBankxKeeper
 	sk  types.ExpectedSupplyKeeper
+	dk  types.ExpectedDistributionxKeeper
+
+	msgProducer msgqueue.MsgSender
+
+	// set when executing an action which has been approved by the co-owners of its token
+	actionApproved bool
 }
 
 // NewBaseKeeper returns a new BaseKeeper that uses go-amino to (binary) encode and decode concrete Token.
 func NewBaseKeeper(cdc *codec.Codec, key sdk.StoreKey,
-	paramStore params.Subspace, bkx types.ExpectedBankxKeeper, sk supply.Keeper) BaseKeeper {
+	paramStore params.Subspace, bkx types.ExpectedBankxKeeper, sk supply.Keeper, dk types.ExpectedDistributionxKeeper,
+	mq msgqueue.MsgSender) BaseKeeper {
 	return BaseKeeper{
 		BaseTokenKeeper: NewBaseTokenKeeper(cdc, key),
 
@@ -70,6 +104,8 @@ func NewBaseKeeper(cdc *codec.Codec, key sdk.StoreKey,
 		paramSubspace: paramStore.WithKeyTable(ParamKeyTable()),
 		bkx:           bkx,
 		sk:            sk,
+		dk:            dk,
+		msgProducer:   mq,
 	}
 }
 
@@ -85,9 +121,18 @@ func (keeper BaseKeeper) IssueToken(ctx sdk.Context, name string, symbol string,
 		return types.ErrDuplicateTokenSymbol(symbol)
 	}
 
+	// a symbol in auction can not be issued, and a reserved one can only be issued by the auction winner
+	if keeper.GetSymbolAuction(ctx, symbol) != nil {
+		return types.ErrSymbolInAuction(symbol)
+	}
+	reservation := keeper.GetActiveSymbolReservation(ctx, symbol)
+	if reservation != nil && !reservation.Winner.Equals(owner) {
+		return types.ErrSymbolReserved(symbol, reservation.Winner)
+	}
+
 	var cetToken types.Token
-	// only cet owner can issue reserved token
-	if types.IsReservedSymbol(symbol) && symbol != dex.CET {
+	// only cet owner can issue reserved token, unless the symbol is won in an auction
+	if types.IsReservedSymbol(symbol) && symbol != dex.CET && reservation == nil {
 		cetToken = keeper.GetToken(ctx, dex.CET)
 		if cetToken == nil || !owner.Equals(cetToken.GetOwner()) {
 			return types.ErrInvalidIssueOwner()
@@ -123,25 +168,30 @@ func (keeper BaseKeeper) IssueToken(ctx sdk.Context, name string, symbol string,
 	if err := keeper.SetToken(ctx, token); err != nil {
 		return err
 	}
+	if reservation != nil {
+		keeper.RemoveSymbolReservation(ctx, symbol)
+	}
 
 	return keeper.sk.MintCoins(ctx, types.ModuleName, types.NewTokenCoins(symbol, totalSupply))
 }
 
-// TransferOwnership - transfer token owner
+// TransferOwnership - nominate the new owner of a token, who must accept the ownership before the deadline
 func (keeper BaseKeeper) TransferOwnership(ctx sdk.Context, symbol string, originalOwner sdk.AccAddress, newOwner sdk.AccAddress) sdk.Error {
 	if keeper.bkx.BlacklistedAddr(newOwner) {
 		return types.ErrAccInBlackList(newOwner)
 	}
-	token, err := keeper.checkPrecondition(ctx, symbol, originalOwner)
-	if err != nil {
+	if _, err := keeper.checkPrecondition(ctx, symbol, originalOwner); err != nil {
 		return err
 	}
 
-	if err := token.SetOwner(newOwner); err != nil {
+	deadline := ctx.BlockHeader().Time.Unix() + types.OwnershipTransferTimeout
+	transfer := types.NewPendingOwnershipTransfer(symbol, originalOwner, newOwner, deadline)
+	if err := transfer.Validate(); err != nil {
 		return err
 	}
 
-	return keeper.SetToken(ctx, token)
+	keeper.SetPendingOwnershipTransfer(ctx, transfer)
+	return nil
 }
 
 // MintToken - mint token
@@ -155,6 +205,14 @@ func (keeper BaseKeeper) MintToken(ctx sdk.Context, symbol string, owner sdk.Acc
 		return types.ErrTokenMintNotSupported(symbol)
 	}
 
+	if token.GetMintScheduled() {
+		return types.ErrTokenMintScheduled(symbol)
+	}
+
+	if err := keeper.applyMintPolicy(ctx, token, amount); err != nil {
+		return err
+	}
+
 	if err := token.SetTotalMint(token.GetTotalMint().Add(amount)); err != nil {
 		return err
 	}
@@ -240,7 +298,8 @@ func (keeper BaseKeeper) AddTokenWhitelist(ctx sdk.Context, symbol string, owner
 		return err
 	}
 
-	if !token.GetTokenForbiddable() {
+	// the whitelist of a permissioned token holds the permitted addresses
+	if !token.GetTokenForbiddable() && !token.GetPermissioned() {
 		return types.ErrTokenForbiddenNotSupported(symbol)
 	}
 	if err = keeper.addWhitelist(ctx, symbol, whitelist); err != nil {
@@ -256,7 +315,8 @@ func (keeper BaseKeeper) RemoveTokenWhitelist(ctx sdk.Context, symbol string, ow
 		return err
 	}
 
-	if !token.GetTokenForbiddable() {
+	// the whitelist of a permissioned token holds the permitted addresses
+	if !token.GetTokenForbiddable() && !token.GetPermissioned() {
 		return types.ErrTokenForbiddenNotSupported(symbol)
 	}
 	if err = keeper.removeWhitelist(ctx, symbol, whitelist); err != nil {
@@ -340,6 +400,9 @@ func (keeper BaseKeeper) ModifyTokenInfo(ctx sdk.Context, symbol string, owner s
 		if distributed {
 			return types.ErrCodeTokenInfoSealed("TotalSupply")
 		}
+		if policy := token.GetMintPolicy(); policy != nil && policy.HasSupplyCap() && totalSupply.GT(policy.SupplyCap) {
+			return types.ErrMintCapExceeded(symbol, policy.SupplyCap)
+		}
 		if err := token.SetTotalSupply(totalSupply); err != nil {
 			return err
 		}
@@ -366,7 +429,7 @@ func (keeper BaseKeeper) ModifyTokenInfo(ctx sdk.Context, symbol string, owner s
 
 	// modifiable (with limitation) after distribution
 	if mintable != token.GetMintable() {
-		if distributed && mintable {
+		if (distributed && mintable) || token.GetMintScheduled() {
 			return types.ErrCodeTokenInfoSealed("Mintable")
 		}
 		token.SetMintable(mintable)
@@ -382,6 +445,7 @@ func (keeper BaseKeeper) ModifyTokenInfo(ctx sdk.Context, symbol string, owner s
 		}
 		token.SetBurnable(burnable)
 		if !burnable {
+			token.SetHolderBurnable(false)
 			if err := token.SetTotalBurn(sdk.ZeroInt()); err != nil {
 				return err
 			}
@@ -397,6 +461,7 @@ func (keeper BaseKeeper) ModifyTokenInfo(ctx sdk.Context, symbol string, owner s
 			if err := keeper.removeForbiddenAddress(ctx, symbol, blackList); err != nil {
 				return err
 			}
+			keeper.removeIssuerFrozenAmounts(ctx, symbol)
 		}
 	}
 	if tokenForbiddable != token.GetTokenForbiddable() {
@@ -413,11 +478,19 @@ func (keeper BaseKeeper) ModifyTokenInfo(ctx sdk.Context, symbol string, owner s
 }
 
 func (keeper BaseKeeper) SendCoinsFromAssetModuleToAccount(ctx sdk.Context, addresses sdk.AccAddress, amt sdk.Coins) sdk.Error {
-	return keeper.sk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addresses, amt)
+	if err := keeper.sk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addresses, amt); err != nil {
+		return err
+	}
+	keeper.updateHolderIndex(ctx, addresses, amt)
+	return nil
 }
 
 func (keeper BaseKeeper) SendCoinsFromAccountToAssetModule(ctx sdk.Context, addresses sdk.AccAddress, amt sdk.Coins) sdk.Error {
-	return keeper.sk.SendCoinsFromAccountToModule(ctx, addresses, types.ModuleName, amt)
+	if err := keeper.sk.SendCoinsFromAccountToModule(ctx, addresses, types.ModuleName, amt); err != nil {
+		return err
+	}
+	keeper.updateHolderIndex(ctx, addresses, amt)
+	return nil
 }
 
 // DeductIssueFee - deduct issue token fee
@@ -445,6 +518,10 @@ func (keeper BaseKeeper) checkPrecondition(ctx sdk.Context, symbol string, owner
 		return nil, types.ErrNeedTokenOwner(token.GetOwner())
 	}
 
+	if !keeper.actionApproved && keeper.GetTokenCoOwners(ctx, symbol) != nil {
+		return nil, types.ErrTokenActionNeedsApproval(symbol)
+	}
+
 	return token, nil
 }
 
@@ -510,12 +587,42 @@ type TokenKeeper interface {
 	GetAllTokens(ctx sdk.Context) []types.Token
 	GetWhitelist(ctx sdk.Context, symbol string) []sdk.AccAddress
 	GetForbiddenAddresses(ctx sdk.Context, symbol string) []sdk.AccAddress
+	GetMintSchedule(ctx sdk.Context, symbol string) *types.MintScheduleInfo
+	GetDueMintSchedules(ctx sdk.Context) []types.MintScheduleInfo
+	GetTokenCoOwners(ctx sdk.Context, symbol string) *types.TokenCoOwners
+	GetTokenActionProposal(ctx sdk.Context, symbol string, id uint64) *types.TokenActionProposal
+	GetTokenActionProposals(ctx sdk.Context, symbol string) []types.TokenActionProposal
+	GetPendingOwnershipTransfer(ctx sdk.Context, symbol string) *types.PendingOwnershipTransfer
+	GetAllPendingOwnershipTransfers(ctx sdk.Context) []types.PendingOwnershipTransfer
+	GetIssuerFrozenAmount(ctx sdk.Context, symbol string, addr sdk.AccAddress) sdk.Int
+	GetIssuerFrozenAmounts(ctx sdk.Context, symbol string) []types.IssuerFrozenAmount
+	GetAddrIssuerFrozenAmounts(ctx sdk.Context, addr sdk.AccAddress) []types.IssuerFrozenAmount
+	GetAllIssuerFrozenAmounts(ctx sdk.Context) []types.IssuerFrozenAmount
+	GetTokenSnapshot(ctx sdk.Context, id uint64) *types.TokenSnapshot
+	GetTokenSnapshots(ctx sdk.Context, symbol string) []types.TokenSnapshot
+	GetAllTokenSnapshots(ctx sdk.Context) []types.TokenSnapshot
+	IterateSnapshotBalances(ctx sdk.Context, id uint64, process func(b types.SnapshotBalance) (stop bool))
+	GetAllSnapshotBalances(ctx sdk.Context) []types.SnapshotBalance
+	GetSymbolAuction(ctx sdk.Context, symbol string) *types.SymbolAuction
+	GetAllSymbolAuctions(ctx sdk.Context) []types.SymbolAuction
+	GetSymbolReservation(ctx sdk.Context, symbol string) *types.SymbolReservation
+	GetAllSymbolReservations(ctx sdk.Context) []types.SymbolReservation
+	GetDistribution(ctx sdk.Context, id uint64) *types.Distribution
+	GetTokenDistributions(ctx sdk.Context, symbol string) []types.Distribution
+	GetAllDistributions(ctx sdk.Context) []types.Distribution
+	GetMintPolicyInfo(ctx sdk.Context, symbol string) *types.MintPolicyInfo
+	GetAllMintRecords(ctx sdk.Context) []types.MintRecord
+	GetHolderCount(ctx sdk.Context, symbol string) uint64
+	GetHolderRank(ctx sdk.Context, symbol string, addr sdk.AccAddress) *types.TokenHolder
+	IterateTopHolders(ctx sdk.Context, symbol string, process func(h types.TokenHolder) (stop bool))
 
 	IsTokenForbidden(ctx sdk.Context, symbol string) bool
 	IsTokenExists(ctx sdk.Context, symbol string) bool
 	IsTokenIssuer(ctx sdk.Context, symbol string, addr sdk.AccAddress) bool
 	IsForbiddenByTokenIssuer(ctx sdk.Context, symbol string, addr sdk.AccAddress) bool
+	IsPermittedByTokenIssuer(ctx sdk.Context, symbol string, addr sdk.AccAddress) bool
 	UpdateTokenSendLock(ctx sdk.Context, symbol string, amount sdk.Int, lock bool) sdk.Error
+	AfterBalanceChanged(ctx sdk.Context, addr sdk.AccAddress, totalCoins sdk.Coins, denoms []string)
 }
 
 var _ TokenKeeper = (*BaseTokenKeeper)(nil)
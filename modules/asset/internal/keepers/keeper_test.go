@@ -2,7 +2,9 @@ package keepers_test
 
 import (
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -11,6 +13,8 @@ import (
 
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
 	"github.com/coinexchain/cet-sdk/modules/authx"
+	"github.com/coinexchain/cet-sdk/modules/bankx"
+	dex "github.com/coinexchain/cet-sdk/types"
 )
 
 func TestTokenKeeper_IssueToken(t *testing.T) {
@@ -168,6 +172,9 @@ func TestTokenKeeper_TransferOwnership(t *testing.T) {
 
 	err = input.tk.TransferOwnership(input.ctx, symbol, testAddr, addr1)
 	require.NoError(t, err)
+	require.Equal(t, testAddr, input.tk.GetToken(input.ctx, symbol).GetOwner())
+	_, err = input.tk.AcceptOwnership(input.ctx, symbol, addr1)
+	require.NoError(t, err)
 
 	// get token
 	token := input.tk.GetToken(input.ctx, symbol)
@@ -191,6 +198,49 @@ func TestTokenKeeper_TransferOwnership(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestTokenKeeper_PendingOwnershipTransfer(t *testing.T) {
+	input := createTestInput()
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+	symbol := "abc"
+	addrs := mockAddrList()
+
+	err := input.tk.IssueToken(ctx, "ABC token", symbol, sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+
+	// nothing to accept or cancel
+	_, err = input.tk.AcceptOwnership(ctx, symbol, addrs[0])
+	require.Equal(t, types.CodeNoPendingOwnershipTransfer, err.Code())
+	_, err = input.tk.CancelOwnershipTransfer(ctx, symbol, testAddr)
+	require.Equal(t, types.CodeNoPendingOwnershipTransfer, err.Code())
+
+	// a later nomination replaces the earlier one
+	require.NoError(t, input.tk.TransferOwnership(ctx, symbol, testAddr, addrs[0]))
+	require.NoError(t, input.tk.TransferOwnership(ctx, symbol, testAddr, addrs[1]))
+	transfer := input.tk.GetPendingOwnershipTransfer(ctx, symbol)
+	require.Equal(t, addrs[1], transfer.NewOwner)
+	require.Equal(t, 1000+int64(types.OwnershipTransferTimeout), transfer.Deadline)
+	_, err = input.tk.AcceptOwnership(ctx, symbol, addrs[0])
+	require.Equal(t, types.CodeNotPendingTokenOwner, err.Code())
+
+	// only the owner cancels
+	_, err = input.tk.CancelOwnershipTransfer(ctx, symbol, addrs[1])
+	require.Equal(t, types.CodeNeedTokenOwner, err.Code())
+	_, err = input.tk.CancelOwnershipTransfer(ctx, symbol, testAddr)
+	require.NoError(t, err)
+	require.Nil(t, input.tk.GetPendingOwnershipTransfer(ctx, symbol))
+
+	// the nomination can not be accepted after the deadline
+	require.NoError(t, input.tk.TransferOwnership(ctx, symbol, testAddr, addrs[1]))
+	late := ctx.WithBlockTime(time.Unix(1000+types.OwnershipTransferTimeout, 0))
+	_, err = input.tk.AcceptOwnership(late, symbol, addrs[1])
+	require.Equal(t, types.CodeNoPendingOwnershipTransfer, err.Code())
+	require.Empty(t, input.tk.RemoveExpiredOwnershipTransfers(ctx))
+	require.Equal(t, 1, len(input.tk.RemoveExpiredOwnershipTransfers(late)))
+	require.Empty(t, input.tk.GetAllPendingOwnershipTransfers(ctx))
+	require.Equal(t, testAddr, input.tk.GetToken(ctx, symbol).GetOwner())
+}
+
 func TestTokenKeeper_MintToken(t *testing.T) {
 	input := createTestInput()
 	symbol := "abc"
@@ -252,6 +302,68 @@ func TestTokenKeeper_MintToken(t *testing.T) {
 
 }
 
+func TestTokenKeeper_MintPolicy(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+
+	err := input.tk.IssueToken(ctx, "ABC token", symbol, sdk.NewInt(2100), testAddr,
+		true, false, false, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+	require.Nil(t, input.tk.GetMintPolicyInfo(ctx, symbol))
+
+	// the cap can not be lower than the total supply
+	err = input.tk.SetMintPolicy(ctx, symbol, testAddr, types.NewMintPolicy(sdk.NewInt(2000), sdk.NewInt(500), 100))
+	require.Equal(t, types.CodeInvalidMintPolicy, err.Code())
+	policy := types.NewMintPolicy(sdk.NewInt(4000), sdk.NewInt(500), 100)
+	err = input.tk.SetMintPolicy(ctx, symbol, testAddr, policy)
+	require.NoError(t, err)
+	err = input.tk.SetMintPolicy(ctx, symbol, testAddr, types.NewMintPolicy(sdk.NewInt(5000), sdk.NewInt(500), 100))
+	require.Equal(t, types.CodeMintPolicyExists, err.Code())
+
+	// at most 500 can be minted within 100 seconds
+	require.NoError(t, input.tk.MintToken(ctx, symbol, testAddr, sdk.NewInt(300)))
+	require.NoError(t, input.tk.MintToken(ctx.WithBlockTime(time.Unix(1050, 0)), symbol, testAddr, sdk.NewInt(200)))
+	err = input.tk.MintToken(ctx.WithBlockTime(time.Unix(1099, 0)), symbol, testAddr, sdk.NewInt(1))
+	require.Equal(t, types.CodeMintRateLimited, err.Code())
+	info := input.tk.GetMintPolicyInfo(ctx.WithBlockTime(time.Unix(1099, 0)), symbol)
+	require.Equal(t, policy, info.Policy)
+	require.Equal(t, "500", info.WindowMinted.String())
+	require.True(t, info.Mintable.IsZero())
+
+	// the first mint leaves the window
+	ctx = ctx.WithBlockTime(time.Unix(1100, 0))
+	require.Equal(t, "300", input.tk.GetMintPolicyInfo(ctx, symbol).Mintable.String())
+	require.NoError(t, input.tk.MintToken(ctx, symbol, testAddr, sdk.NewInt(100)))
+	require.NoError(t, input.tk.MintToken(ctx, symbol, testAddr, sdk.NewInt(200)))
+	require.Equal(t, []types.MintRecord{
+		types.NewMintRecord(symbol, 1050, sdk.NewInt(200)),
+		types.NewMintRecord(symbol, 1100, sdk.NewInt(300)),
+	}, input.tk.GetAllMintRecords(ctx))
+
+	// the total supply can not exceed the cap
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0))
+	require.NoError(t, input.tk.MintToken(ctx, symbol, testAddr, sdk.NewInt(500)))
+	ctx = ctx.WithBlockTime(time.Unix(3000, 0))
+	require.Equal(t, "500", input.tk.GetMintPolicyInfo(ctx, symbol).Mintable.String())
+	require.NoError(t, input.tk.MintToken(ctx, symbol, testAddr, sdk.NewInt(400)))
+	ctx = ctx.WithBlockTime(time.Unix(4000, 0))
+	require.Equal(t, "200", input.tk.GetMintPolicyInfo(ctx, symbol).Mintable.String())
+	err = input.tk.MintToken(ctx, symbol, testAddr, sdk.NewInt(201))
+	require.Equal(t, types.CodeMintCapExceeded, err.Code())
+	require.Equal(t, sdk.NewInt(3800), input.tk.GetToken(ctx, symbol).GetTotalSupply())
+
+	// a mint schedule can not bypass the cap
+	err = input.tk.SetMintSchedule(ctx, symbol, testAddr, types.MintSchedule{Tranches: []types.MintTranche{
+		{Time: 5000, Amount: sdk.NewInt(1000), Recipient: testAddr},
+	}})
+	require.Equal(t, types.CodeMintPolicyExists, err.Code())
+	require.False(t, input.tk.GetToken(ctx, symbol).GetMintScheduled())
+	require.Nil(t, input.tk.GetMintSchedule(ctx, symbol))
+	require.Empty(t, input.tk.GetDueMintSchedules(ctx.WithBlockTime(time.Unix(5000, 0))))
+	require.Equal(t, sdk.NewInt(3800), input.tk.GetToken(ctx, symbol).GetTotalSupply())
+}
+
 func TestTokenKeeper_BurnToken(t *testing.T) {
 	input := createTestInput()
 	symbol := "abc"
@@ -850,3 +962,844 @@ func checkTokensEqual(t *testing.T, token, newToken types.Token) {
 	require.Equal(t, token.GetAddrForbiddable(), newToken.GetAddrForbiddable())
 	require.Equal(t, token.GetTokenForbiddable(), newToken.GetTokenForbiddable())
 }
+
+func TestTokenKeeper_MintSchedule(t *testing.T) {
+	input := createTestInput()
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+	symbol := "abc"
+	recipients := mockAddrList()
+
+	err := input.tk.IssueToken(ctx, "ABC token", symbol, sdk.NewInt(2100), testAddr,
+		true, false, false, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+
+	schedule := types.MintSchedule{Tranches: []types.MintTranche{
+		{Time: 2000, Amount: sdk.NewInt(100), Recipient: recipients[0]},
+		{Time: 3000, Amount: sdk.NewInt(200), Recipient: recipients[1]},
+	}}
+
+	// only the owner can set a schedule, which must start in the future
+	err = input.tk.SetMintSchedule(ctx, symbol, recipients[0], schedule)
+	require.Error(t, err)
+	err = input.tk.SetMintSchedule(ctx.WithBlockTime(time.Unix(2000, 0)), symbol, testAddr, schedule)
+	require.Equal(t, types.CodeInvalidMintSchedule, err.Code())
+
+	err = input.tk.SetMintSchedule(ctx, symbol, testAddr, schedule)
+	require.NoError(t, err)
+	token := input.tk.GetToken(ctx, symbol)
+	require.True(t, token.GetMintScheduled())
+	require.Equal(t, sdk.NewInt(300), token.GetScheduledSupply())
+
+	// the schedule is immutable, and forbids MintToken
+	err = input.tk.SetMintSchedule(ctx, symbol, testAddr, schedule)
+	require.Equal(t, types.CodeMintScheduleExists, err.Code())
+	err = input.tk.MintToken(ctx, symbol, testAddr, sdk.NewInt(1000))
+	require.Equal(t, types.CodeTokenMintScheduled, err.Code())
+	err = input.tk.ModifyTokenInfo(ctx, symbol, testAddr, "", "", types.TestIdentityString, "ABC token",
+		sdk.NewInt(2100), false, false, false, false)
+	require.Equal(t, types.CodeTokenInfoSealed, err.Code())
+
+	// nothing is due yet
+	mints, err := input.tk.ExecuteMintSchedule(ctx, *input.tk.GetMintSchedule(ctx, symbol))
+	require.NoError(t, err)
+	require.Empty(t, mints)
+
+	mints, err = input.tk.ExecuteMintSchedule(ctx.WithBlockTime(time.Unix(2500, 0)), *input.tk.GetMintSchedule(ctx, symbol))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(mints))
+	token = input.tk.GetToken(ctx, symbol)
+	require.Equal(t, sdk.NewInt(2200), token.GetTotalSupply())
+	require.Equal(t, sdk.NewInt(100), token.GetTotalMint())
+	require.Equal(t, sdk.NewInt(200), token.GetScheduledSupply())
+	require.Equal(t, sdk.NewInt(100), input.tk.GetAccTotalToken(ctx, recipients[0]).AmountOf(symbol))
+
+	mints, err = input.tk.ExecuteMintSchedule(ctx.WithBlockTime(time.Unix(5000, 0)), *input.tk.GetMintSchedule(ctx, symbol))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(mints))
+	token = input.tk.GetToken(ctx, symbol)
+	require.Equal(t, sdk.NewInt(2400), token.GetTotalSupply())
+	require.True(t, token.GetScheduledSupply().IsZero())
+	require.Equal(t, sdk.NewInt(200), input.tk.GetAccTotalToken(ctx, recipients[1]).AmountOf(symbol))
+	require.True(t, input.tk.GetMintSchedule(ctx, symbol).Finished())
+	require.Equal(t, 1, len(input.tk.GetAllMintSchedules(ctx)))
+}
+
+func TestTokenKeeper_LinearEmission(t *testing.T) {
+	input := createTestInput()
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+	symbol := "abc"
+	recipient := mockAddrList()[0]
+
+	err := input.tk.IssueToken(ctx, "ABC token", symbol, sdk.NewInt(2100), testAddr,
+		true, false, false, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+	schedule := types.MintSchedule{Emission: &types.LinearEmission{
+		Rate: sdk.NewInt(3), StartTime: 2000, EndTime: 2100, Recipient: recipient,
+	}}
+	err = input.tk.SetMintSchedule(ctx, symbol, testAddr, schedule)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(300), input.tk.GetToken(ctx, symbol).GetScheduledSupply())
+
+	for _, now := range []int64{1500, 2010, 2010, 2050, 3000} {
+		_, err = input.tk.ExecuteMintSchedule(ctx.WithBlockTime(time.Unix(now, 0)), *input.tk.GetMintSchedule(ctx, symbol))
+		require.NoError(t, err)
+		if now == 2050 {
+			require.Equal(t, sdk.NewInt(150), input.tk.GetAccTotalToken(ctx, recipient).AmountOf(symbol))
+		}
+	}
+	token := input.tk.GetToken(ctx, symbol)
+	require.Equal(t, sdk.NewInt(2400), token.GetTotalSupply())
+	require.True(t, token.GetScheduledSupply().IsZero())
+	require.Equal(t, sdk.NewInt(300), input.tk.GetAccTotalToken(ctx, recipient).AmountOf(symbol))
+}
+
+func TestTokenKeeper_CoOwners(t *testing.T) {
+	input := createTestInput()
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+	symbol := "abc"
+	coOwners := mockAddrList()
+
+	err := input.tk.IssueToken(ctx, "ABC token", symbol, sdk.NewInt(2100), testAddr,
+		true, false, false, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+
+	// only the owner sets the first co-owners
+	err = input.tk.SetTokenCoOwners(ctx, symbol, coOwners[0], coOwners, 2)
+	require.Equal(t, types.CodeNeedTokenOwner, err.Code())
+	err = input.tk.SetTokenCoOwners(ctx, symbol, testAddr, coOwners, 4)
+	require.Equal(t, types.CodeInvalidTokenCoOwners, err.Code())
+	err = input.tk.SetTokenCoOwners(ctx, symbol, testAddr, coOwners, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), input.tk.GetTokenCoOwners(ctx, symbol).Threshold)
+
+	// after that, the owner can not act alone
+	err = input.tk.MintToken(ctx, symbol, testAddr, sdk.NewInt(100))
+	require.Equal(t, types.CodeTokenActionNeedsApproval, err.Code())
+	err = input.tk.SetTokenCoOwners(ctx, symbol, testAddr, nil, 0)
+	require.Equal(t, types.CodeTokenActionNeedsApproval, err.Code())
+
+	action := types.NewMsgMintToken(symbol, sdk.NewInt(100), testAddr)
+	_, _, err = input.tk.ProposeTokenAction(ctx, testAddr, action, 100)
+	require.Equal(t, types.CodeNotTokenCoOwner, err.Code())
+	_, _, err = input.tk.ProposeTokenAction(ctx, coOwners[0], types.NewMsgMintToken(symbol, sdk.NewInt(100), coOwners[1]), 100)
+	require.Equal(t, types.CodeNeedTokenOwner, err.Code())
+	proposal, ready, err := input.tk.ProposeTokenAction(ctx, coOwners[0], action, 100)
+	require.NoError(t, err)
+	require.False(t, ready)
+	require.Equal(t, uint64(1), proposal.ID)
+	require.Equal(t, int64(1100), proposal.ExpireTime)
+	require.Equal(t, 1, len(input.tk.GetTokenActionProposals(ctx, symbol)))
+
+	_, _, err = input.tk.ApproveTokenAction(ctx, symbol, 1, coOwners[0])
+	require.Equal(t, types.CodeDuplicateApproval, err.Code())
+	_, _, err = input.tk.ApproveTokenAction(ctx, symbol, 1, testAddr)
+	require.Equal(t, types.CodeNotTokenCoOwner, err.Code())
+	_, _, err = input.tk.ApproveTokenAction(ctx, symbol, 2, coOwners[1])
+	require.Equal(t, types.CodeTokenProposalNotFound, err.Code())
+	proposal, ready, err = input.tk.ApproveTokenAction(ctx, symbol, 1, coOwners[1])
+	require.NoError(t, err)
+	require.True(t, ready)
+	require.Equal(t, 2, len(proposal.Approvals))
+	require.Nil(t, input.tk.GetTokenActionProposal(ctx, symbol, 1))
+
+	// the approved action is executed with the owner's authority
+	err = input.tk.WithApprovedAction().MintToken(ctx, symbol, testAddr, sdk.NewInt(100))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(2200), input.tk.GetToken(ctx, symbol).GetTotalSupply())
+
+	// expired proposals are removed
+	proposal, _, err = input.tk.ProposeTokenAction(ctx, coOwners[2], action, 100)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), proposal.ID)
+	require.Empty(t, input.tk.RemoveExpiredTokenActionProposals(ctx.WithBlockTime(time.Unix(1099, 0))))
+	_, _, err = input.tk.ApproveTokenAction(ctx.WithBlockTime(time.Unix(1100, 0)), symbol, 2, coOwners[1])
+	require.Equal(t, types.CodeTokenProposalNotFound, err.Code())
+	expired := input.tk.RemoveExpiredTokenActionProposals(ctx.WithBlockTime(time.Unix(1100, 0)))
+	require.Equal(t, 1, len(expired))
+	require.Empty(t, input.tk.GetAllTokenActionProposals(ctx))
+
+	// removing the co-owners needs approval too, and drops the pending proposals
+	_, _, err = input.tk.ProposeTokenAction(ctx, coOwners[2], action, 100)
+	require.NoError(t, err)
+	err = input.tk.WithApprovedAction().SetTokenCoOwners(ctx, symbol, testAddr, nil, 0)
+	require.NoError(t, err)
+	require.Nil(t, input.tk.GetTokenCoOwners(ctx, symbol))
+	require.Empty(t, input.tk.GetAllTokenActionProposals(ctx))
+	err = input.tk.MintToken(ctx, symbol, testAddr, sdk.NewInt(100))
+	require.NoError(t, err)
+}
+
+func TestTokenKeeper_HolderBurnToken(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	holder := mockAddrList()[0]
+
+	err := input.tk.IssueToken(input.ctx, "ABC token", symbol, sdk.NewInt(2100), testAddr,
+		false, false, true, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+	err = input.tk.SendCoinsFromAssetModuleToAccount(input.ctx, testAddr, types.NewTokenCoins(symbol, sdk.NewInt(2100)))
+	require.NoError(t, err)
+
+	// only burnable tokens can be holder burnable
+	err = input.tk.SetTokenHolderBurnable(input.ctx, symbol, testAddr, true)
+	require.Equal(t, types.CodeTokenBurnNotSupported, err.Code())
+	token := input.tk.GetToken(input.ctx, symbol)
+	err = input.tk.ModifyTokenInfo(input.ctx, symbol, testAddr,
+		token.GetURL(), token.GetDescription(), token.GetIdentity(), token.GetName(),
+		token.GetTotalSupply(), token.GetMintable(), true,
+		token.GetAddrForbiddable(), token.GetTokenForbiddable())
+	require.NoError(t, err)
+	err = input.tk.SetTokenHolderBurnable(input.ctx, symbol, holder, true)
+	require.Equal(t, types.CodeNeedTokenOwner, err.Code())
+	_, err = input.tk.HolderBurnToken(input.ctx, symbol, testAddr, sdk.NewInt(100), "")
+	require.Equal(t, types.CodeTokenHolderBurnNotSupported, err.Code())
+	err = input.tk.SetTokenHolderBurnable(input.ctx, symbol, testAddr, true)
+	require.NoError(t, err)
+	require.True(t, input.tk.GetToken(input.ctx, symbol).GetHolderBurnable())
+
+	err = input.bkx.SendCoins(input.ctx, testAddr, holder, types.NewTokenCoins(symbol, sdk.NewInt(1000)))
+	require.NoError(t, err)
+
+	// holders rely on the redemption after distribution
+	err = input.tk.SetTokenHolderBurnable(input.ctx, symbol, testAddr, false)
+	require.Equal(t, types.CodeTokenInfoSealed, err.Code())
+
+	redemption, err := input.tk.HolderBurnToken(input.ctx, symbol, holder, sdk.NewInt(400), "bc1qwithdrawal")
+	require.NoError(t, err)
+	require.Equal(t, types.NewTokenRedemption(symbol, holder, testAddr, sdk.NewInt(400), "bc1qwithdrawal", input.ctx.BlockHeight()), redemption)
+	token = input.tk.GetToken(input.ctx, symbol)
+	require.Equal(t, sdk.NewInt(1700), token.GetTotalSupply())
+	require.Equal(t, sdk.NewInt(400), token.GetTotalBurn())
+	require.Equal(t, sdk.NewInt(600), input.bkx.GetTotalCoins(input.ctx, holder).AmountOf(symbol))
+
+	// a holder can not burn more than its balance
+	_, err = input.tk.HolderBurnToken(input.ctx, symbol, holder, sdk.NewInt(601), "")
+	require.Error(t, err)
+	_, err = input.tk.HolderBurnToken(input.ctx, symbol, holder, sdk.NewInt(100), strings.Repeat("x", types.MaxRedemptionMemoLength+1))
+	require.Equal(t, types.CodeInvalidRedemptionMemo, err.Code())
+
+	// the forbidden holders can not burn
+	err = input.tk.ForbidAddress(input.ctx, symbol, testAddr, []sdk.AccAddress{holder})
+	require.NoError(t, err)
+	_, err = input.tk.HolderBurnToken(input.ctx, symbol, holder, sdk.NewInt(100), "")
+	require.Equal(t, types.CodeHolderBurnForbidden, err.Code())
+
+	// the owner can still burn as a holder
+	_, err = input.tk.HolderBurnToken(input.ctx, symbol, testAddr, sdk.NewInt(100), "")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(500), input.tk.GetToken(input.ctx, symbol).GetTotalBurn())
+}
+
+func TestTokenKeeper_PermissionedToken(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	holder := mockAddrList()[0]
+	other := mockAddrList()[1]
+
+	err := input.tk.IssueToken(input.ctx, "ABC token", symbol, sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+	err = input.tk.SendCoinsFromAssetModuleToAccount(input.ctx, testAddr, types.NewTokenCoins(symbol, sdk.NewInt(2100)))
+	require.NoError(t, err)
+	require.True(t, input.tk.IsPermittedByTokenIssuer(input.ctx, symbol, holder))
+
+	// the whitelist is only supported by forbiddable or permissioned tokens
+	err = input.tk.AddTokenWhitelist(input.ctx, symbol, testAddr, []sdk.AccAddress{holder})
+	require.Equal(t, types.CodeTokenForbiddenNotSupported, err.Code())
+	err = input.tk.SetTokenPermissioned(input.ctx, symbol, holder)
+	require.Equal(t, types.CodeNeedTokenOwner, err.Code())
+	err = input.tk.SetTokenPermissioned(input.ctx, symbol, testAddr)
+	require.NoError(t, err)
+	require.True(t, input.tk.GetToken(input.ctx, symbol).GetPermissioned())
+	err = input.tk.AddTokenWhitelist(input.ctx, symbol, testAddr, []sdk.AccAddress{holder})
+	require.NoError(t, err)
+
+	require.True(t, input.tk.IsPermittedByTokenIssuer(input.ctx, symbol, testAddr))
+	require.True(t, input.tk.IsPermittedByTokenIssuer(input.ctx, symbol, holder))
+	require.False(t, input.tk.IsPermittedByTokenIssuer(input.ctx, symbol, other))
+	require.True(t, input.tk.IsPermittedByTokenIssuer(input.ctx, "xyz", other))
+
+	// a permissioned token is not forbidden by itself
+	require.False(t, input.tk.IsForbiddenByTokenIssuer(input.ctx, symbol, other))
+
+	err = input.tk.RemoveTokenWhitelist(input.ctx, symbol, testAddr, []sdk.AccAddress{holder})
+	require.NoError(t, err)
+	require.False(t, input.tk.IsPermittedByTokenIssuer(input.ctx, symbol, holder))
+
+	// the token can not become permissioned after distribution
+	err = input.tk.IssueToken(input.ctx, "XYZ token", "xyz", sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+	err = input.tk.SendCoinsFromAssetModuleToAccount(input.ctx, testAddr, types.NewTokenCoins("xyz", sdk.NewInt(2100)))
+	require.NoError(t, err)
+	err = input.bkx.SendCoins(input.ctx, testAddr, holder, types.NewTokenCoins("xyz", sdk.NewInt(100)))
+	require.NoError(t, err)
+	err = input.tk.SetTokenPermissioned(input.ctx, "xyz", testAddr)
+	require.Equal(t, types.CodeTokenInfoSealed, err.Code())
+}
+
+func TestTokenKeeper_TokenMetadata(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	holder := mockAddrList()[0]
+
+	err := input.tk.IssueToken(input.ctx, "ABC token", symbol, sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+	err = input.tk.SendCoinsFromAssetModuleToAccount(input.ctx, testAddr, types.NewTokenCoins(symbol, sdk.NewInt(2100)))
+	require.NoError(t, err)
+	require.Nil(t, input.tk.GetToken(input.ctx, symbol).GetMetadata())
+
+	metadata := types.TokenMetadata{
+		Display:     "ABC",
+		Decimals:    8,
+		DenomUnits:  []types.DenomUnit{{Denom: symbol, Exponent: 0}, {Denom: "ABC", Exponent: 8}},
+		LogoHash:    "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		SocialLinks: []types.SocialLink{{Name: "twitter", URL: "https://twitter.com/abc"}},
+	}
+	err = input.tk.SetTokenMetadata(input.ctx, symbol, holder, metadata)
+	require.Equal(t, types.CodeNeedTokenOwner, err.Code())
+	err = input.tk.SetTokenMetadata(input.ctx, "xyz", testAddr, metadata)
+	require.Equal(t, types.CodeTokenNotFound, err.Code())
+	err = input.tk.SetTokenMetadata(input.ctx, symbol, testAddr, types.TokenMetadata{Display: "ABC", Decimals: 19})
+	require.Equal(t, types.CodeInvalidTokenMetadata, err.Code())
+
+	// the metadata is still modifiable after distribution
+	err = input.bkx.SendCoins(input.ctx, testAddr, holder, types.NewTokenCoins(symbol, sdk.NewInt(100)))
+	require.NoError(t, err)
+	err = input.tk.SetTokenMetadata(input.ctx, symbol, testAddr, metadata)
+	require.NoError(t, err)
+	require.Equal(t, &metadata, input.tk.GetToken(input.ctx, symbol).GetMetadata())
+
+	// an empty metadata clears it
+	err = input.tk.SetTokenMetadata(input.ctx, symbol, testAddr, types.TokenMetadata{})
+	require.NoError(t, err)
+	require.Nil(t, input.tk.GetToken(input.ctx, symbol).GetMetadata())
+}
+
+func TestTokenKeeper_FreezeTokenAmount(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	addr := mockAddrList()[0]
+	addr2 := mockAddrList()[1]
+
+	err := input.tk.IssueToken(input.ctx, "ABC token", symbol, sdk.NewInt(2100), testAddr,
+		false, false, true, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+	err = input.tk.IssueToken(input.ctx, "XYZ token", "xyz", sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+
+	// only addr forbiddable tokens can be frozen
+	_, err = input.tk.FreezeTokenAmount(input.ctx, "xyz", testAddr, addr, sdk.NewInt(100))
+	require.Equal(t, types.CodeAddressForbiddenNotSupported, err.Code())
+
+	_, err = input.tk.FreezeTokenAmount(input.ctx, symbol, addr, addr2, sdk.NewInt(100))
+	require.Equal(t, types.CodeNeedTokenOwner, err.Code())
+	_, err = input.tk.FreezeTokenAmount(input.ctx, symbol, testAddr, testAddr, sdk.NewInt(100))
+	require.Equal(t, types.CodeTokenOwnerSelfForbidden, err.Code())
+	_, err = input.tk.FreezeTokenAmount(input.ctx, "def", testAddr, addr, sdk.NewInt(100))
+	require.Equal(t, types.CodeTokenNotFound, err.Code())
+
+	// the frozen amounts add up
+	frozen, err := input.tk.FreezeTokenAmount(input.ctx, symbol, testAddr, addr, sdk.NewInt(100))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(100), frozen)
+	frozen, err = input.tk.FreezeTokenAmount(input.ctx, symbol, testAddr, addr, sdk.NewInt(50))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(150), frozen)
+	_, err = input.tk.FreezeTokenAmount(input.ctx, symbol, testAddr, addr2, sdk.NewInt(10))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(150), input.tk.GetIssuerFrozenAmount(input.ctx, symbol, addr))
+	require.Equal(t, 2, len(input.tk.GetIssuerFrozenAmounts(input.ctx, symbol)))
+	require.Equal(t, []types.IssuerFrozenAmount{types.NewIssuerFrozenAmount(symbol, addr, sdk.NewInt(150))},
+		input.tk.GetAddrIssuerFrozenAmounts(input.ctx, addr))
+
+	// unfreeze a part, and then the rest
+	_, err = input.tk.UnfreezeTokenAmount(input.ctx, symbol, testAddr, addr, sdk.NewInt(151))
+	require.Equal(t, types.CodeInsufficientFrozenAmount, err.Code())
+	frozen, err = input.tk.UnfreezeTokenAmount(input.ctx, symbol, testAddr, addr, sdk.NewInt(100))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(50), frozen)
+	frozen, err = input.tk.UnfreezeTokenAmount(input.ctx, symbol, testAddr, addr, sdk.NewInt(50))
+	require.NoError(t, err)
+	require.True(t, frozen.IsZero())
+	require.True(t, input.tk.GetIssuerFrozenAmount(input.ctx, symbol, addr).IsZero())
+	require.Equal(t, 0, len(input.tk.GetAddrIssuerFrozenAmounts(input.ctx, addr)))
+
+	// the frozen amounts are dropped when the token is no longer addr forbiddable
+	err = input.tk.ModifyTokenInfo(input.ctx, symbol, testAddr, "", "", types.TestIdentityString, "ABC token",
+		sdk.NewInt(2100), false, false, false, false)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(input.tk.GetAllIssuerFrozenAmounts(input.ctx)))
+}
+
+func TestTokenKeeper_TokenSnapshot(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	addr := mockAddrList()[0]
+	addr2 := mockAddrList()[1]
+
+	err := input.tk.IssueToken(input.ctx, "ABC token", symbol, sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+	err = input.tk.SendCoinsFromAssetModuleToAccount(input.ctx, testAddr, types.NewTokenCoins(symbol, sdk.NewInt(2100)))
+	require.NoError(t, err)
+	require.NoError(t, input.bkx.SendCoins(input.ctx, testAddr, addr, types.NewTokenCoins(symbol, sdk.NewInt(100))))
+	require.NoError(t, input.bkx.SendCoins(input.ctx, testAddr, addr2, types.NewTokenCoins(symbol, sdk.NewInt(50))))
+	// the frozen coins are recorded too
+	require.NoError(t, input.bkx.FreezeCoins(input.ctx, addr2, types.NewTokenCoins(symbol, sdk.NewInt(20))))
+
+	_, err = input.tk.RequestTokenSnapshot(input.ctx, "xyz", testAddr)
+	require.Equal(t, types.CodeTokenNotFound, err.Code())
+	// others must pay the snapshot fee
+	_, err = input.tk.RequestTokenSnapshot(input.ctx, symbol, addr)
+	require.Error(t, err)
+	require.Nil(t, input.tk.GetTokenSnapshot(input.ctx, 1))
+
+	fee := dex.NewCetCoins(types.DefaultSnapshotFee)
+	require.NoError(t, input.tk.AddToken(input.ctx, addr, fee))
+	s1, err := input.tk.RequestTokenSnapshot(input.ctx, symbol, addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), s1.ID)
+	require.True(t, input.tk.GetAccTotalToken(input.ctx, addr).AmountOf(dex.CET).IsZero())
+	// the owner pays the fee too
+	_, err = input.tk.RequestTokenSnapshot(input.ctx, symbol, testAddr)
+	require.Error(t, err)
+	require.NoError(t, input.tk.AddToken(input.ctx, testAddr, fee))
+	s2, err := input.tk.RequestTokenSnapshot(input.ctx, symbol, testAddr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), s2.ID)
+	require.False(t, input.tk.GetTokenSnapshot(input.ctx, 1).Taken())
+
+	taken := input.tk.TakePendingSnapshots(input.ctx, types.SnapshotEntriesPerBlock)
+	require.Equal(t, 2, len(taken))
+	require.Nil(t, input.tk.TakePendingSnapshots(input.ctx, types.SnapshotEntriesPerBlock))
+	for _, s := range taken {
+		require.Equal(t, input.ctx.BlockHeight(), s.Height)
+		require.Equal(t, uint64(3), s.Holders)
+	}
+	require.Equal(t, taken, input.tk.GetTokenSnapshots(input.ctx, symbol))
+	require.Equal(t, 0, len(input.tk.GetTokenSnapshots(input.ctx, "xyz")))
+
+	var balances []types.SnapshotBalance
+	input.tk.IterateSnapshotBalances(input.ctx, 1, func(b types.SnapshotBalance) bool {
+		balances = append(balances, b)
+		return false
+	})
+	require.Equal(t, 3, len(balances))
+	expected := map[string]sdk.Int{
+		testAddr.String(): sdk.NewInt(1950),
+		addr.String():     sdk.NewInt(100),
+		addr2.String():    sdk.NewInt(50),
+	}
+	for _, b := range balances {
+		require.Equal(t, uint64(1), b.SnapshotID)
+		require.Equal(t, expected[b.Address.String()], b.Amount)
+	}
+	require.Equal(t, 6, len(input.tk.GetAllSnapshotBalances(input.ctx)))
+}
+
+func TestTokenKeeper_TakeSnapshotsInBatches(t *testing.T) {
+	input := createTestInput()
+	ctx := input.ctx.WithBlockHeight(10)
+	addrs := mockAddrList()
+	require.NoError(t, input.tk.AddToken(ctx, testAddr, dex.NewCetCoins(types.DefaultSnapshotFee*10)))
+
+	for _, symbol := range []string{"abc", "xyz"} {
+		err := input.tk.IssueToken(ctx, "ABC token", symbol, sdk.NewInt(2100), testAddr,
+			false, false, false, false, "", "", types.TestIdentityString)
+		require.NoError(t, err)
+		err = input.tk.SendCoinsFromAssetModuleToAccount(ctx, testAddr, types.NewTokenCoins(symbol, sdk.NewInt(2100)))
+		require.NoError(t, err)
+		for i := 0; i < 3; i++ {
+			require.NoError(t, input.bkx.SendCoins(ctx, testAddr, addrs[i], types.NewTokenCoins(symbol, sdk.NewInt(100))))
+		}
+		_, err = input.tk.RequestTokenSnapshot(ctx, symbol, testAddr)
+		require.NoError(t, err)
+	}
+
+	// the earliest snapshot is always taken, the other one waits for the next block
+	taken := input.tk.TakePendingSnapshots(ctx, 3)
+	require.Equal(t, 1, len(taken))
+	require.Equal(t, uint64(4), taken[0].Holders)
+	require.False(t, input.tk.GetTokenSnapshot(ctx, 2).Taken())
+	ctx = ctx.WithBlockHeight(11)
+	taken = input.tk.TakePendingSnapshots(ctx, 4)
+	require.Equal(t, 1, len(taken))
+	require.Equal(t, int64(11), input.tk.GetTokenSnapshot(ctx, 2).Height)
+
+	// the expired snapshots are removed in batches
+	ctx = ctx.WithBlockHeight(10 + types.SnapshotRetentionBlocks)
+	input.tk.RemoveExpiredSnapshots(ctx, 3)
+	require.NotNil(t, input.tk.GetTokenSnapshot(ctx, 1))
+	require.Equal(t, 5, len(input.tk.GetAllSnapshotBalances(ctx)))
+	input.tk.RemoveExpiredSnapshots(ctx, 3)
+	require.Nil(t, input.tk.GetTokenSnapshot(ctx, 1))
+	require.NotNil(t, input.tk.GetTokenSnapshot(ctx, 2))
+	require.Equal(t, 4, len(input.tk.GetAllSnapshotBalances(ctx)))
+
+	// the snapshot of a pending distribution is kept
+	d, err := input.tk.DistributeToHolders(ctx, "xyz", testAddr, "xyz", sdk.NewInt(100), nil)
+	require.NoError(t, err)
+	d.SnapshotID = 2
+	input.tk.SetDistribution(ctx, d)
+	input.tk.RemoveExpiredSnapshots(ctx.WithBlockHeight(11+types.SnapshotRetentionBlocks), 10)
+	require.NotNil(t, input.tk.GetTokenSnapshot(ctx, 2))
+}
+
+func TestTokenKeeper_SnapshotFeeNotInStore(t *testing.T) {
+	input := createTestInput()
+	params := types.DefaultParams()
+	params.SnapshotFee = 1
+	input.tk.SetParams(input.ctx, params)
+	require.Equal(t, int64(1), input.tk.GetParams(input.ctx).SnapshotFee)
+
+	// the chains started before snapshots were introduced do not have SnapshotFee in the store
+	paramStore := input.ctx.KVStore(input.keyParams)
+	paramStore.Delete(append([]byte(types.DefaultParamspace+"/"), types.KeySnapshotFee...))
+	require.Equal(t, params.IssueTokenFee, input.tk.GetParams(input.ctx).IssueTokenFee)
+	require.Equal(t, int64(types.DefaultSnapshotFee), input.tk.GetParams(input.ctx).SnapshotFee)
+}
+
+func TestTokenKeeper_HolderIndex(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	addr := mockAddrList()[0]
+	addr2 := mockAddrList()[1]
+
+	err := input.tk.IssueToken(input.ctx, "ABC token", symbol, sdk.NewInt(2100), testAddr,
+		false, true, false, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), input.tk.GetHolderCount(input.ctx, symbol))
+	err = input.tk.SendCoinsFromAssetModuleToAccount(input.ctx, testAddr, types.NewTokenCoins(symbol, sdk.NewInt(2100)))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), input.tk.GetHolderCount(input.ctx, symbol))
+
+	require.NoError(t, input.bkx.SendCoins(input.ctx, testAddr, addr, types.NewTokenCoins(symbol, sdk.NewInt(100))))
+	require.NoError(t, input.bkx.SendCoins(input.ctx, testAddr, addr2, types.NewTokenCoins(symbol, sdk.NewInt(300))))
+	// the frozen and locked coins are included
+	input.bkx.SetParams(input.ctx, bankx.DefaultParams())
+	require.NoError(t, input.bkx.FreezeCoins(input.ctx, addr2, types.NewTokenCoins(symbol, sdk.NewInt(20))))
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+	err = input.bkx.SendLockedCoins(ctx, testAddr, addr, nil, types.NewTokenCoins(symbol, sdk.NewInt(250)), 1100, 0, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), input.tk.GetHolderCount(input.ctx, symbol))
+
+	var holders []types.TokenHolder
+	input.tk.IterateTopHolders(input.ctx, symbol, func(h types.TokenHolder) bool {
+		holders = append(holders, h)
+		return false
+	})
+	require.Equal(t, []types.TokenHolder{
+		types.NewTokenHolder(testAddr, sdk.NewInt(1450), 1),
+		types.NewTokenHolder(addr, sdk.NewInt(350), 2),
+		types.NewTokenHolder(addr2, sdk.NewInt(300), 3),
+	}, holders)
+	require.Equal(t, types.NewTokenHolder(addr2, sdk.NewInt(300), 3), *input.tk.GetHolderRank(input.ctx, symbol, addr2))
+
+	// the burnt coins leave the index
+	err = input.tk.SendCoinsFromAccountToAssetModule(input.ctx, testAddr, types.NewTokenCoins(symbol, sdk.NewInt(1200)))
+	require.NoError(t, err)
+	require.NoError(t, input.tk.BurnToken(input.ctx, symbol, testAddr, sdk.NewInt(1200)))
+	require.Equal(t, uint64(1), input.tk.GetHolderRank(input.ctx, symbol, addr).Rank)
+	require.Equal(t, uint64(3), input.tk.GetHolderRank(input.ctx, symbol, testAddr).Rank)
+	require.NoError(t, input.bkx.SendCoins(input.ctx, testAddr, addr2, types.NewTokenCoins(symbol, sdk.NewInt(250))))
+	require.Nil(t, input.tk.GetHolderRank(input.ctx, symbol, testAddr))
+	require.Equal(t, uint64(2), input.tk.GetHolderCount(input.ctx, symbol))
+	require.Equal(t, "550", input.tk.GetHolderRank(input.ctx, symbol, addr2).Amount.String())
+
+	// the index is rebuilt from the accounts
+	input.tk.SetHolderAmount(input.ctx, symbol, addr, sdk.ZeroInt())
+	require.Equal(t, uint64(1), input.tk.GetHolderCount(input.ctx, symbol))
+	input.tk.RebuildHolderIndex(input.ctx)
+	require.Equal(t, uint64(2), input.tk.GetHolderCount(input.ctx, symbol))
+	require.Equal(t, uint64(2), input.tk.GetHolderRank(input.ctx, symbol, addr).Rank)
+
+	// CET is not indexed and can not be snapshotted
+	err = input.tk.IssueToken(input.ctx, "CET token", dex.CET, sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+	require.NoError(t, input.tk.AddToken(input.ctx, testAddr, dex.NewCetCoins(types.DefaultSnapshotFee*10)))
+	require.NoError(t, input.bkx.SendCoins(input.ctx, testAddr, addr, dex.NewCetCoins(100)))
+	input.tk.RebuildHolderIndex(input.ctx)
+	require.Equal(t, uint64(0), input.tk.GetHolderCount(input.ctx, dex.CET))
+	_, err = input.tk.RequestTokenSnapshot(input.ctx, dex.CET, testAddr)
+	require.Equal(t, types.CodeHoldersNotIndexed, err.Code())
+}
+
+func TestTokenKeeper_BuildHolderIndexOnce(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	addr := mockAddrList()[0]
+	err := input.tk.IssueToken(input.ctx, "ABC token", symbol, sdk.NewInt(2100), testAddr,
+		false, true, false, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+	err = input.tk.SendCoinsFromAssetModuleToAccount(input.ctx, testAddr, types.NewTokenCoins(symbol, sdk.NewInt(2100)))
+	require.NoError(t, err)
+	require.NoError(t, input.bkx.SendCoins(input.ctx, testAddr, addr, types.NewTokenCoins(symbol, sdk.NewInt(100))))
+
+	// the chains upgraded from a version without the holder index have none of its keys
+	store := input.ctx.KVStore(input.keyAsset)
+	for _, prefix := range [][]byte{types.HolderKey, types.HolderRankKey, types.HolderCountKey} {
+		var keys [][]byte
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	require.Equal(t, uint64(0), input.tk.GetHolderCount(input.ctx, symbol))
+
+	require.True(t, input.tk.BuildHolderIndexOnce(input.ctx))
+	require.Equal(t, uint64(2), input.tk.GetHolderCount(input.ctx, symbol))
+	require.Equal(t, uint64(2), input.tk.GetHolderRank(input.ctx, symbol, addr).Rank)
+	require.False(t, input.tk.BuildHolderIndexOnce(input.ctx))
+}
+
+func TestTokenKeeper_SymbolAuction(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	opener := mockAddrList()[0]
+	bidder := mockAddrList()[1]
+	fee := sdk.NewInt(input.tk.GetParams(input.ctx).GetIssueTokenFee(symbol))
+	err := input.tk.IssueToken(input.ctx, "CET token", dex.CET, sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+	for _, addr := range []sdk.AccAddress{opener, bidder} {
+		require.NoError(t, input.tk.AddToken(input.ctx, addr, types.NewTokenCoins(dex.CET, fee.MulRaw(2))))
+	}
+
+	_, err = input.tk.OpenSymbolAuction(input.ctx, "abcdefg", opener, fee)
+	require.Equal(t, types.CodeSymbolNotAuctionable, err.Code())
+	_, err = input.tk.OpenSymbolAuction(input.ctx, symbol, opener, fee.SubRaw(1))
+	require.Equal(t, types.CodeSymbolBidTooLow, err.Code())
+	auction, err := input.tk.OpenSymbolAuction(input.ctx, symbol, opener, fee)
+	require.NoError(t, err)
+	require.Equal(t, fee, input.bkx.GetFrozenCoins(input.ctx, opener).AmountOf(dex.CET))
+	_, err = input.tk.OpenSymbolAuction(input.ctx, symbol, bidder, fee)
+	require.Equal(t, types.CodeSymbolInAuction, err.Code())
+
+	// the outbid one is refunded
+	_, err = input.tk.BidSymbolAuction(input.ctx, symbol, bidder, fee.AddRaw(1))
+	require.Equal(t, types.CodeSymbolBidTooLow, err.Code())
+	winningBid := fee.AddRaw(types.SymbolAuctionMinIncrement)
+	_, err = input.tk.BidSymbolAuction(input.ctx, symbol, bidder, winningBid)
+	require.NoError(t, err)
+	require.True(t, input.bkx.GetFrozenCoins(input.ctx, opener).AmountOf(dex.CET).IsZero())
+	require.Equal(t, winningBid, input.bkx.GetFrozenCoins(input.ctx, bidder).AmountOf(dex.CET))
+	require.Equal(t, bidder, input.tk.GetSymbolAuction(input.ctx, symbol).Bidder)
+
+	err = input.tk.IssueToken(input.ctx, "ABC token", symbol, sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString)
+	require.Equal(t, types.CodeSymbolInAuction, err.Code())
+	require.Equal(t, 0, len(input.tk.SettleEndedSymbolAuctions(input.ctx)))
+
+	// the winning bid goes to the community pool when the auction ends
+	ctx := input.ctx.WithBlockTime(time.Unix(auction.EndTime, 0))
+	_, err = input.tk.BidSymbolAuction(ctx, symbol, opener, winningBid.AddRaw(types.SymbolAuctionMinIncrement))
+	require.Equal(t, types.CodeSymbolAuctionNotFound, err.Code())
+	reservations := input.tk.SettleEndedSymbolAuctions(ctx)
+	require.Equal(t, 1, len(reservations))
+	require.Equal(t, bidder, reservations[0].Winner)
+	require.Equal(t, auction.EndTime+types.SymbolReservationTimeout, reservations[0].Deadline)
+	require.Nil(t, input.tk.GetSymbolAuction(ctx, symbol))
+	require.Equal(t, winningBid, input.dk.donated.AmountOf(dex.CET))
+	require.Equal(t, fee.MulRaw(2).Sub(winningBid), input.bkx.GetTotalCoins(ctx, bidder).AmountOf(dex.CET))
+	require.Equal(t, fee.MulRaw(2), input.bkx.GetTotalCoins(ctx, opener).AmountOf(dex.CET))
+
+	// only the winner can issue the token
+	_, err = input.tk.OpenSymbolAuction(ctx, symbol, opener, fee)
+	require.Equal(t, types.CodeSymbolReserved, err.Code())
+	err = input.tk.IssueToken(ctx, "ABC token", symbol, sdk.NewInt(2100), opener,
+		false, false, false, false, "", "", types.TestIdentityString)
+	require.Equal(t, types.CodeSymbolReserved, err.Code())
+	err = input.tk.IssueToken(ctx, "ABC token", symbol, sdk.NewInt(2100), bidder,
+		false, false, false, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+	require.Nil(t, input.tk.GetSymbolReservation(ctx, symbol))
+	_, err = input.tk.OpenSymbolAuction(ctx, symbol, opener, fee)
+	require.Equal(t, types.CodeSymbolNotAuctionable, err.Code())
+}
+
+func TestTokenKeeper_SymbolAuctionDonationFails(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	bidder := mockAddrList()[0]
+	fee := sdk.NewInt(input.tk.GetParams(input.ctx).GetIssueTokenFee(symbol))
+	err := input.tk.IssueToken(input.ctx, "CET token", dex.CET, sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+	require.NoError(t, input.tk.AddToken(input.ctx, bidder, types.NewTokenCoins(dex.CET, fee)))
+	auction, err := input.tk.OpenSymbolAuction(input.ctx, symbol, bidder, fee)
+	require.NoError(t, err)
+
+	// the winning bid is refunded without reservation
+	input.dk.err = sdk.ErrInternal("donation failed")
+	ctx := input.ctx.WithBlockTime(time.Unix(auction.EndTime, 0))
+	require.Equal(t, 0, len(input.tk.SettleEndedSymbolAuctions(ctx)))
+	require.Nil(t, input.tk.GetSymbolAuction(ctx, symbol))
+	require.Nil(t, input.tk.GetSymbolReservation(ctx, symbol))
+	require.True(t, input.bkx.GetFrozenCoins(ctx, bidder).AmountOf(dex.CET).IsZero())
+	require.Equal(t, fee, input.bkx.GetTotalCoins(ctx, bidder).AmountOf(dex.CET))
+	require.True(t, input.dk.donated.IsZero())
+	_, err = input.tk.OpenSymbolAuction(ctx, symbol, bidder, fee)
+	require.NoError(t, err)
+}
+
+func TestTokenKeeper_SymbolReservationExpiry(t *testing.T) {
+	input := createTestInput()
+	symbol := "xyz"
+	opener := mockAddrList()[0]
+	other := mockAddrList()[1]
+	fee := sdk.NewInt(input.tk.GetParams(input.ctx).GetIssueTokenFee(symbol))
+	err := input.tk.IssueToken(input.ctx, "CET token", dex.CET, sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+	for _, addr := range []sdk.AccAddress{opener, other} {
+		require.NoError(t, input.tk.AddToken(input.ctx, addr, types.NewTokenCoins(dex.CET, fee)))
+	}
+
+	auction, err := input.tk.OpenSymbolAuction(input.ctx, symbol, opener, fee)
+	require.NoError(t, err)
+	ctx := input.ctx.WithBlockTime(time.Unix(auction.EndTime, 0))
+	require.Equal(t, 1, len(input.tk.SettleEndedSymbolAuctions(ctx)))
+	require.Equal(t, 0, len(input.tk.SettleEndedSymbolAuctions(ctx)))
+	require.Equal(t, 0, len(input.tk.RemoveExpiredSymbolReservations(ctx)))
+	expiredCtx, _ := input.ctx.WithBlockTime(time.Unix(auction.EndTime+types.SymbolReservationTimeout, 0)).CacheContext()
+	require.Equal(t, 1, len(input.tk.RemoveExpiredSymbolReservations(expiredCtx)))
+
+	// the symbol can be auctioned again after the deadline
+	deadline := auction.EndTime + types.SymbolReservationTimeout
+	ctx = input.ctx.WithBlockTime(time.Unix(deadline, 0))
+	require.Nil(t, input.tk.GetActiveSymbolReservation(ctx, symbol))
+	_, err = input.tk.OpenSymbolAuction(ctx, symbol, other, fee)
+	require.NoError(t, err)
+	require.Nil(t, input.tk.GetSymbolReservation(ctx, symbol))
+	require.Equal(t, 0, len(input.tk.RemoveExpiredSymbolReservations(ctx)))
+}
+
+func TestTokenKeeper_DistributeToHolders(t *testing.T) {
+	input := createTestInput()
+	ctx := input.ctx.WithBlockHeight(10)
+	require.NoError(t, input.tk.AddToken(ctx, testAddr, dex.NewCetCoins(types.DefaultSnapshotFee*10)))
+	symbol := "abc"
+	denom := "xyz"
+	addrs := mockAddrList()
+
+	for _, s := range []string{symbol, denom} {
+		err := input.tk.IssueToken(ctx, "ABC token", s, sdk.NewInt(2100), testAddr,
+			false, false, false, false, "", "", types.TestIdentityString)
+		require.NoError(t, err)
+		err = input.tk.SendCoinsFromAssetModuleToAccount(ctx, testAddr, types.NewTokenCoins(s, sdk.NewInt(2100)))
+		require.NoError(t, err)
+	}
+	for i, amt := range []int64{100, 50, 30} {
+		require.NoError(t, input.bkx.SendCoins(ctx, testAddr, addrs[i], types.NewTokenCoins(symbol, sdk.NewInt(amt))))
+	}
+	excluded := []sdk.AccAddress{testAddr, addrs[2]}
+
+	_, err := input.tk.DistributeToHolders(ctx, symbol, addrs[0], denom, sdk.NewInt(1000), excluded)
+	require.Equal(t, types.CodeNeedTokenOwner, err.Code())
+	_, err = input.tk.DistributeToHolders(ctx, symbol, testAddr, "eth", sdk.NewInt(1000), excluded)
+	require.Equal(t, types.CodeTokenNotFound, err.Code())
+	_, err = input.tk.DistributeToHolders(ctx, symbol, testAddr, denom, sdk.NewInt(3000), excluded)
+	require.Error(t, err)
+	d, err := input.tk.DistributeToHolders(ctx, symbol, testAddr, denom, sdk.NewInt(1000), excluded)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), d.ID)
+	require.Equal(t, sdk.NewInt(1000), input.bkx.GetFrozenCoins(ctx, testAddr).AmountOf(denom))
+
+	// nothing is paid until the snapshot is taken
+	require.Nil(t, input.tk.ProcessDistributions(ctx, 10))
+	require.False(t, input.tk.GetDistribution(ctx, d.ID).Counted)
+	require.Equal(t, 1, len(input.tk.TakePendingSnapshots(ctx, types.SnapshotEntriesPerBlock)))
+
+	// the 4 holders are counted and paid in batches
+	require.Nil(t, input.tk.ProcessDistributions(ctx, 2))
+	require.False(t, input.tk.GetDistribution(ctx, d.ID).Counted)
+	require.Nil(t, input.tk.ProcessDistributions(ctx, 2))
+	progress := input.tk.GetDistribution(ctx, d.ID)
+	require.True(t, progress.Counted)
+	require.Equal(t, sdk.NewInt(150), progress.Eligible)
+	require.True(t, progress.Distributed.IsZero())
+	require.Nil(t, input.tk.ProcessDistributions(ctx, 3))
+	settled := input.tk.ProcessDistributions(ctx, 3)
+	require.Equal(t, 1, len(settled))
+	require.Nil(t, input.tk.ProcessDistributions(ctx, 3))
+
+	d = settled[0]
+	require.Equal(t, d, *input.tk.GetDistribution(ctx, d.ID))
+	require.Equal(t, int64(10), d.SettleHeight)
+	require.Equal(t, uint64(2), d.Recipients)
+	require.Equal(t, sdk.NewInt(999), d.Distributed)
+	require.Equal(t, sdk.NewInt(1), d.Dust())
+	require.Equal(t, sdk.NewInt(666), input.bkx.GetTotalCoins(ctx, addrs[0]).AmountOf(denom))
+	require.Equal(t, sdk.NewInt(333), input.bkx.GetTotalCoins(ctx, addrs[1]).AmountOf(denom))
+	require.True(t, input.bkx.GetTotalCoins(ctx, addrs[2]).AmountOf(denom).IsZero())
+	// the dust is returned to the owner
+	require.True(t, input.bkx.GetFrozenCoins(ctx, testAddr).AmountOf(denom).IsZero())
+	require.Equal(t, sdk.NewInt(1101), input.bkx.GetCoins(ctx, testAddr).AmountOf(denom))
+	require.Equal(t, []types.Distribution{d}, input.tk.GetTokenDistributions(ctx, symbol))
+	require.Equal(t, 0, len(input.tk.GetTokenDistributions(ctx, denom)))
+}
+
+func TestTokenKeeper_DistributeToForbiddenHolder(t *testing.T) {
+	input := createTestInput()
+	ctx := input.ctx.WithBlockHeight(10)
+	require.NoError(t, input.tk.AddToken(ctx, testAddr, dex.NewCetCoins(types.DefaultSnapshotFee*10)))
+	symbol := "abc"
+	denom := "xyz"
+	addrs := mockAddrList()
+
+	for _, s := range []string{symbol, denom} {
+		err := input.tk.IssueToken(ctx, "ABC token", s, sdk.NewInt(2100), testAddr,
+			false, false, true, false, "", "", types.TestIdentityString)
+		require.NoError(t, err)
+		err = input.tk.SendCoinsFromAssetModuleToAccount(ctx, testAddr, types.NewTokenCoins(s, sdk.NewInt(2100)))
+		require.NoError(t, err)
+	}
+	for i, amt := range []int64{100, 50} {
+		require.NoError(t, input.bkx.SendCoins(ctx, testAddr, addrs[i], types.NewTokenCoins(symbol, sdk.NewInt(amt))))
+	}
+	require.NoError(t, input.tk.ForbidAddress(ctx, denom, testAddr, []sdk.AccAddress{addrs[1]}))
+
+	// the share of the forbidden holder is returned to the owner as dust
+	_, err := input.tk.DistributeToHolders(ctx, symbol, testAddr, denom, sdk.NewInt(150), []sdk.AccAddress{testAddr})
+	require.NoError(t, err)
+	input.tk.TakePendingSnapshots(ctx, types.SnapshotEntriesPerBlock)
+	settled := input.tk.ProcessDistributions(ctx, 10)
+	require.Equal(t, 1, len(settled))
+	require.Equal(t, uint64(1), settled[0].Recipients)
+	require.Equal(t, sdk.NewInt(50), settled[0].Dust())
+	require.Equal(t, sdk.NewInt(100), input.bkx.GetTotalCoins(ctx, addrs[0]).AmountOf(denom))
+	require.True(t, input.bkx.GetTotalCoins(ctx, addrs[1]).AmountOf(denom).IsZero())
+	require.Equal(t, sdk.NewInt(2000), input.bkx.GetCoins(ctx, testAddr).AmountOf(denom))
+}
+
+func TestTokenKeeper_DistributeToNoHolders(t *testing.T) {
+	input := createTestInput()
+	ctx := input.ctx.WithBlockHeight(10)
+	require.NoError(t, input.tk.AddToken(ctx, testAddr, dex.NewCetCoins(types.DefaultSnapshotFee*10)))
+	symbol := "abc"
+
+	err := input.tk.IssueToken(ctx, "ABC token", symbol, sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+	err = input.tk.SendCoinsFromAssetModuleToAccount(ctx, testAddr, types.NewTokenCoins(symbol, sdk.NewInt(2100)))
+	require.NoError(t, err)
+
+	// the owner is the only holder and is excluded by default, so the whole amount is returned
+	d, err := input.tk.DistributeToHolders(ctx, symbol, testAddr, symbol, sdk.NewInt(100), nil)
+	require.NoError(t, err)
+	input.tk.TakePendingSnapshots(ctx, types.SnapshotEntriesPerBlock)
+	settled := input.tk.ProcessDistributions(ctx, 10)
+	require.Equal(t, 1, len(settled))
+	require.Equal(t, d.ID, settled[0].ID)
+	require.Equal(t, uint64(0), settled[0].Recipients)
+	require.Equal(t, sdk.NewInt(100), settled[0].Dust())
+	require.Equal(t, sdk.NewInt(2100), input.bkx.GetCoins(ctx, testAddr).AmountOf(symbol))
+}
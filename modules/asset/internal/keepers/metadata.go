@@ -0,0 +1,20 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// SetTokenMetadata - replace the display metadata of a token, which is always modifiable by the owner
+func (keeper BaseKeeper) SetTokenMetadata(ctx sdk.Context, symbol string, owner sdk.AccAddress, metadata types.TokenMetadata) sdk.Error {
+	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	if err != nil {
+		return err
+	}
+
+	if err := token.SetMetadata(metadata); err != nil {
+		return err
+	}
+	return keeper.SetToken(ctx, token)
+}
@@ -0,0 +1,152 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// SetMintPolicy - set the immutable mint policy of a token, which limits how much the owner can mint by MintToken
+func (keeper BaseKeeper) SetMintPolicy(ctx sdk.Context, symbol string, owner sdk.AccAddress, policy types.MintPolicy) sdk.Error {
+	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	if err != nil {
+		return err
+	}
+
+	if !token.GetMintable() {
+		return types.ErrTokenMintNotSupported(symbol)
+	}
+	if token.GetMintScheduled() {
+		return types.ErrTokenMintScheduled(symbol)
+	}
+	if token.GetMintPolicy() != nil {
+		return types.ErrMintPolicyExists(symbol)
+	}
+	if policy.HasSupplyCap() && token.GetTotalSupply().GT(policy.SupplyCap) {
+		return types.ErrInvalidMintPolicy("the supply cap is lower than the total supply")
+	}
+
+	if err := token.SetMintPolicy(policy); err != nil {
+		return err
+	}
+	return keeper.SetToken(ctx, token)
+}
+
+// applyMintPolicy - check whether amount can be minted under the mint policy of a token, and record the mint
+// for the rate limit
+func (keeper BaseKeeper) applyMintPolicy(ctx sdk.Context, token types.Token, amount sdk.Int) sdk.Error {
+	policy := token.GetMintPolicy()
+	if policy == nil {
+		return nil
+	}
+
+	symbol := token.GetSymbol()
+	if policy.HasSupplyCap() && token.GetTotalSupply().Add(amount).GT(policy.SupplyCap) {
+		return types.ErrMintCapExceeded(symbol, policy.SupplyCap)
+	}
+	if !policy.HasRateLimit() {
+		return nil
+	}
+
+	now := ctx.BlockHeader().Time.Unix()
+	keeper.removeExpiredMintRecords(ctx, symbol, now-policy.WindowSeconds)
+	minted := keeper.getWindowMintedAmount(ctx, symbol, now-policy.WindowSeconds)
+	if minted.Add(amount).GT(policy.WindowAmount) {
+		return types.ErrMintRateLimited(symbol, policy.WindowAmount.Sub(minted))
+	}
+
+	record := types.NewMintRecord(symbol, now, amount)
+	if bz := ctx.KVStore(keeper.storeKey).Get(types.GetMintRecordStoreKey(symbol, now)); bz != nil {
+		var mintedInBlock types.MintRecord
+		keeper.cdc.MustUnmarshalBinaryBare(bz, &mintedInBlock)
+		record.Amount = record.Amount.Add(mintedInBlock.Amount)
+	}
+	keeper.SetMintRecord(ctx, record)
+	return nil
+}
+
+// removeExpiredMintRecords - remove the records which are not later than windowStart
+func (keeper BaseKeeper) removeExpiredMintRecords(ctx sdk.Context, symbol string, windowStart int64) {
+	var expired []types.MintRecord
+	keeper.iterateMintRecords(ctx, types.GetMintRecordKeyPrefix(symbol), func(r types.MintRecord) (stop bool) {
+		if r.Time > windowStart {
+			return true
+		}
+		expired = append(expired, r)
+		return false
+	})
+
+	store := ctx.KVStore(keeper.storeKey)
+	for _, r := range expired {
+		store.Delete(types.GetMintRecordStoreKey(r.Symbol, r.Time))
+	}
+}
+
+// SetMintRecord - set the amount minted at a block time, which is also used to import genesis.json
+func (keeper BaseTokenKeeper) SetMintRecord(ctx sdk.Context, r types.MintRecord) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetMintRecordStoreKey(r.Symbol, r.Time), keeper.cdc.MustMarshalBinaryBare(r))
+}
+
+// GetMintPolicyInfo - return the mint policy of a token and how much it can still mint, nil if it has no policy
+func (keeper BaseTokenKeeper) GetMintPolicyInfo(ctx sdk.Context, symbol string) *types.MintPolicyInfo {
+	token := keeper.GetToken(ctx, symbol)
+	if token == nil || token.GetMintPolicy() == nil {
+		return nil
+	}
+
+	policy := *token.GetMintPolicy()
+	info := &types.MintPolicyInfo{
+		Symbol:       symbol,
+		Policy:       policy,
+		WindowMinted: sdk.ZeroInt(),
+	}
+	// a policy has at least one of the two limits
+	if policy.HasSupplyCap() {
+		info.Mintable = policy.SupplyCap.Sub(token.GetTotalSupply())
+	}
+	if policy.HasRateLimit() {
+		now := ctx.BlockHeader().Time.Unix()
+		info.WindowMinted = keeper.getWindowMintedAmount(ctx, symbol, now-policy.WindowSeconds)
+		available := policy.WindowAmount.Sub(info.WindowMinted)
+		if info.Mintable == (sdk.Int{}) || available.LT(info.Mintable) {
+			info.Mintable = available
+		}
+	}
+	return info
+}
+
+// getWindowMintedAmount - return the amount minted later than windowStart
+func (keeper BaseTokenKeeper) getWindowMintedAmount(ctx sdk.Context, symbol string, windowStart int64) sdk.Int {
+	minted := sdk.ZeroInt()
+	keeper.iterateMintRecords(ctx, types.GetMintRecordKeyPrefix(symbol), func(r types.MintRecord) (stop bool) {
+		if r.Time > windowStart {
+			minted = minted.Add(r.Amount)
+		}
+		return false
+	})
+	return minted
+}
+
+// GetAllMintRecords - return the mint records of all the tokens, which is used to export genesis.json
+func (keeper BaseTokenKeeper) GetAllMintRecords(ctx sdk.Context) []types.MintRecord {
+	res := make([]types.MintRecord, 0)
+	keeper.iterateMintRecords(ctx, types.MintRecordKey, func(r types.MintRecord) (stop bool) {
+		res = append(res, r)
+		return false
+	})
+	return res
+}
+
+func (keeper BaseTokenKeeper) iterateMintRecords(ctx sdk.Context, prefix []byte, process func(r types.MintRecord) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var r types.MintRecord
+		keeper.cdc.MustUnmarshalBinaryBare(iter.Value(), &r)
+		if process(r) {
+			return
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// SetMintSchedule - register the immutable mint schedule of a token, after which the token can
+// not be minted by MintToken any more. A token with a mint policy can not have a schedule, whose
+// mints would bypass the supply cap and the rate limit
+func (keeper BaseKeeper) SetMintSchedule(ctx sdk.Context, symbol string, owner sdk.AccAddress, schedule types.MintSchedule) sdk.Error {
+	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	if err != nil {
+		return err
+	}
+
+	if !token.GetMintable() {
+		return types.ErrTokenMintNotSupported(symbol)
+	}
+	if token.GetMintScheduled() || keeper.GetMintSchedule(ctx, symbol) != nil {
+		return types.ErrMintScheduleExists(symbol)
+	}
+	if token.GetMintPolicy() != nil {
+		return types.ErrMintPolicyExists(symbol)
+	}
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+	if schedule.StartTime() <= ctx.BlockHeader().Time.Unix() {
+		return types.ErrInvalidMintSchedule("the schedule must start later than the current block")
+	}
+	for _, addr := range schedule.Recipients() {
+		if keeper.bkx.BlacklistedAddr(addr) {
+			return types.ErrAccInBlackList(addr)
+		}
+	}
+
+	token.SetMintScheduled(true)
+	if err := token.SetScheduledSupply(schedule.TotalAmount()); err != nil {
+		return err
+	}
+	if err := keeper.SetToken(ctx, token); err != nil {
+		return err
+	}
+	keeper.setMintScheduleInfo(ctx, types.NewMintScheduleInfo(symbol, schedule))
+	return nil
+}
+
+// ExecuteMintSchedule - mint the tranches which are due at the current block time and send them
+// to their recipients, the executed mints are returned. The scheduled mints obey the same rules as
+// transfers, i.e. nothing is minted for a forbidden token, or to a recipient which is forbidden or
+// not whitelisted by the issuer
+func (keeper BaseKeeper) ExecuteMintSchedule(ctx sdk.Context, info types.MintScheduleInfo) ([]types.MintTranche, sdk.Error) {
+	mints := info.Execute(ctx.BlockHeader().Time.Unix())
+	if len(mints) == 0 {
+		return nil, nil
+	}
+
+	token := keeper.GetToken(ctx, info.Symbol)
+	if token == nil {
+		return nil, types.ErrTokenNotFound(info.Symbol)
+	}
+	if keeper.IsTokenForbidden(ctx, info.Symbol) {
+		return nil, types.ErrScheduledMintForbidden(info.Symbol, "the token is forbidden")
+	}
+	for _, mint := range mints {
+		if keeper.IsForbiddenByTokenIssuer(ctx, info.Symbol, mint.Recipient) {
+			return nil, types.ErrScheduledMintForbidden(info.Symbol, mint.Recipient.String()+" is forbidden")
+		}
+		if !keeper.IsPermittedByTokenIssuer(ctx, info.Symbol, mint.Recipient) {
+			return nil, types.ErrScheduledMintForbidden(info.Symbol, mint.Recipient.String()+" is not whitelisted")
+		}
+		if err := token.SetTotalMint(token.GetTotalMint().Add(mint.Amount)); err != nil {
+			return nil, err
+		}
+		if err := token.SetTotalSupply(token.GetTotalSupply().Add(mint.Amount)); err != nil {
+			return nil, err
+		}
+		if err := token.SetScheduledSupply(token.GetScheduledSupply().Sub(mint.Amount)); err != nil {
+			return nil, err
+		}
+		coins := types.NewTokenCoins(info.Symbol, mint.Amount)
+		if err := keeper.sk.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return nil, err
+		}
+		if err := keeper.SendCoinsFromAssetModuleToAccount(ctx, mint.Recipient, coins); err != nil {
+			return nil, err
+		}
+	}
+	if err := keeper.SetToken(ctx, token); err != nil {
+		return nil, err
+	}
+	keeper.setMintScheduleInfo(ctx, info)
+	return mints, nil
+}
+
+// StopMintSchedule - stop a schedule whose mints failed, so that it is not tried again in every block,
+// the amount it has not minted is no longer reserved in the scheduled supply of the token
+func (keeper BaseKeeper) StopMintSchedule(ctx sdk.Context, info types.MintScheduleInfo) {
+	if token := keeper.GetToken(ctx, info.Symbol); token != nil {
+		remaining := token.GetScheduledSupply().Sub(info.Remaining())
+		if remaining.IsNegative() {
+			remaining = sdk.ZeroInt()
+		}
+		if token.SetScheduledSupply(remaining) == nil {
+			_ = keeper.SetToken(ctx, token)
+		}
+	}
+	info.Stopped = true
+	keeper.setMintScheduleInfo(ctx, info)
+}
+
+// GetDueMintSchedules - return the schedules which have some mints due at the current block time,
+// in the order of their due time
+func (keeper BaseTokenKeeper) GetDueMintSchedules(ctx sdk.Context) []types.MintScheduleInfo {
+	store := ctx.KVStore(keeper.storeKey)
+	end := types.GetMintScheduleDueStoreKey(ctx.BlockHeader().Time.Unix()+1, "")
+	iter := store.Iterator(types.MintScheduleDueKey, end)
+	defer iter.Close()
+	var infos []types.MintScheduleInfo
+	for ; iter.Valid(); iter.Next() {
+		symbol := string(iter.Key()[len(types.MintScheduleDueKey)+8:])
+		if info := keeper.GetMintSchedule(ctx, symbol); info != nil {
+			infos = append(infos, *info)
+		}
+	}
+	return infos
+}
+
+// GetMintSchedule - return the mint schedule of a token, or nil if it has none
+func (keeper BaseTokenKeeper) GetMintSchedule(ctx sdk.Context, symbol string) *types.MintScheduleInfo {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GetMintScheduleStoreKey(symbol))
+	if bz == nil {
+		return nil
+	}
+	var info types.MintScheduleInfo
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &info)
+	return &info
+}
+
+// GetAllMintSchedules - return all the mint schedules, including the finished ones
+func (keeper BaseTokenKeeper) GetAllMintSchedules(ctx sdk.Context) []types.MintScheduleInfo {
+	infos := make([]types.MintScheduleInfo, 0)
+	keeper.IterateMintSchedules(ctx, func(info types.MintScheduleInfo) {
+		infos = append(infos, info)
+	})
+	return infos
+}
+
+func (keeper BaseTokenKeeper) IterateMintSchedules(ctx sdk.Context, process func(info types.MintScheduleInfo)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.MintScheduleKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var info types.MintScheduleInfo
+		keeper.cdc.MustUnmarshalBinaryBare(iter.Value(), &info)
+		process(info)
+	}
+}
+
+// ImportGenesisMintSchedule - import a mint schedule from genesis.json
+func (keeper BaseTokenKeeper) ImportGenesisMintSchedule(ctx sdk.Context, info types.MintScheduleInfo) {
+	keeper.setMintScheduleInfo(ctx, info)
+}
+
+// the schedules are also indexed by their next due time, so that the EndBlocker only loads the due ones
+func (keeper BaseTokenKeeper) setMintScheduleInfo(ctx sdk.Context, info types.MintScheduleInfo) {
+	store := ctx.KVStore(keeper.storeKey)
+	if old := keeper.GetMintSchedule(ctx, info.Symbol); old != nil {
+		if due, ok := old.NextDueTime(); ok {
+			store.Delete(types.GetMintScheduleDueStoreKey(due, info.Symbol))
+		}
+	}
+	store.Set(types.GetMintScheduleStoreKey(info.Symbol), keeper.cdc.MustMarshalBinaryBare(info))
+	if due, ok := info.NextDueTime(); ok {
+		store.Set(types.GetMintScheduleDueStoreKey(due, info.Symbol), []byte{})
+	}
+}
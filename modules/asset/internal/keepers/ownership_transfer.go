@@ -0,0 +1,128 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// AcceptOwnership - the nominated owner accepts the ownership of a token, which completes the transfer
+func (keeper BaseKeeper) AcceptOwnership(ctx sdk.Context, symbol string, newOwner sdk.AccAddress) (types.PendingOwnershipTransfer, sdk.Error) {
+	transfer := keeper.GetPendingOwnershipTransfer(ctx, symbol)
+	if transfer == nil || transfer.Deadline <= ctx.BlockHeader().Time.Unix() {
+		return types.PendingOwnershipTransfer{}, types.ErrNoPendingOwnershipTransfer(symbol)
+	}
+	if !transfer.NewOwner.Equals(newOwner) {
+		return types.PendingOwnershipTransfer{}, types.ErrNotPendingTokenOwner(transfer.NewOwner)
+	}
+	if keeper.bkx.BlacklistedAddr(newOwner) {
+		return types.PendingOwnershipTransfer{}, types.ErrAccInBlackList(newOwner)
+	}
+
+	token := keeper.GetToken(ctx, symbol)
+	if token == nil {
+		return types.PendingOwnershipTransfer{}, types.ErrTokenNotFound(symbol)
+	}
+	// the nomination is stale if the owner has changed since then
+	if !token.GetOwner().Equals(transfer.OriginalOwner) {
+		keeper.RemovePendingOwnershipTransfer(ctx, symbol)
+		return types.PendingOwnershipTransfer{}, types.ErrNoPendingOwnershipTransfer(symbol)
+	}
+	if err := token.SetOwner(newOwner); err != nil {
+		return types.PendingOwnershipTransfer{}, err
+	}
+	if err := keeper.SetToken(ctx, token); err != nil {
+		return types.PendingOwnershipTransfer{}, err
+	}
+
+	keeper.RemovePendingOwnershipTransfer(ctx, symbol)
+	return *transfer, nil
+}
+
+// CancelOwnershipTransfer - the owner cancels the pending ownership transfer of a token
+func (keeper BaseKeeper) CancelOwnershipTransfer(ctx sdk.Context, symbol string, owner sdk.AccAddress) (types.PendingOwnershipTransfer, sdk.Error) {
+	if _, err := keeper.checkPrecondition(ctx, symbol, owner); err != nil {
+		return types.PendingOwnershipTransfer{}, err
+	}
+	transfer := keeper.GetPendingOwnershipTransfer(ctx, symbol)
+	if transfer == nil {
+		return types.PendingOwnershipTransfer{}, types.ErrNoPendingOwnershipTransfer(symbol)
+	}
+
+	keeper.RemovePendingOwnershipTransfer(ctx, symbol)
+	return *transfer, nil
+}
+
+// RemoveExpiredOwnershipTransfers - remove the ownership transfers which are not accepted before their deadlines
+func (keeper BaseKeeper) RemoveExpiredOwnershipTransfers(ctx sdk.Context) []types.PendingOwnershipTransfer {
+	store := ctx.KVStore(keeper.storeKey)
+	end := types.GetPendingOwnerDeadlineStoreKey(ctx.BlockHeader().Time.Unix()+1, "")
+	iter := store.Iterator(types.PendingOwnerDeadlineKey, end)
+	var expired []types.PendingOwnershipTransfer
+	for ; iter.Valid(); iter.Next() {
+		symbol := string(iter.Key()[len(types.PendingOwnerDeadlineKey)+8:])
+		if transfer := keeper.GetPendingOwnershipTransfer(ctx, symbol); transfer != nil {
+			expired = append(expired, *transfer)
+		}
+	}
+	iter.Close()
+	for _, transfer := range expired {
+		keeper.RemovePendingOwnershipTransfer(ctx, transfer.Symbol)
+	}
+	return expired
+}
+
+func (keeper BaseKeeper) IsSubscribed(topic string) bool {
+	return keeper.msgProducer.IsSubscribed(topic)
+}
+
+// SetPendingOwnershipTransfer - set the pending ownership transfer of a token, a later nomination
+// replaces the earlier one. The transfers are also indexed by their deadlines, so that the EndBlocker
+// only loads the expired ones
+func (keeper BaseTokenKeeper) SetPendingOwnershipTransfer(ctx sdk.Context, transfer types.PendingOwnershipTransfer) {
+	keeper.RemovePendingOwnershipTransfer(ctx, transfer.Symbol)
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetPendingOwnerStoreKey(transfer.Symbol), keeper.cdc.MustMarshalBinaryBare(transfer))
+	store.Set(types.GetPendingOwnerDeadlineStoreKey(transfer.Deadline, transfer.Symbol), []byte{})
+}
+
+func (keeper BaseTokenKeeper) RemovePendingOwnershipTransfer(ctx sdk.Context, symbol string) {
+	transfer := keeper.GetPendingOwnershipTransfer(ctx, symbol)
+	if transfer == nil {
+		return
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetPendingOwnerStoreKey(symbol))
+	store.Delete(types.GetPendingOwnerDeadlineStoreKey(transfer.Deadline, symbol))
+}
+
+// GetPendingOwnershipTransfer - return the pending ownership transfer of a token, or nil if it has none
+func (keeper BaseTokenKeeper) GetPendingOwnershipTransfer(ctx sdk.Context, symbol string) *types.PendingOwnershipTransfer {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GetPendingOwnerStoreKey(symbol))
+	if bz == nil {
+		return nil
+	}
+	var transfer types.PendingOwnershipTransfer
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &transfer)
+	return &transfer
+}
+
+func (keeper BaseTokenKeeper) GetAllPendingOwnershipTransfers(ctx sdk.Context) []types.PendingOwnershipTransfer {
+	transfers := make([]types.PendingOwnershipTransfer, 0)
+	keeper.IteratePendingOwnershipTransfers(ctx, func(transfer types.PendingOwnershipTransfer) {
+		transfers = append(transfers, transfer)
+	})
+	return transfers
+}
+
+func (keeper BaseTokenKeeper) IteratePendingOwnershipTransfers(ctx sdk.Context, process func(transfer types.PendingOwnershipTransfer)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PendingOwnerKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var transfer types.PendingOwnershipTransfer
+		keeper.cdc.MustUnmarshalBinaryBare(iter.Value(), &transfer)
+		process(transfer)
+	}
+}
@@ -1,6 +1,8 @@
 package keepers
 
 import (
+	"bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 
@@ -18,7 +20,14 @@ func (keeper BaseKeeper) SetParams(ctx sdk.Context, params types.Params) {
 }
 
 // GetParams gets the asset module's parameters.
+// The chains started before snapshots were introduced have no SnapshotFee, the default fee is used then.
 func (keeper BaseKeeper) GetParams(ctx sdk.Context) (params types.Params) {
-	keeper.paramSubspace.GetParamSet(ctx, &params)
+	for _, pair := range params.ParamSetPairs() {
+		if bytes.Equal(pair.Key, types.KeySnapshotFee) && !keeper.paramSubspace.Has(ctx, pair.Key) {
+			params.SnapshotFee = types.DefaultSnapshotFee
+			continue
+		}
+		keeper.paramSubspace.Get(ctx, pair.Key, pair.Value)
+	}
 	return
 }
@@ -0,0 +1,43 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// SetTokenPermissioned - restrict the token to the owner and the whitelisted addresses,
+// which is only allowed before the token has been distributed
+func (keeper BaseKeeper) SetTokenPermissioned(ctx sdk.Context, symbol string, owner sdk.AccAddress) sdk.Error {
+	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	if err != nil {
+		return err
+	}
+
+	if token.GetPermissioned() {
+		return nil
+	}
+
+	// the holders which are not whitelisted would be locked out
+	ownerAmt := keeper.bkx.GetTotalCoins(ctx, owner).AmountOf(symbol)
+	if !ownerAmt.Equal(token.GetTotalSupply()) {
+		return types.ErrCodeTokenInfoSealed("Permissioned")
+	}
+
+	token.SetPermissioned(true)
+	return keeper.SetToken(ctx, token)
+}
+
+// IsPermittedByTokenIssuer - check whether addr could hold, send, receive or trade the token,
+// only the owner and the whitelisted addresses are permitted for a permissioned token
+func (keeper BaseTokenKeeper) IsPermittedByTokenIssuer(ctx sdk.Context, symbol string, addr sdk.AccAddress) bool {
+	token := keeper.GetToken(ctx, symbol)
+	if token == nil || !token.GetPermissioned() {
+		return true
+	}
+	if token.GetOwner().Equals(addr) {
+		return true
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Has(types.GetWhitelistStoreKey(symbol, addr))
+}
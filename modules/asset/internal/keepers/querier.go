@@ -3,6 +3,7 @@ package keepers
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -25,6 +26,46 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryWhitelist(ctx, req, keeper)
 		case types.QueryForbiddenAddr:
 			return queryForbiddenAddr(ctx, req, keeper)
+		case types.QueryMintSchedule:
+			return queryMintSchedule(ctx, req, keeper)
+		case types.QueryCoOwners:
+			return queryCoOwners(ctx, req, keeper)
+		case types.QueryProposals:
+			return queryProposals(ctx, req, keeper)
+		case types.QueryPendingOwner:
+			return queryPendingOwner(ctx, req, keeper)
+		case types.QueryPendingOwners:
+			return queryPendingOwners(ctx, keeper)
+		case types.QueryFrozenAmounts:
+			return queryFrozenAmounts(ctx, req, keeper)
+		case types.QueryAddrFrozen:
+			return queryAddrFrozenAmounts(ctx, req, keeper)
+		case types.QuerySnapshot:
+			return querySnapshot(ctx, req, keeper)
+		case types.QuerySnapshots:
+			return querySnapshots(ctx, req, keeper)
+		case types.QuerySnapshotBalance:
+			return querySnapshotBalances(ctx, req, keeper)
+		case types.QuerySymbolAuction:
+			return querySymbolAuction(ctx, req, keeper)
+		case types.QuerySymbolAuctions:
+			return querySymbolAuctions(ctx, keeper)
+		case types.QuerySymbolReservation:
+			return querySymbolReservation(ctx, req, keeper)
+		case types.QueryDistribution:
+			return queryDistribution(ctx, req, keeper)
+		case types.QueryDistributions:
+			return queryDistributions(ctx, req, keeper)
+		case types.QueryTokenMetadata:
+			return queryTokenMetadata(ctx, req, keeper)
+		case types.QueryMintPolicy:
+			return queryMintPolicy(ctx, req, keeper)
+		case types.QueryHolderCount:
+			return queryHolderCount(ctx, req, keeper)
+		case types.QueryTopHolders:
+			return queryTopHolders(ctx, req, keeper)
+		case types.QueryHolderRank:
+			return queryHolderRank(ctx, req, keeper)
 		case types.QueryReservedSymbols:
 			return queryReservedSymbols()
 		default:
@@ -100,6 +141,388 @@ func queryForbiddenAddr(ctx sdk.Context, req abci.RequestQuery, keeper TokenKeep
 	return bz, nil
 }
 
+func queryMintSchedule(ctx sdk.Context, req abci.RequestQuery, keeper TokenKeeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	info := keeper.GetMintSchedule(ctx, params.Symbol)
+	if info == nil {
+		return nil, types.ErrInvalidMintSchedule("token " + params.Symbol + " has no mint schedule")
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, info)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryTokenMetadata(ctx sdk.Context, req abci.RequestQuery, keeper TokenKeeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	token := keeper.GetToken(ctx, params.Symbol)
+	if token == nil {
+		return nil, types.ErrTokenNotFound(params.Symbol)
+	}
+	if token.GetMetadata() == nil {
+		return nil, types.ErrInvalidTokenMetadata("token " + params.Symbol + " has no metadata")
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, token.GetMetadata())
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryMintPolicy(ctx sdk.Context, req abci.RequestQuery, keeper TokenKeeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if keeper.GetToken(ctx, params.Symbol) == nil {
+		return nil, types.ErrTokenNotFound(params.Symbol)
+	}
+	info := keeper.GetMintPolicyInfo(ctx, params.Symbol)
+	if info == nil {
+		return nil, types.ErrInvalidMintPolicy("token " + params.Symbol + " has no mint policy")
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, info)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryCoOwners(ctx sdk.Context, req abci.RequestQuery, keeper TokenKeeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	coOwners := keeper.GetTokenCoOwners(ctx, params.Symbol)
+	if coOwners == nil {
+		return nil, types.ErrInvalidTokenCoOwners("token " + params.Symbol + " has no co-owners")
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, coOwners)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryProposals(ctx sdk.Context, req abci.RequestQuery, keeper TokenKeeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	proposals := keeper.GetTokenActionProposals(ctx, params.Symbol)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, proposals)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryPendingOwner(ctx sdk.Context, req abci.RequestQuery, keeper TokenKeeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	transfer := keeper.GetPendingOwnershipTransfer(ctx, params.Symbol)
+	if transfer == nil {
+		return nil, types.ErrNoPendingOwnershipTransfer(params.Symbol)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, transfer)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryPendingOwners(ctx sdk.Context, keeper TokenKeeper) ([]byte, sdk.Error) {
+	transfers := keeper.GetAllPendingOwnershipTransfers(ctx)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, transfers)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func querySymbolAuction(ctx sdk.Context, req abci.RequestQuery, keeper TokenKeeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	auction := keeper.GetSymbolAuction(ctx, params.Symbol)
+	if auction == nil {
+		return nil, types.ErrSymbolAuctionNotFound(params.Symbol)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, auction)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func querySymbolAuctions(ctx sdk.Context, keeper TokenKeeper) ([]byte, sdk.Error) {
+	auctions := keeper.GetAllSymbolAuctions(ctx)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, auctions)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func querySymbolReservation(ctx sdk.Context, req abci.RequestQuery, keeper TokenKeeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	r := keeper.GetSymbolReservation(ctx, params.Symbol)
+	if r == nil {
+		return nil, types.ErrSymbolReservationNotFound(params.Symbol)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, r)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryDistribution(ctx sdk.Context, req abci.RequestQuery, keeper TokenKeeper) ([]byte, sdk.Error) {
+	var params types.QueryDistributionParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	d := keeper.GetDistribution(ctx, params.ID)
+	if d == nil {
+		return nil, types.ErrDistributionNotFound(params.ID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, d)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryDistributions(ctx sdk.Context, req abci.RequestQuery, keeper TokenKeeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	distributions := keeper.GetTokenDistributions(ctx, params.Symbol)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, distributions)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryFrozenAmounts(ctx sdk.Context, req abci.RequestQuery, keeper TokenKeeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, keeper.GetIssuerFrozenAmounts(ctx, params.Symbol))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryAddrFrozenAmounts(ctx sdk.Context, req abci.RequestQuery, keeper TokenKeeper) ([]byte, sdk.Error) {
+	var params types.QueryAddrFrozenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, keeper.GetAddrIssuerFrozenAmounts(ctx, params.Address))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func querySnapshot(ctx sdk.Context, req abci.RequestQuery, keeper TokenKeeper) ([]byte, sdk.Error) {
+	var params types.QuerySnapshotParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	snapshot := keeper.GetTokenSnapshot(ctx, params.ID)
+	if snapshot == nil {
+		return nil, types.ErrSnapshotNotFound(params.ID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, snapshot)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func querySnapshots(ctx sdk.Context, req abci.RequestQuery, keeper TokenKeeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, keeper.GetTokenSnapshots(ctx, params.Symbol))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func querySnapshotBalances(ctx sdk.Context, req abci.RequestQuery, keeper TokenKeeper) ([]byte, sdk.Error) {
+	var params types.QuerySnapshotBalancesParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	snapshot := keeper.GetTokenSnapshot(ctx, params.ID)
+	if snapshot == nil {
+		return nil, types.ErrSnapshotNotFound(params.ID)
+	}
+
+	balances := make([]types.SnapshotBalance, 0)
+	start, end := client.Paginate(int(snapshot.Holders), params.Page, params.Limit, types.DefaultSnapshotLimit)
+	if start >= 0 && end >= 0 {
+		i := 0
+		keeper.IterateSnapshotBalances(ctx, params.ID, func(b types.SnapshotBalance) bool {
+			if i >= start {
+				balances = append(balances, b)
+			}
+			i++
+			return i >= end
+		})
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, balances)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryHolderCount(ctx sdk.Context, req abci.RequestQuery, keeper TokenKeeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if keeper.GetToken(ctx, params.Symbol) == nil {
+		return nil, types.ErrTokenNotFound(params.Symbol)
+	}
+	if !types.IsHolderIndexed(params.Symbol) {
+		return nil, types.ErrHoldersNotIndexed(params.Symbol)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, keeper.GetHolderCount(ctx, params.Symbol))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryTopHolders(ctx sdk.Context, req abci.RequestQuery, keeper TokenKeeper) ([]byte, sdk.Error) {
+	var params types.QueryTopHoldersParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if keeper.GetToken(ctx, params.Symbol) == nil {
+		return nil, types.ErrTokenNotFound(params.Symbol)
+	}
+	if !types.IsHolderIndexed(params.Symbol) {
+		return nil, types.ErrHoldersNotIndexed(params.Symbol)
+	}
+
+	holders := make([]types.TokenHolder, 0)
+	count := keeper.GetHolderCount(ctx, params.Symbol)
+	start, end := client.Paginate(int(count), params.Page, params.Limit, types.DefaultHolderLimit)
+	if start >= 0 && end >= 0 {
+		i := 0
+		keeper.IterateTopHolders(ctx, params.Symbol, func(h types.TokenHolder) bool {
+			if i >= start {
+				holders = append(holders, h)
+			}
+			i++
+			return i >= end
+		})
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, holders)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryHolderRank(ctx sdk.Context, req abci.RequestQuery, keeper TokenKeeper) ([]byte, sdk.Error) {
+	var params types.QueryHolderRankParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if keeper.GetToken(ctx, params.Symbol) == nil {
+		return nil, types.ErrTokenNotFound(params.Symbol)
+	}
+	if !types.IsHolderIndexed(params.Symbol) {
+		return nil, types.ErrHoldersNotIndexed(params.Symbol)
+	}
+	holder := keeper.GetHolderRank(ctx, params.Symbol, params.Address)
+	if holder == nil {
+		return nil, types.ErrTokenHolderNotFound(params.Symbol, params.Address)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, holder)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
 func queryReservedSymbols() ([]byte, sdk.Error) {
 	reserved := types.GetReservedSymbols()
 	var s = ""
@@ -11,6 +11,7 @@ import (
 
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/keepers"
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+	dex "github.com/coinexchain/cet-sdk/types"
 )
 
 func Test_queryParams(t *testing.T) {
@@ -199,6 +200,218 @@ func Test_queryForbiddenAddr(t *testing.T) {
 
 }
 
+func Test_querySnapshotBalances(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	addrs := mockAddrList()
+	path0 := []string{types.QuerySnapshotBalance}
+	query := keepers.NewQuerier(input.tk)
+
+	// no snapshot
+	req := abci.RequestQuery{Data: input.cdc.MustMarshalJSON(types.NewQuerySnapshotBalancesParams(1, 1, 2))}
+	_, err := query(input.ctx, path0, req)
+	require.Equal(t, types.CodeSnapshotNotFound, err.Code())
+
+	snapshot := types.NewTokenSnapshot(1, symbol, testAddr, 1)
+	snapshot.Height = 1
+	snapshot.Holders = uint64(len(addrs))
+	input.tk.SetTokenSnapshot(input.ctx, snapshot)
+	for _, addr := range addrs {
+		input.tk.SetSnapshotBalance(input.ctx, types.NewSnapshotBalance(1, addr, sdk.NewInt(100)))
+	}
+
+	var all []types.SnapshotBalance
+	for page := 1; page <= 3; page++ {
+		req.Data = input.cdc.MustMarshalJSON(types.NewQuerySnapshotBalancesParams(1, page, 2))
+		res, err := query(input.ctx, path0, req)
+		require.NoError(t, err)
+		var balances []types.SnapshotBalance
+		input.cdc.MustUnmarshalJSON(res, &balances)
+		all = append(all, balances...)
+	}
+	require.Equal(t, len(addrs), len(all))
+	require.Equal(t, input.tk.GetAllSnapshotBalances(input.ctx), all)
+
+	// out of range
+	req.Data = input.cdc.MustMarshalJSON(types.NewQuerySnapshotBalancesParams(1, 10, 2))
+	res, err := query(input.ctx, path0, req)
+	require.NoError(t, err)
+	require.Equal(t, []byte("[]"), res)
+}
+
+func Test_querySymbolAuction(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	query := keepers.NewQuerier(input.tk)
+	req := abci.RequestQuery{Data: input.cdc.MustMarshalJSON(types.NewQueryAssetParams(symbol))}
+
+	_, err := query(input.ctx, []string{types.QuerySymbolAuction}, req)
+	require.Equal(t, types.CodeSymbolAuctionNotFound, err.Code())
+	_, err = query(input.ctx, []string{types.QuerySymbolReservation}, req)
+	require.Equal(t, types.CodeSymbolReservationNotFound, err.Code())
+	res, err := query(input.ctx, []string{types.QuerySymbolAuctions}, req)
+	require.NoError(t, err)
+	require.Equal(t, []byte("[]"), res)
+
+	auction := types.NewSymbolAuction(symbol, testAddr, sdk.NewInt(100), 1000)
+	input.tk.SetSymbolAuction(input.ctx, auction)
+	input.tk.SetSymbolReservation(input.ctx, types.NewSymbolReservation(symbol, testAddr, sdk.NewInt(100), 2000))
+
+	res, err = query(input.ctx, []string{types.QuerySymbolAuction}, req)
+	require.NoError(t, err)
+	var a types.SymbolAuction
+	input.cdc.MustUnmarshalJSON(res, &a)
+	require.Equal(t, auction, a)
+	res, err = query(input.ctx, []string{types.QuerySymbolAuctions}, req)
+	require.NoError(t, err)
+	var auctions []types.SymbolAuction
+	input.cdc.MustUnmarshalJSON(res, &auctions)
+	require.Equal(t, []types.SymbolAuction{auction}, auctions)
+	res, err = query(input.ctx, []string{types.QuerySymbolReservation}, req)
+	require.NoError(t, err)
+	var r types.SymbolReservation
+	input.cdc.MustUnmarshalJSON(res, &r)
+	require.Equal(t, int64(2000), r.Deadline)
+}
+
+func Test_queryDistribution(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	query := keepers.NewQuerier(input.tk)
+
+	req := abci.RequestQuery{Data: input.cdc.MustMarshalJSON(types.NewQueryDistributionParams(1))}
+	_, err := query(input.ctx, []string{types.QueryDistribution}, req)
+	require.Equal(t, types.CodeDistributionNotFound, err.Code())
+
+	d := types.NewDistribution(1, symbol, testAddr, "cet", sdk.NewInt(100), nil, 1)
+	input.tk.SetDistribution(input.ctx, d)
+	res, err := query(input.ctx, []string{types.QueryDistribution}, req)
+	require.NoError(t, err)
+	var got types.Distribution
+	input.cdc.MustUnmarshalJSON(res, &got)
+	require.Equal(t, d.Amount, got.Amount)
+	require.Equal(t, d.SnapshotID, got.SnapshotID)
+
+	req.Data = input.cdc.MustMarshalJSON(types.NewQueryAssetParams(symbol))
+	res, err = query(input.ctx, []string{types.QueryDistributions}, req)
+	require.NoError(t, err)
+	var distributions []types.Distribution
+	input.cdc.MustUnmarshalJSON(res, &distributions)
+	require.Equal(t, 1, len(distributions))
+	req.Data = input.cdc.MustMarshalJSON(types.NewQueryAssetParams("xyz"))
+	res, err = query(input.ctx, []string{types.QueryDistributions}, req)
+	require.NoError(t, err)
+	require.Equal(t, []byte("[]"), res)
+}
+
+func Test_queryTokenMetadata(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	query := keepers.NewQuerier(input.tk)
+
+	req := abci.RequestQuery{Data: input.cdc.MustMarshalJSON(types.NewQueryAssetParams(symbol))}
+	_, err := query(input.ctx, []string{types.QueryTokenMetadata}, req)
+	require.Equal(t, types.CodeTokenNotFound, err.Code())
+
+	err = input.tk.IssueToken(input.ctx, "ABC token", symbol, sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+	_, err = query(input.ctx, []string{types.QueryTokenMetadata}, req)
+	require.Equal(t, types.CodeInvalidTokenMetadata, err.Code())
+
+	metadata := types.TokenMetadata{Display: "ABC", Decimals: 8}
+	err = input.tk.SetTokenMetadata(input.ctx, symbol, testAddr, metadata)
+	require.NoError(t, err)
+	res, err := query(input.ctx, []string{types.QueryTokenMetadata}, req)
+	require.NoError(t, err)
+	var got types.TokenMetadata
+	input.cdc.MustUnmarshalJSON(res, &got)
+	require.Equal(t, metadata, got)
+}
+
+func Test_queryMintPolicy(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	query := keepers.NewQuerier(input.tk)
+
+	req := abci.RequestQuery{Data: input.cdc.MustMarshalJSON(types.NewQueryAssetParams(symbol))}
+	_, err := query(input.ctx, []string{types.QueryMintPolicy}, req)
+	require.Equal(t, types.CodeTokenNotFound, err.Code())
+
+	err = input.tk.IssueToken(input.ctx, "ABC token", symbol, sdk.NewInt(2100), testAddr,
+		true, false, false, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+	_, err = query(input.ctx, []string{types.QueryMintPolicy}, req)
+	require.Equal(t, types.CodeInvalidMintPolicy, err.Code())
+
+	policy := types.NewMintPolicy(sdk.NewInt(3000), sdk.ZeroInt(), 0)
+	err = input.tk.SetMintPolicy(input.ctx, symbol, testAddr, policy)
+	require.NoError(t, err)
+	res, err := query(input.ctx, []string{types.QueryMintPolicy}, req)
+	require.NoError(t, err)
+	var info types.MintPolicyInfo
+	input.cdc.MustUnmarshalJSON(res, &info)
+	require.Equal(t, symbol, info.Symbol)
+	require.Equal(t, "3000", info.Policy.SupplyCap.String())
+	require.Equal(t, "900", info.Mintable.String())
+}
+
+func Test_queryHolders(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	addr := mockAddrList()[0]
+	query := keepers.NewQuerier(input.tk)
+
+	countReq := abci.RequestQuery{Data: input.cdc.MustMarshalJSON(types.NewQueryAssetParams(symbol))}
+	_, err := query(input.ctx, []string{types.QueryHolderCount}, countReq)
+	require.Equal(t, types.CodeTokenNotFound, err.Code())
+
+	err = input.tk.IssueToken(input.ctx, "ABC token", symbol, sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+	err = input.tk.SendCoinsFromAssetModuleToAccount(input.ctx, testAddr, types.NewTokenCoins(symbol, sdk.NewInt(2100)))
+	require.NoError(t, err)
+	require.NoError(t, input.bkx.SendCoins(input.ctx, testAddr, addr, types.NewTokenCoins(symbol, sdk.NewInt(100))))
+
+	res, err := query(input.ctx, []string{types.QueryHolderCount}, countReq)
+	require.NoError(t, err)
+	var count uint64
+	input.cdc.MustUnmarshalJSON(res, &count)
+	require.Equal(t, uint64(2), count)
+
+	req := abci.RequestQuery{Data: input.cdc.MustMarshalJSON(types.NewQueryTopHoldersParams(symbol, 2, 1))}
+	res, err = query(input.ctx, []string{types.QueryTopHolders}, req)
+	require.NoError(t, err)
+	var holders []types.TokenHolder
+	input.cdc.MustUnmarshalJSON(res, &holders)
+	require.Equal(t, 1, len(holders))
+	require.Equal(t, addr, holders[0].Address)
+	require.Equal(t, uint64(2), holders[0].Rank)
+
+	req = abci.RequestQuery{Data: input.cdc.MustMarshalJSON(types.NewQueryHolderRankParams(symbol, testAddr))}
+	res, err = query(input.ctx, []string{types.QueryHolderRank}, req)
+	require.NoError(t, err)
+	var holder types.TokenHolder
+	input.cdc.MustUnmarshalJSON(res, &holder)
+	require.Equal(t, "2000", holder.Amount.String())
+	require.Equal(t, uint64(1), holder.Rank)
+
+	req = abci.RequestQuery{Data: input.cdc.MustMarshalJSON(types.NewQueryHolderRankParams(symbol, mockAddrList()[1]))}
+	_, err = query(input.ctx, []string{types.QueryHolderRank}, req)
+	require.Equal(t, types.CodeTokenHolderNotFound, err.Code())
+
+	// the holders of CET are not indexed
+	err = input.tk.IssueToken(input.ctx, "CET token", dex.CET, sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+	req = abci.RequestQuery{Data: input.cdc.MustMarshalJSON(types.NewQueryAssetParams(dex.CET))}
+	_, err = query(input.ctx, []string{types.QueryHolderCount}, req)
+	require.Equal(t, types.CodeHoldersNotIndexed, err.Code())
+	req = abci.RequestQuery{Data: input.cdc.MustMarshalJSON(types.NewQueryTopHoldersParams(dex.CET, 1, 10))}
+	_, err = query(input.ctx, []string{types.QueryTopHolders}, req)
+	require.Equal(t, types.CodeHoldersNotIndexed, err.Code())
+}
+
 func Test_queryReservedSymbols(t *testing.T) {
 	input := createTestInput()
 	req := abci.RequestQuery{
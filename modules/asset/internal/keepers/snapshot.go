@@ -0,0 +1,258 @@
+package keepers
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// RequestTokenSnapshot - request a snapshot of all the holders of a token, which is taken at an EndBlock,
+// the snapshot fee is charged to every requester, including the token owner
+func (keeper BaseKeeper) RequestTokenSnapshot(ctx sdk.Context, symbol string, requester sdk.AccAddress) (types.TokenSnapshot, sdk.Error) {
+	if !keeper.IsTokenExists(ctx, symbol) {
+		return types.TokenSnapshot{}, types.ErrTokenNotFound(symbol)
+	}
+	if !types.IsHolderIndexed(symbol) {
+		return types.TokenSnapshot{}, types.ErrHoldersNotIndexed(symbol)
+	}
+
+	if err := keeper.bkx.DeductInt64CetFee(ctx, requester, keeper.GetParams(ctx).SnapshotFee); err != nil {
+		return types.TokenSnapshot{}, err
+	}
+
+	snapshot := types.NewTokenSnapshot(keeper.nextSnapshotID(ctx), symbol, requester, ctx.BlockHeight())
+	keeper.SetTokenSnapshot(ctx, snapshot)
+	keeper.setPendingSnapshot(ctx, snapshot.ID)
+	return snapshot, nil
+}
+
+// TakePendingSnapshots - record the balances of the holders for the pending snapshots, the earliest first.
+// The balances are read from the holder index, so only the holders of the token are visited. The snapshots
+// taken in one block record at most limit balances, except that the earliest pending one is always taken,
+// and the others wait for the following blocks
+func (keeper BaseKeeper) TakePendingSnapshots(ctx sdk.Context, limit int) []types.TokenSnapshot {
+	var ids []uint64
+	keeper.iteratePendingSnapshotIDs(ctx, func(id uint64) {
+		ids = append(ids, id)
+	})
+
+	var taken []types.TokenSnapshot
+	store := ctx.KVStore(keeper.storeKey)
+	for _, id := range ids {
+		s := keeper.GetTokenSnapshot(ctx, id)
+		if s == nil {
+			store.Delete(types.GetPendingSnapshotStoreKey(id))
+			continue
+		}
+		if len(taken) != 0 && keeper.GetHolderCount(ctx, s.Symbol) > uint64(limit) {
+			break
+		}
+
+		keeper.IterateTopHolders(ctx, s.Symbol, func(h types.TokenHolder) bool {
+			keeper.SetSnapshotBalance(ctx, types.NewSnapshotBalance(s.ID, h.Address, h.Amount))
+			s.Holders++
+			return false
+		})
+		s.Height = ctx.BlockHeight()
+		keeper.SetTokenSnapshot(ctx, *s)
+		store.Delete(types.GetPendingSnapshotStoreKey(s.ID))
+		taken = append(taken, *s)
+		limit -= int(s.Holders)
+		if limit < 0 {
+			limit = 0
+		}
+	}
+	return taken
+}
+
+// RemoveExpiredSnapshots - remove the snapshots taken SnapshotRetentionBlocks ago, the earliest first, and at
+// most limit of their balances in one block. The snapshots used by the pending distributions are kept
+func (keeper BaseKeeper) RemoveExpiredSnapshots(ctx sdk.Context, limit int) {
+	inUse := make(map[uint64]bool)
+	keeper.iteratePendingDistributionIDs(ctx, func(id uint64) {
+		if d := keeper.GetDistribution(ctx, id); d != nil {
+			inUse[d.SnapshotID] = true
+		}
+	})
+
+	// the snapshot ids increase with the heights, so the iteration stops at the first unexpired one
+	var expired []uint64
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.SnapshotKey)
+	for ; iter.Valid(); iter.Next() {
+		var s types.TokenSnapshot
+		keeper.cdc.MustUnmarshalBinaryBare(iter.Value(), &s)
+		if !s.Taken() || s.Height+types.SnapshotRetentionBlocks > ctx.BlockHeight() {
+			break
+		}
+		if !inUse[s.ID] {
+			expired = append(expired, s.ID)
+		}
+	}
+	iter.Close()
+
+	for _, id := range expired {
+		var keys [][]byte
+		iter := sdk.KVStorePrefixIterator(store, types.GetSnapshotBalanceKeyPrefix(id))
+		for ; iter.Valid() && len(keys) <= limit; iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+		if len(keys) > limit {
+			for _, key := range keys[:limit] {
+				store.Delete(key)
+			}
+			return
+		}
+		for _, key := range keys {
+			store.Delete(key)
+		}
+		limit -= len(keys)
+		store.Delete(types.GetSnapshotStoreKey(id))
+	}
+}
+
+func (keeper BaseKeeper) nextSnapshotID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	var id uint64 = 1
+	if bz := store.Get(types.NextSnapshotIDKey); bz != nil {
+		id = binary.BigEndian.Uint64(bz)
+	}
+	keeper.SetNextSnapshotID(ctx, id+1)
+	return id
+}
+
+// SetNextSnapshotID - set the id of the next snapshot, used by genesis import
+func (keeper BaseKeeper) SetNextSnapshotID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.NextSnapshotIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// ImportGenesisTokenSnapshot - import a snapshot from genesis.json, the one which is not taken yet
+// will be taken at the next EndBlock
+func (keeper BaseKeeper) ImportGenesisTokenSnapshot(ctx sdk.Context, s types.TokenSnapshot) {
+	keeper.SetTokenSnapshot(ctx, s)
+	if !s.Taken() {
+		keeper.setPendingSnapshot(ctx, s.ID)
+	}
+}
+
+func (keeper BaseKeeper) setPendingSnapshot(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetPendingSnapshotStoreKey(id), []byte{})
+}
+
+func (keeper BaseKeeper) iteratePendingSnapshotIDs(ctx sdk.Context, process func(id uint64)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PendingSnapshotKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		process(binary.BigEndian.Uint64(iter.Key()[len(types.PendingSnapshotKey):]))
+	}
+}
+
+func (keeper BaseTokenKeeper) SetTokenSnapshot(ctx sdk.Context, s types.TokenSnapshot) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetSnapshotStoreKey(s.ID), keeper.cdc.MustMarshalBinaryBare(s))
+}
+
+// GetTokenSnapshot - return the snapshot of the id, or nil if it does not exist
+func (keeper BaseTokenKeeper) GetTokenSnapshot(ctx sdk.Context, id uint64) *types.TokenSnapshot {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GetSnapshotStoreKey(id))
+	if bz == nil {
+		return nil
+	}
+	var s types.TokenSnapshot
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &s)
+	return &s
+}
+
+// GetTokenSnapshots - return the snapshots of a token, the earliest first
+func (keeper BaseTokenKeeper) GetTokenSnapshots(ctx sdk.Context, symbol string) []types.TokenSnapshot {
+	res := make([]types.TokenSnapshot, 0)
+	keeper.iterateTokenSnapshots(ctx, func(s types.TokenSnapshot) {
+		if s.Symbol == symbol {
+			res = append(res, s)
+		}
+	})
+	return res
+}
+
+// GetAllTokenSnapshots - return the snapshots of all the tokens, which is used to export genesis.json
+func (keeper BaseTokenKeeper) GetAllTokenSnapshots(ctx sdk.Context) []types.TokenSnapshot {
+	res := make([]types.TokenSnapshot, 0)
+	keeper.iterateTokenSnapshots(ctx, func(s types.TokenSnapshot) {
+		res = append(res, s)
+	})
+	return res
+}
+
+func (keeper BaseTokenKeeper) iterateTokenSnapshots(ctx sdk.Context, process func(s types.TokenSnapshot)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.SnapshotKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var s types.TokenSnapshot
+		keeper.cdc.MustUnmarshalBinaryBare(iter.Value(), &s)
+		process(s)
+	}
+}
+
+// SetSnapshotBalance - record the balance of a holder in a snapshot, only the amount is stored
+// because the snapshot id and the address are already in the key
+func (keeper BaseTokenKeeper) SetSnapshotBalance(ctx sdk.Context, b types.SnapshotBalance) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetSnapshotBalanceStoreKey(b.SnapshotID, b.Address), keeper.cdc.MustMarshalBinaryBare(b.Amount))
+}
+
+// IterateSnapshotBalances - iterate the balances recorded in a snapshot, in the order of the addresses
+func (keeper BaseTokenKeeper) IterateSnapshotBalances(ctx sdk.Context, id uint64, process func(b types.SnapshotBalance) (stop bool)) {
+	keeper.iterateSnapshotBalances(ctx, types.GetSnapshotBalanceKeyPrefix(id), process)
+}
+
+// GetAllSnapshotBalances - return the balances of all the snapshots, which is used to export genesis.json
+func (keeper BaseTokenKeeper) GetAllSnapshotBalances(ctx sdk.Context) []types.SnapshotBalance {
+	res := make([]types.SnapshotBalance, 0)
+	keeper.iterateSnapshotBalances(ctx, types.SnapshotBalanceKey, func(b types.SnapshotBalance) bool {
+		res = append(res, b)
+		return false
+	})
+	return res
+}
+
+func (keeper BaseTokenKeeper) iterateSnapshotBalances(ctx sdk.Context, prefix []byte, process func(b types.SnapshotBalance) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	keeper.processSnapshotBalances(sdk.KVStorePrefixIterator(store, prefix), process)
+}
+
+// iterateSnapshotBalancesAfter - iterate the balances recorded in a snapshot whose addresses are after addr,
+// or all the balances if addr is empty
+func (keeper BaseTokenKeeper) iterateSnapshotBalancesAfter(ctx sdk.Context, id uint64, addr sdk.AccAddress, process func(b types.SnapshotBalance) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	prefix := types.GetSnapshotBalanceKeyPrefix(id)
+	start := prefix
+	if !addr.Empty() {
+		start = append(types.GetSnapshotBalanceStoreKey(id, addr), 0)
+	}
+	keeper.processSnapshotBalances(store.Iterator(start, sdk.PrefixEndBytes(prefix)), process)
+}
+
+func (keeper BaseTokenKeeper) processSnapshotBalances(iter sdk.Iterator, process func(b types.SnapshotBalance) (stop bool)) {
+	defer iter.Close()
+	idStart := len(types.SnapshotBalanceKey)
+	addrStart := idStart + 8
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		b := types.SnapshotBalance{
+			SnapshotID: binary.BigEndian.Uint64(key[idStart:addrStart]),
+			Address:    sdk.AccAddress(key[addrStart:]),
+		}
+		keeper.cdc.MustUnmarshalBinaryBare(iter.Value(), &b.Amount)
+		if process(b) {
+			return
+		}
+	}
+}
@@ -38,6 +38,28 @@ type testInput struct {
 	ctx sdk.Context
 	tk  keepers.BaseKeeper
 	bkx bankx.Keeper
+	dk  *mockDistrxKeeper
+
+	keyAsset  sdk.StoreKey
+	keyParams sdk.StoreKey
+}
+
+// mockDistrxKeeper takes the donated coins away and records them, or returns err if it is set
+type mockDistrxKeeper struct {
+	bkx     bankx.Keeper
+	donated sdk.Coins
+	err     sdk.Error
+}
+
+func (k *mockDistrxKeeper) DonateToCommunityPool(ctx sdk.Context, fromAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if k.err != nil {
+		return k.err
+	}
+	if err := k.bkx.SubtractCoins(ctx, fromAddr, amt); err != nil {
+		return err
+	}
+	k.donated = k.donated.Add(amt)
+	return nil
 }
 
 func createTestInput() testInput {
@@ -80,7 +102,9 @@ func createTestInput() testInput {
 	axk := authx.NewKeeper(cdc, keyAuthx, pk.Subspace(authx.DefaultParamspace), sk, ak, bk, "")
 	ask := keepers.NewBaseTokenKeeper(cdc, keyAsset)
 	bkx := bankx.NewKeeper(pk.Subspace(bankx.DefaultParamspace), axk, bk, ak, ask, sk, msgqueue.NewProducer(nil))
-	tk := keepers.NewBaseKeeper(cdc, keyAsset, pk.Subspace(types.DefaultParamspace), bkx, sk)
+	bkx.SetHooks(ask)
+	dk := &mockDistrxKeeper{bkx: bkx}
+	tk := keepers.NewBaseKeeper(cdc, keyAsset, pk.Subspace(types.DefaultParamspace), bkx, sk, dk, msgqueue.NewProducer(nil))
 
 	tk.SetParams(ctx, types.DefaultParams())
 
@@ -90,7 +114,7 @@ func createTestInput() testInput {
 	_ = notBondedPool.SetCoins(initSupply)
 	sk.SetModuleAccount(ctx, notBondedPool)
 
-	return testInput{cdc, ctx, tk, bkx}
+	return testInput{cdc, ctx, tk, bkx, dk, keyAsset, keyParams}
 }
 
 // create a codec used only for testing
@@ -105,6 +129,7 @@ func makeTestCodec() *codec.Codec {
 	cdc.RegisterInterface((*exported.SupplyI)(nil), nil)
 	cdc.RegisterConcrete(&supply.Supply{}, "test/supply/supply", nil)
 	codec.RegisterCrypto(cdc)
+	sdk.RegisterCodec(cdc)
 
 	return cdc
 }
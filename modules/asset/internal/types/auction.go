@@ -0,0 +1,96 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// a symbol auction accepts bids for 3 days after it is opened
+	SymbolAuctionDuration = 3 * 24 * 3600
+	// the winner of a symbol auction must issue the token within 7 days after the auction ends
+	SymbolReservationTimeout = 7 * 24 * 3600
+	// a bid must exceed the highest bid by at least 1 CET
+	SymbolAuctionMinIncrement = 1e8
+)
+
+// IsAuctionableSymbol returns true if the symbol is a reserved one or a short one, which has a higher issue fee
+func IsAuctionableSymbol(symbol string) bool {
+	if IsSuffixSymbol(symbol) {
+		return false
+	}
+	return IsReservedSymbol(symbol) || len(symbol) <= 6
+}
+
+// SymbolAuction is an ascending auction for an unissued symbol, only the highest bid is frozen
+// and the outbid ones are refunded. The auction ends at EndTime (unix seconds)
+type SymbolAuction struct {
+	Symbol  string         `json:"symbol" yaml:"symbol"`
+	Opener  sdk.AccAddress `json:"opener" yaml:"opener"`
+	Bidder  sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	Bid     sdk.Int        `json:"bid" yaml:"bid"`
+	EndTime int64          `json:"end_time" yaml:"end_time"`
+}
+
+func NewSymbolAuction(symbol string, opener sdk.AccAddress, bid sdk.Int, endTime int64) SymbolAuction {
+	return SymbolAuction{
+		Symbol:  symbol,
+		Opener:  opener,
+		Bidder:  opener,
+		Bid:     bid,
+		EndTime: endTime,
+	}
+}
+
+// MinNextBid returns the lowest amount which could outbid the highest bid
+func (a SymbolAuction) MinNextBid() sdk.Int {
+	return a.Bid.AddRaw(SymbolAuctionMinIncrement)
+}
+
+func (a SymbolAuction) Validate() sdk.Error {
+	if err := ValidateTokenSymbol(a.Symbol); err != nil {
+		return err
+	}
+	if !IsAuctionableSymbol(a.Symbol) {
+		return ErrSymbolNotAuctionable(a.Symbol)
+	}
+	if a.Opener.Empty() || a.Bidder.Empty() {
+		return sdk.ErrInvalidAddress("missing bidder address")
+	}
+	if a.Bid == (sdk.Int{}) || !a.Bid.IsPositive() {
+		return ErrInvalidSymbolBid(a.Bid.String())
+	}
+	return nil
+}
+
+// SymbolReservation is the exclusive right of an auction winner to issue the symbol before Deadline (unix seconds)
+type SymbolReservation struct {
+	Symbol   string         `json:"symbol" yaml:"symbol"`
+	Winner   sdk.AccAddress `json:"winner" yaml:"winner"`
+	Price    sdk.Int        `json:"price" yaml:"price"`
+	Deadline int64          `json:"deadline" yaml:"deadline"`
+}
+
+func NewSymbolReservation(symbol string, winner sdk.AccAddress, price sdk.Int, deadline int64) SymbolReservation {
+	return SymbolReservation{
+		Symbol:   symbol,
+		Winner:   winner,
+		Price:    price,
+		Deadline: deadline,
+	}
+}
+
+func (r SymbolReservation) Validate() sdk.Error {
+	if err := ValidateTokenSymbol(r.Symbol); err != nil {
+		return err
+	}
+	if !IsAuctionableSymbol(r.Symbol) {
+		return ErrSymbolNotAuctionable(r.Symbol)
+	}
+	if r.Winner.Empty() {
+		return sdk.ErrInvalidAddress("missing winner address")
+	}
+	if r.Price == (sdk.Int{}) || !r.Price.IsPositive() {
+		return ErrInvalidSymbolBid(r.Price.String())
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	MaxTokenCoOwners = 20
+	// a proposal can live for at most 30 days
+	MaxTokenActionLifetime = 30 * 24 * 3600
+)
+
+// TokenCoOwners is the set of co-owners of a token. Once it is set, the actions which need
+// the token owner must be proposed by a co-owner, and are executed after Threshold co-owners approve them
+type TokenCoOwners struct {
+	Symbol    string           `json:"symbol" yaml:"symbol"`
+	CoOwners  []sdk.AccAddress `json:"co_owners" yaml:"co_owners"`
+	Threshold uint32           `json:"threshold" yaml:"threshold"`
+}
+
+func NewTokenCoOwners(symbol string, coOwners []sdk.AccAddress, threshold uint32) TokenCoOwners {
+	return TokenCoOwners{
+		Symbol:    symbol,
+		CoOwners:  coOwners,
+		Threshold: threshold,
+	}
+}
+
+func (c TokenCoOwners) IsCoOwner(addr sdk.AccAddress) bool {
+	for _, coOwner := range c.CoOwners {
+		if coOwner.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// CountApprovals returns how many of the approvals are from the current co-owners
+func (c TokenCoOwners) CountApprovals(approvals []sdk.AccAddress) uint32 {
+	var n uint32
+	for _, addr := range approvals {
+		if c.IsCoOwner(addr) {
+			n++
+		}
+	}
+	return n
+}
+
+func (c TokenCoOwners) Validate() sdk.Error {
+	if err := ValidateTokenSymbol(c.Symbol); err != nil {
+		return err
+	}
+	return ValidateCoOwners(c.CoOwners, c.Threshold)
+}
+
+// ValidateCoOwners checks a non-empty co-owner set, empty co-owners with zero threshold are
+// only allowed in MsgSetTokenCoOwners, where they remove the co-owner set
+func ValidateCoOwners(coOwners []sdk.AccAddress, threshold uint32) sdk.Error {
+	if len(coOwners) == 0 || len(coOwners) > MaxTokenCoOwners {
+		return ErrInvalidTokenCoOwners(fmt.Sprintf("there must be 1 ~ %d co-owners", MaxTokenCoOwners))
+	}
+	if threshold == 0 || int(threshold) > len(coOwners) {
+		return ErrInvalidTokenCoOwners(fmt.Sprintf("threshold must be 1 ~ %d", len(coOwners)))
+	}
+	seen := make(map[string]bool, len(coOwners))
+	for _, addr := range coOwners {
+		if addr.Empty() {
+			return ErrInvalidTokenCoOwners("empty co-owner address")
+		}
+		if seen[string(addr)] {
+			return ErrInvalidTokenCoOwners("duplicated co-owner " + addr.String())
+		}
+		seen[string(addr)] = true
+	}
+	return nil
+}
+
+// TokenActionProposal is an action on a token proposed by a co-owner, which waits for the
+// approvals of other co-owners until ExpireTime (unix seconds)
+type TokenActionProposal struct {
+	ID         uint64           `json:"id" yaml:"id"`
+	Symbol     string           `json:"symbol" yaml:"symbol"`
+	Proposer   sdk.AccAddress   `json:"proposer" yaml:"proposer"`
+	Action     sdk.Msg          `json:"action" yaml:"action"`
+	Approvals  []sdk.AccAddress `json:"approvals" yaml:"approvals"`
+	ExpireTime int64            `json:"expire_time" yaml:"expire_time"`
+}
+
+func (p TokenActionProposal) HasApproved(addr sdk.AccAddress) bool {
+	for _, approval := range p.Approvals {
+		if approval.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetTokenAdminAction returns the token and the owner of an action which can only be done by
+// the token owner, ok is false if msg is not such an action
+func GetTokenAdminAction(msg sdk.Msg) (symbol string, owner sdk.AccAddress, ok bool) {
+	switch msg := msg.(type) {
+	case MsgTransferOwnership:
+		return msg.Symbol, msg.OriginalOwner, true
+	case MsgMintToken:
+		return msg.Symbol, msg.OwnerAddress, true
+	case MsgBurnToken:
+		return msg.Symbol, msg.OwnerAddress, true
+	case MsgForbidToken:
+		return msg.Symbol, msg.OwnerAddress, true
+	case MsgUnForbidToken:
+		return msg.Symbol, msg.OwnerAddress, true
+	case MsgAddTokenWhitelist:
+		return msg.Symbol, msg.OwnerAddress, true
+	case MsgRemoveTokenWhitelist:
+		return msg.Symbol, msg.OwnerAddress, true
+	case MsgForbidAddr:
+		return msg.Symbol, msg.OwnerAddr, true
+	case MsgUnForbidAddr:
+		return msg.Symbol, msg.OwnerAddr, true
+	case MsgModifyTokenInfo:
+		return msg.Symbol, msg.OwnerAddress, true
+	case MsgSetMintSchedule:
+		return msg.Symbol, msg.OwnerAddress, true
+	case MsgSetTokenCoOwners:
+		return msg.Symbol, msg.OwnerAddress, true
+	case MsgCancelOwnershipTransfer:
+		return msg.Symbol, msg.OwnerAddress, true
+	case MsgFreezeTokenAmount:
+		return msg.Symbol, msg.OwnerAddress, true
+	case MsgUnfreezeTokenAmount:
+		return msg.Symbol, msg.OwnerAddress, true
+	case MsgDistributeToHolders:
+		return msg.Symbol, msg.OwnerAddress, true
+	case MsgSetMintPolicy:
+		return msg.Symbol, msg.OwnerAddress, true
+	default:
+		return "", nil, false
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // ModuleCdc wide codec
@@ -9,6 +10,8 @@ var ModuleCdc *codec.Codec
 
 func init() {
 	ModuleCdc = codec.New()
+	// the actions in MsgProposeTokenAction are messages
+	ModuleCdc.RegisterInterface((*sdk.Msg)(nil), nil)
 	RegisterCodec(ModuleCdc)
 	codec.RegisterCrypto(ModuleCdc)
 	ModuleCdc.Seal()
@@ -30,4 +33,18 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgForbidAddr{}, "asset/MsgForbidAddr", nil)
 	cdc.RegisterConcrete(MsgUnForbidAddr{}, "asset/MsgUnForbidAddr", nil)
 	cdc.RegisterConcrete(MsgModifyTokenInfo{}, "asset/MsgModifyTokenInfo", nil)
+	cdc.RegisterConcrete(MsgSetMintSchedule{}, "asset/MsgSetMintSchedule", nil)
+	cdc.RegisterConcrete(MsgSetTokenCoOwners{}, "asset/MsgSetTokenCoOwners", nil)
+	cdc.RegisterConcrete(MsgProposeTokenAction{}, "asset/MsgProposeTokenAction", nil)
+	cdc.RegisterConcrete(MsgApproveTokenAction{}, "asset/MsgApproveTokenAction", nil)
+	cdc.RegisterConcrete(MsgAcceptOwnership{}, "asset/MsgAcceptOwnership", nil)
+	cdc.RegisterConcrete(MsgCancelOwnershipTransfer{}, "asset/MsgCancelOwnershipTransfer", nil)
+	cdc.RegisterConcrete(MsgHolderBurnToken{}, "asset/MsgHolderBurnToken", nil)
+	cdc.RegisterConcrete(MsgFreezeTokenAmount{}, "asset/MsgFreezeTokenAmount", nil)
+	cdc.RegisterConcrete(MsgUnfreezeTokenAmount{}, "asset/MsgUnfreezeTokenAmount", nil)
+	cdc.RegisterConcrete(MsgRequestTokenSnapshot{}, "asset/MsgRequestTokenSnapshot", nil)
+	cdc.RegisterConcrete(MsgOpenSymbolAuction{}, "asset/MsgOpenSymbolAuction", nil)
+	cdc.RegisterConcrete(MsgBidSymbolAuction{}, "asset/MsgBidSymbolAuction", nil)
+	cdc.RegisterConcrete(MsgDistributeToHolders{}, "asset/MsgDistributeToHolders", nil)
+	cdc.RegisterConcrete(MsgSetMintPolicy{}, "asset/MsgSetMintPolicy", nil)
 }
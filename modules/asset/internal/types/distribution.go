@@ -0,0 +1,114 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// the snapshot balances processed by all the distributions in one block
+	DistributionEntriesPerBlock = 1000
+	// the max number of addresses excluded from a distribution
+	MaxDistributionExcluded = 100
+)
+
+// Distribution pays Amount of Denom to the holders of Symbol pro-rata to their balances recorded in the snapshot.
+// The amount is frozen on the distributor until it is paid. The balances are processed in bounded batches
+// across blocks: the eligible balances are summed up first, then the shares are paid. SettleHeight is zero
+// until all the holders are paid and the rounding dust is returned to the distributor
+type Distribution struct {
+	ID          uint64           `json:"id" yaml:"id"`
+	Symbol      string           `json:"symbol" yaml:"symbol"`
+	Distributor sdk.AccAddress   `json:"distributor" yaml:"distributor"`
+	Denom       string           `json:"denom" yaml:"denom"`
+	Amount      sdk.Int          `json:"amount" yaml:"amount"`
+	Excluded    []sdk.AccAddress `json:"excluded" yaml:"excluded"`
+	SnapshotID  uint64           `json:"snapshot_id" yaml:"snapshot_id"`
+
+	// Counted is true after all the eligible balances are summed up to Eligible
+	Counted  bool    `json:"counted" yaml:"counted"`
+	Eligible sdk.Int `json:"eligible" yaml:"eligible"`
+	// Cursor is the last address processed in the current stage
+	Cursor       sdk.AccAddress `json:"cursor" yaml:"cursor"`
+	Distributed  sdk.Int        `json:"distributed" yaml:"distributed"`
+	Recipients   uint64         `json:"recipients" yaml:"recipients"`
+	SettleHeight int64          `json:"settle_height" yaml:"settle_height"`
+}
+
+func NewDistribution(id uint64, symbol string, distributor sdk.AccAddress, denom string, amount sdk.Int,
+	excluded []sdk.AccAddress, snapshotID uint64) Distribution {
+	return Distribution{
+		ID:          id,
+		Symbol:      symbol,
+		Distributor: distributor,
+		Denom:       denom,
+		Amount:      amount,
+		Excluded:    excluded,
+		SnapshotID:  snapshotID,
+		Eligible:    sdk.ZeroInt(),
+		Distributed: sdk.ZeroInt(),
+	}
+}
+
+func (d Distribution) Settled() bool {
+	return d.SettleHeight != 0
+}
+
+// Dust returns the amount which is not distributed
+func (d Distribution) Dust() sdk.Int {
+	return d.Amount.Sub(d.Distributed)
+}
+
+// IsExcluded returns true if addr does not take part in the distribution, the distributor never does,
+// or it would receive a share of its own frozen payout when Denom is Symbol
+func (d Distribution) IsExcluded(addr sdk.AccAddress) bool {
+	if d.Distributor.Equals(addr) {
+		return true
+	}
+	for _, e := range d.Excluded {
+		if e.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// ShareOf returns the amount paid to a holder, which is rounded down
+func (d Distribution) ShareOf(balance sdk.Int) sdk.Int {
+	if !d.Eligible.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	return d.Amount.Mul(balance).Quo(d.Eligible)
+}
+
+func (d Distribution) Validate() error {
+	if d.ID == 0 {
+		return errors.New("distribution id must be positive")
+	}
+	if err := ValidateTokenSymbol(d.Symbol); err != nil {
+		return err
+	}
+	if err := ValidateTokenSymbol(d.Denom); err != nil {
+		return err
+	}
+	if d.Distributor.Empty() {
+		return errors.New("distributor is empty")
+	}
+	if d.Amount == (sdk.Int{}) || !d.Amount.IsPositive() {
+		return errors.New("distribution amount must be positive")
+	}
+	if len(d.Excluded) > MaxDistributionExcluded {
+		return ErrTooManyExcludedAddresses(len(d.Excluded))
+	}
+	if d.SnapshotID == 0 {
+		return errors.New("distribution snapshot id must be positive")
+	}
+	if d.Eligible == (sdk.Int{}) || d.Eligible.IsNegative() {
+		return errors.New("distribution eligible balance must not be negative")
+	}
+	if d.Distributed == (sdk.Int{}) || d.Distributed.IsNegative() || d.Distributed.GT(d.Amount) {
+		return errors.New("distributed amount is out of range")
+	}
+	return nil
+}
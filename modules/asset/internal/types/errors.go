@@ -41,6 +41,41 @@ const (
 	CodeTokenOwnerSelfForbidden      sdk.CodeType = 530
 	CodeInvalidTokenInfo             sdk.CodeType = 531
 	CodeTokenInfoSealed              sdk.CodeType = 532
+	CodeInvalidMintSchedule          sdk.CodeType = 533
+	CodeMintScheduleExists           sdk.CodeType = 534
+	CodeTokenMintScheduled           sdk.CodeType = 535
+	CodeInvalidTokenCoOwners         sdk.CodeType = 536
+	CodeTokenActionNeedsApproval     sdk.CodeType = 537
+	CodeNotTokenCoOwner              sdk.CodeType = 538
+	CodeTokenProposalNotFound        sdk.CodeType = 539
+	CodeInvalidTokenAction           sdk.CodeType = 540
+	CodeDuplicateApproval            sdk.CodeType = 541
+	CodeNoPendingOwnershipTransfer   sdk.CodeType = 542
+	CodeNotPendingTokenOwner         sdk.CodeType = 543
+	CodeTokenHolderBurnNotSupported  sdk.CodeType = 544
+	CodeInvalidRedemptionMemo        sdk.CodeType = 545
+	CodeHolderBurnForbidden          sdk.CodeType = 546
+	CodeInvalidFreezeAmount          sdk.CodeType = 547
+	CodeInsufficientFrozenAmount     sdk.CodeType = 548
+	CodeSnapshotNotFound             sdk.CodeType = 549
+	CodeSymbolNotAuctionable         sdk.CodeType = 550
+	CodeSymbolAuctionNotFound        sdk.CodeType = 551
+	CodeInvalidSymbolBid             sdk.CodeType = 552
+	CodeSymbolBidTooLow              sdk.CodeType = 553
+	CodeSymbolInAuction              sdk.CodeType = 554
+	CodeSymbolReserved               sdk.CodeType = 555
+	CodeSymbolReservationNotFound    sdk.CodeType = 556
+	CodeInvalidDistribution          sdk.CodeType = 557
+	CodeDistributionNotFound         sdk.CodeType = 558
+	CodeTooManyExcludedAddresses     sdk.CodeType = 559
+	CodeInvalidTokenMetadata         sdk.CodeType = 560
+	CodeInvalidMintPolicy            sdk.CodeType = 561
+	CodeMintPolicyExists             sdk.CodeType = 562
+	CodeMintCapExceeded              sdk.CodeType = 563
+	CodeMintRateLimited              sdk.CodeType = 564
+	CodeTokenHolderNotFound          sdk.CodeType = 565
+	CodeScheduledMintForbidden       sdk.CodeType = 566
+	CodeHoldersNotIndexed            sdk.CodeType = 567
 )
 
 func ErrInvalidTokenName(name string) sdk.Error {
@@ -182,3 +217,146 @@ func ErrCodeTokenInfoSealed(field string) sdk.Error {
 	msg := fmt.Sprintf("token %s sealed", field)
 	return sdk.NewError(CodeSpaceAsset, CodeTokenInfoSealed, msg)
 }
+
+func ErrInvalidMintSchedule(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid mint schedule : %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidMintSchedule, msg)
+}
+func ErrMintScheduleExists(symbol string) sdk.Error {
+	msg := fmt.Sprintf("token %s already has a mint schedule, which can not be changed", symbol)
+	return sdk.NewError(CodeSpaceAsset, CodeMintScheduleExists, msg)
+}
+func ErrTokenMintScheduled(symbol string) sdk.Error {
+	msg := fmt.Sprintf("token %s can only be minted by its mint schedule", symbol)
+	return sdk.NewError(CodeSpaceAsset, CodeTokenMintScheduled, msg)
+}
+
+func ErrInvalidTokenCoOwners(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid co-owners : %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidTokenCoOwners, msg)
+}
+func ErrTokenActionNeedsApproval(symbol string) sdk.Error {
+	msg := fmt.Sprintf("token %s has co-owners, this action must be proposed and approved by them", symbol)
+	return sdk.NewError(CodeSpaceAsset, CodeTokenActionNeedsApproval, msg)
+}
+func ErrNotTokenCoOwner(addr sdk.AccAddress) sdk.Error {
+	msg := fmt.Sprintf("%s is not a co-owner of the token", addr.String())
+	return sdk.NewError(CodeSpaceAsset, CodeNotTokenCoOwner, msg)
+}
+func ErrTokenProposalNotFound(symbol string, id uint64) sdk.Error {
+	msg := fmt.Sprintf("proposal %d of token %s is not found", id, symbol)
+	return sdk.NewError(CodeSpaceAsset, CodeTokenProposalNotFound, msg)
+}
+func ErrInvalidTokenAction(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid token action : %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidTokenAction, msg)
+}
+func ErrDuplicateApproval(addr sdk.AccAddress) sdk.Error {
+	msg := fmt.Sprintf("%s has approved this proposal", addr.String())
+	return sdk.NewError(CodeSpaceAsset, CodeDuplicateApproval, msg)
+}
+func ErrNoPendingOwnershipTransfer(symbol string) sdk.Error {
+	msg := fmt.Sprintf("token %s has no pending ownership transfer", symbol)
+	return sdk.NewError(CodeSpaceAsset, CodeNoPendingOwnershipTransfer, msg)
+}
+func ErrNotPendingTokenOwner(pendingOwner sdk.AccAddress) sdk.Error {
+	msg := fmt.Sprintf("only the pending owner %s can accept the ownership", pendingOwner.String())
+	return sdk.NewError(CodeSpaceAsset, CodeNotPendingTokenOwner, msg)
+}
+func ErrTokenHolderBurnNotSupported(symbol string) sdk.Error {
+	msg := fmt.Sprintf("token %s do not support holder burn", symbol)
+	return sdk.NewError(CodeSpaceAsset, CodeTokenHolderBurnNotSupported, msg)
+}
+func ErrInvalidRedemptionMemo(memo string) sdk.Error {
+	msg := fmt.Sprintf("invalid redemption memo %s : redemption memo is limited to %d bytes", memo, MaxRedemptionMemoLength)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidRedemptionMemo, msg)
+}
+func ErrHolderBurnForbidden(symbol string, holder sdk.AccAddress) sdk.Error {
+	msg := fmt.Sprintf("%s is forbidden by the issuer to burn token %s", holder.String(), symbol)
+	return sdk.NewError(CodeSpaceAsset, CodeHolderBurnForbidden, msg)
+}
+func ErrInvalidFreezeAmount(amt string) sdk.Error {
+	msg := fmt.Sprintf("invalid freeze amount %s : the amount must be positive", amt)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidFreezeAmount, msg)
+}
+func ErrInsufficientFrozenAmount(frozen sdk.Int) sdk.Error {
+	msg := fmt.Sprintf("only %s is frozen on the address", frozen.String())
+	return sdk.NewError(CodeSpaceAsset, CodeInsufficientFrozenAmount, msg)
+}
+func ErrSnapshotNotFound(id uint64) sdk.Error {
+	msg := fmt.Sprintf("token snapshot %d is not found", id)
+	return sdk.NewError(CodeSpaceAsset, CodeSnapshotNotFound, msg)
+}
+func ErrSymbolNotAuctionable(symbol string) sdk.Error {
+	msg := fmt.Sprintf("symbol %s can not be auctioned : only the unissued reserved or short symbols can be auctioned", symbol)
+	return sdk.NewError(CodeSpaceAsset, CodeSymbolNotAuctionable, msg)
+}
+func ErrSymbolAuctionNotFound(symbol string) sdk.Error {
+	msg := fmt.Sprintf("symbol %s is not in auction", symbol)
+	return sdk.NewError(CodeSpaceAsset, CodeSymbolAuctionNotFound, msg)
+}
+func ErrInvalidSymbolBid(amt string) sdk.Error {
+	msg := fmt.Sprintf("invalid bid %s : the bid must be positive", amt)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidSymbolBid, msg)
+}
+func ErrSymbolBidTooLow(min sdk.Int) sdk.Error {
+	msg := fmt.Sprintf("the bid must be at least %s", min.String())
+	return sdk.NewError(CodeSpaceAsset, CodeSymbolBidTooLow, msg)
+}
+func ErrSymbolInAuction(symbol string) sdk.Error {
+	msg := fmt.Sprintf("symbol %s is in auction", symbol)
+	return sdk.NewError(CodeSpaceAsset, CodeSymbolInAuction, msg)
+}
+func ErrSymbolReserved(symbol string, winner sdk.AccAddress) sdk.Error {
+	msg := fmt.Sprintf("symbol %s is reserved for the auction winner %s", symbol, winner.String())
+	return sdk.NewError(CodeSpaceAsset, CodeSymbolReserved, msg)
+}
+func ErrSymbolReservationNotFound(symbol string) sdk.Error {
+	msg := fmt.Sprintf("symbol %s is not reserved", symbol)
+	return sdk.NewError(CodeSpaceAsset, CodeSymbolReservationNotFound, msg)
+}
+func ErrInvalidDistributionAmount(amt string) sdk.Error {
+	msg := fmt.Sprintf("invalid distribution amount %s : the amount must be positive", amt)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidDistribution, msg)
+}
+func ErrDistributionNotFound(id uint64) sdk.Error {
+	msg := fmt.Sprintf("token distribution %d is not found", id)
+	return sdk.NewError(CodeSpaceAsset, CodeDistributionNotFound, msg)
+}
+func ErrTooManyExcludedAddresses(n int) sdk.Error {
+	msg := fmt.Sprintf("%d addresses are excluded, at most %d are allowed", n, MaxDistributionExcluded)
+	return sdk.NewError(CodeSpaceAsset, CodeTooManyExcludedAddresses, msg)
+}
+func ErrInvalidTokenMetadata(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid token metadata : %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidTokenMetadata, msg)
+}
+func ErrInvalidMintPolicy(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid mint policy : %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidMintPolicy, msg)
+}
+func ErrMintPolicyExists(symbol string) sdk.Error {
+	msg := fmt.Sprintf("token %s already has a mint policy, which can not be changed", symbol)
+	return sdk.NewError(CodeSpaceAsset, CodeMintPolicyExists, msg)
+}
+func ErrMintCapExceeded(symbol string, supplyCap sdk.Int) sdk.Error {
+	msg := fmt.Sprintf("the total supply of token %s can not exceed its cap %s", symbol, supplyCap.String())
+	return sdk.NewError(CodeSpaceAsset, CodeMintCapExceeded, msg)
+}
+func ErrMintRateLimited(symbol string, available sdk.Int) sdk.Error {
+	msg := fmt.Sprintf("token %s can only mint %s more in the current window", symbol, available.String())
+	return sdk.NewError(CodeSpaceAsset, CodeMintRateLimited, msg)
+}
+
+func ErrTokenHolderNotFound(symbol string, addr sdk.AccAddress) sdk.Error {
+	msg := fmt.Sprintf("%s does not hold token %s", addr.String(), symbol)
+	return sdk.NewError(CodeSpaceAsset, CodeTokenHolderNotFound, msg)
+}
+func ErrScheduledMintForbidden(symbol string, reason string) sdk.Error {
+	msg := fmt.Sprintf("scheduled mint of token %s is forbidden: %s", symbol, reason)
+	return sdk.NewError(CodeSpaceAsset, CodeScheduledMintForbidden, msg)
+}
+func ErrHoldersNotIndexed(symbol string) sdk.Error {
+	msg := fmt.Sprintf("the holders of token %s are not indexed", symbol)
+	return sdk.NewError(CodeSpaceAsset, CodeHoldersNotIndexed, msg)
+}
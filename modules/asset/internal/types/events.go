@@ -14,6 +14,34 @@ const (
 	EventTypeForbidAddr           = "forbid_addr"
 	EventTypeUnForbidAddr         = "unforbid_addr"
 	EventTypeModifyTokenInfo      = "modify_token_info"
+	EventTypeSetMintSchedule      = "set_mint_schedule"
+	EventTypeScheduledMint        = "scheduled_mint"
+	EventTypeStopMintSchedule     = "stop_mint_schedule"
+	EventTypeSetTokenCoOwners     = "set_token_co_owners"
+	EventTypeProposeTokenAction   = "propose_token_action"
+	EventTypeApproveTokenAction   = "approve_token_action"
+	EventTypeExecuteTokenAction   = "execute_token_action"
+	EventTypeFailTokenAction      = "fail_token_action"
+	EventTypeExpireTokenAction    = "expire_token_action"
+	EventTypeNominateOwner        = "nominate_token_owner"
+	EventTypeCancelOwnerTransfer  = "cancel_ownership_transfer"
+	EventTypeExpireOwnerTransfer  = "expire_ownership_transfer"
+	EventTypeRedeemToken          = "redeem_token"
+	EventTypeFreezeTokenAmount    = "freeze_token_amount"
+	EventTypeUnfreezeTokenAmount  = "unfreeze_token_amount"
+	EventTypeRequestSnapshot      = "request_token_snapshot"
+	EventTypeTakeSnapshot         = "take_token_snapshot"
+
+	EventTypeOpenSymbolAuction   = "open_symbol_auction"
+	EventTypeBidSymbolAuction    = "bid_symbol_auction"
+	EventTypeSettleSymbolAuction = "settle_symbol_auction"
+	EventTypeExpireReservation   = "expire_symbol_reservation"
+
+	EventTypeDistributeToHolders = "distribute_to_holders"
+	EventTypeSettleDistribution  = "settle_token_distribution"
+
+	EventTypeSetMintPolicy    = "set_mint_policy"
+	EventTypeMintLimitReached = "mint_limit_reached"
 
 	AttributeKeySymbol        = "symbol"
 	AttributeKeyTokenOwner    = "owner"
@@ -23,4 +51,49 @@ const (
 	AttributeKeyURL           = "url"
 	AttributeKeyDescription   = "description"
 	AttributeKeyIdentity      = "identity"
+	AttributeKeyRecipient     = "recipient"
+	AttributeKeyScheduled     = "scheduled_supply"
+	AttributeKeyThreshold     = "threshold"
+	AttributeKeyProposalID    = "proposal_id"
+	AttributeKeyAction        = "action"
+	AttributeKeyProposer      = "proposer"
+	AttributeKeyApprover      = "approver"
+	AttributeKeyApprovals     = "approvals"
+	AttributeKeyPendingOwner  = "pending_owner"
+	AttributeKeyDeadline      = "deadline"
+	AttributeKeyHolder        = "holder"
+	AttributeKeyMemo          = "memo"
+	AttributeKeyAddress       = "address"
+	AttributeKeyFrozenAmount  = "frozen_amount"
+	AttributeKeySnapshotID    = "snapshot_id"
+	AttributeKeyHolders       = "holders"
+	AttributeKeyReason        = "reason"
+
+	AttributeKeyBidder  = "bidder"
+	AttributeKeyBid     = "bid"
+	AttributeKeyWinner  = "winner"
+	AttributeKeyEndTime = "end_time"
+
+	AttributeKeyDistributionID = "distribution_id"
+	AttributeKeyDenom          = "denom"
+	AttributeKeyDistributed    = "distributed"
+	AttributeKeyRecipients     = "recipients"
+	AttributeKeyDust           = "dust"
+
+	AttributeKeySupplyCap     = "supply_cap"
+	AttributeKeyWindowAmount  = "window_amount"
+	AttributeKeyWindowSeconds = "window_seconds"
+	AttributeKeyLimit         = "limit"
+
+	KafkaNominateOwner       = "nominate_token_owner"
+	KafkaAcceptOwnership     = "accept_token_ownership"
+	KafkaCancelOwnerTransfer = "cancel_ownership_transfer"
+	KafkaExpireOwnerTransfer = "expire_ownership_transfer"
+	KafkaRedeemToken         = "redeem_token"
+	KafkaTakeSnapshot        = "take_token_snapshot"
+
+	KafkaSettleSymbolAuction = "settle_symbol_auction"
+	KafkaExpireReservation   = "expire_symbol_reservation"
+
+	KafkaSettleDistribution = "settle_token_distribution"
 )
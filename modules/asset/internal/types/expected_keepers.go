@@ -11,8 +11,18 @@ type ExpectedBankxKeeper interface {
 
 	AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error
 	SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	SendCoins(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) sdk.Error
 	GetTotalCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	BlacklistedAddr(addr sdk.AccAddress) bool
+	IterateTotalCoins(ctx sdk.Context, process func(addr sdk.AccAddress, coins sdk.Coins) (stop bool))
+	FreezeCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	UnFreezeCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error
+}
+
+// Distributionx Keeper will implement the interface
+type ExpectedDistributionxKeeper interface {
+	DonateToCommunityPool(ctx sdk.Context, fromAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
 }
 
 // Supply Keeper will implement the interface
@@ -2,23 +2,52 @@ package types
 
 // GenesisState - all asset state that must be provided at genesis
 type GenesisState struct {
-	Params             Params   `json:"params" yaml:"params"`
-	Tokens             []Token  `json:"tokens" yaml:"tokens"`
-	Whitelist          []string `json:"whitelist" yaml:"whitelist"`
-	ForbiddenAddresses []string `json:"forbidden_addresses" yaml:"forbidden_addresses"`
+	Params             Params                     `json:"params" yaml:"params"`
+	Tokens             []Token                    `json:"tokens" yaml:"tokens"`
+	Whitelist          []string                   `json:"whitelist" yaml:"whitelist"`
+	ForbiddenAddresses []string                   `json:"forbidden_addresses" yaml:"forbidden_addresses"`
+	MintSchedules      []MintScheduleInfo         `json:"mint_schedules" yaml:"mint_schedules"`
+	CoOwners           []TokenCoOwners            `json:"co_owners" yaml:"co_owners"`
+	Proposals          []TokenActionProposal      `json:"proposals" yaml:"proposals"`
+	PendingTransfers   []PendingOwnershipTransfer `json:"pending_transfers" yaml:"pending_transfers"`
+	FrozenAmounts      []IssuerFrozenAmount       `json:"frozen_amounts" yaml:"frozen_amounts"`
+	Snapshots          []TokenSnapshot            `json:"snapshots" yaml:"snapshots"`
+	SnapshotBalances   []SnapshotBalance          `json:"snapshot_balances" yaml:"snapshot_balances"`
+	SymbolAuctions     []SymbolAuction            `json:"symbol_auctions" yaml:"symbol_auctions"`
+	SymbolReservations []SymbolReservation        `json:"symbol_reservations" yaml:"symbol_reservations"`
+	Distributions      []Distribution             `json:"distributions" yaml:"distributions"`
+	MintRecords        []MintRecord               `json:"mint_records" yaml:"mint_records"`
 }
 
 // NewGenesisState - Create a new genesis state
-func NewGenesisState(params Params, tokens []Token, whitelist []string, forbiddenAddresses []string) GenesisState {
+func NewGenesisState(params Params, tokens []Token, whitelist []string, forbiddenAddresses []string,
+	mintSchedules []MintScheduleInfo, coOwners []TokenCoOwners, proposals []TokenActionProposal,
+	pendingTransfers []PendingOwnershipTransfer, frozenAmounts []IssuerFrozenAmount, snapshots []TokenSnapshot,
+	snapshotBalances []SnapshotBalance, symbolAuctions []SymbolAuction, symbolReservations []SymbolReservation,
+	distributions []Distribution, mintRecords []MintRecord) GenesisState {
 	return GenesisState{
 		Params:             params,
 		Tokens:             tokens,
 		Whitelist:          whitelist,
 		ForbiddenAddresses: forbiddenAddresses,
+		MintSchedules:      mintSchedules,
+		CoOwners:           coOwners,
+		Proposals:          proposals,
+		PendingTransfers:   pendingTransfers,
+		FrozenAmounts:      frozenAmounts,
+		Snapshots:          snapshots,
+		SnapshotBalances:   snapshotBalances,
+		SymbolAuctions:     symbolAuctions,
+		SymbolReservations: symbolReservations,
+		Distributions:      distributions,
+		MintRecords:        mintRecords,
 	}
 }
 
 // DefaultGenesisState - Return a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(DefaultParams(), []Token{}, []string{}, []string{})
+	return NewGenesisState(DefaultParams(), []Token{}, []string{}, []string{}, []MintScheduleInfo{},
+		[]TokenCoOwners{}, []TokenActionProposal{}, []PendingOwnershipTransfer{}, []IssuerFrozenAmount{},
+		[]TokenSnapshot{}, []SnapshotBalance{}, []SymbolAuction{}, []SymbolReservation{},
+		[]Distribution{}, []MintRecord{})
 }
@@ -0,0 +1,31 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+const DefaultHolderLimit = 100
+
+// IsHolderIndexed returns false for CET, which is moved by the fee deduction, the incentives, staking and
+// the rewards without going through bankx, so its holders are not indexed, and it can not be snapshotted
+func IsHolderIndexed(symbol string) bool {
+	return symbol != dex.CET
+}
+
+// TokenHolder is an entry of the holder index of a token. Amount includes the locked and frozen coins,
+// and Rank starts from 1 for the largest holder
+type TokenHolder struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Amount  sdk.Int        `json:"amount" yaml:"amount"`
+	Rank    uint64         `json:"rank" yaml:"rank"`
+}
+
+func NewTokenHolder(addr sdk.AccAddress, amount sdk.Int, rank uint64) TokenHolder {
+	return TokenHolder{
+		Address: addr,
+		Amount:  amount,
+		Rank:    rank,
+	}
+}
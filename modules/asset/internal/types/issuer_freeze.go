@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IssuerFrozenAmount is the amount of a token frozen on an address by the token owner,
+// the address can not move this part of its balance until the owner unfreezes it
+type IssuerFrozenAmount struct {
+	Symbol  string         `json:"symbol" yaml:"symbol"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Amount  sdk.Int        `json:"amount" yaml:"amount"`
+}
+
+func NewIssuerFrozenAmount(symbol string, addr sdk.AccAddress, amount sdk.Int) IssuerFrozenAmount {
+	return IssuerFrozenAmount{
+		Symbol:  symbol,
+		Address: addr,
+		Amount:  amount,
+	}
+}
+
+func (f IssuerFrozenAmount) Validate() sdk.Error {
+	if err := ValidateTokenSymbol(f.Symbol); err != nil {
+		return err
+	}
+	if f.Address.Empty() {
+		return ErrNilForbiddenAddress()
+	}
+	if f.Amount == (sdk.Int{}) || !f.Amount.IsPositive() {
+		return ErrInvalidFreezeAmount(f.Amount.String())
+	}
+	return nil
+}
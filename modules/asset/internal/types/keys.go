@@ -1,6 +1,8 @@
 package types
 
 import (
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -18,6 +20,9 @@ const (
 	QuerierRoute = ModuleName
 
 	DefaultParamspace = ModuleName
+
+	// Topic is the kafka topic of asset messages
+	Topic = ModuleName
 )
 
 var (
@@ -25,8 +30,45 @@ var (
 	TokenKey         = []byte{0x01}
 	WhitelistKey     = []byte{0x02}
 	ForbiddenAddrKey = []byte{0x03}
+	MintScheduleKey  = []byte{0x04}
+	CoOwnersKey      = []byte{0x05}
+	TokenProposalKey = []byte{0x06}
+	NextProposalKey  = []byte{0x07}
+	PendingOwnerKey  = []byte{0x08}
+	IssuerFrozenKey  = []byte{0x09}
+
+	SnapshotKey        = []byte{0x0A}
+	SnapshotBalanceKey = []byte{0x0B}
+	PendingSnapshotKey = []byte{0x0C}
+	NextSnapshotIDKey  = []byte{0x0D}
+
+	SymbolAuctionKey     = []byte{0x0E}
+	SymbolReservationKey = []byte{0x0F}
+
+	DistributionKey        = []byte{0x10}
+	PendingDistributionKey = []byte{0x11}
+	NextDistributionIDKey  = []byte{0x12}
+
+	MintRecordKey = []byte{0x13}
+
+	HolderKey      = []byte{0x14}
+	HolderRankKey  = []byte{0x15}
+	HolderCountKey = []byte{0x16}
+
+	MintScheduleDueKey = []byte{0x17}
+
+	TokenProposalExpireKey  = []byte{0x18}
+	PendingOwnerDeadlineKey = []byte{0x19}
+
+	SymbolAuctionEndKey          = []byte{0x1A}
+	SymbolReservationDeadlineKey = []byte{0x1B}
+
+	HolderIndexBuiltKey = []byte{0x1C}
 )
 
+// the amounts in the holder rank keys are padded to the same length, so that they are ordered by value
+const holderAmountLength = 32
+
 // GetTokenStoreKey - TokenKey | symbol
 func GetTokenStoreKey(symbol string) []byte {
 	return append(TokenKey, symbol...)
@@ -61,3 +103,143 @@ func GetForbiddenAddrKeyPrefix(symbol string) []byte {
 func GetForbiddenAddrKeyPrefixLength(symbol string) int {
 	return len(GetForbiddenAddrKeyPrefix(symbol))
 }
+
+// GetMintScheduleStoreKey - MintScheduleKey | symbol
+func GetMintScheduleStoreKey(symbol string) []byte {
+	return append(MintScheduleKey, symbol...)
+}
+
+// GetMintScheduleDueStoreKey - MintScheduleDueKey | time | symbol
+func GetMintScheduleDueStoreKey(time int64, symbol string) []byte {
+	return append(append(MintScheduleDueKey, sdk.Uint64ToBigEndian(uint64(time))...), symbol...)
+}
+
+// GetCoOwnersStoreKey - CoOwnersKey | symbol
+func GetCoOwnersStoreKey(symbol string) []byte {
+	return append(CoOwnersKey, symbol...)
+}
+
+// GetTokenProposalStoreKey - TokenProposalKey | symbol | : | id
+func GetTokenProposalStoreKey(symbol string, id uint64) []byte {
+	return append(GetTokenProposalKeyPrefix(symbol), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenProposalKeyPrefix - TokenProposalKey | symbol | :
+func GetTokenProposalKeyPrefix(symbol string) []byte {
+	return append(append(TokenProposalKey, symbol...), SeparateKey...)
+}
+
+// GetTokenProposalExpireStoreKey - TokenProposalExpireKey | time | id | symbol
+func GetTokenProposalExpireStoreKey(time int64, id uint64, symbol string) []byte {
+	key := append(append(TokenProposalExpireKey, sdk.Uint64ToBigEndian(uint64(time))...), sdk.Uint64ToBigEndian(id)...)
+	return append(key, symbol...)
+}
+
+// GetPendingOwnerStoreKey - PendingOwnerKey | symbol
+func GetPendingOwnerStoreKey(symbol string) []byte {
+	return append(PendingOwnerKey, symbol...)
+}
+
+// GetPendingOwnerDeadlineStoreKey - PendingOwnerDeadlineKey | deadline | symbol
+func GetPendingOwnerDeadlineStoreKey(deadline int64, symbol string) []byte {
+	return append(append(PendingOwnerDeadlineKey, sdk.Uint64ToBigEndian(uint64(deadline))...), symbol...)
+}
+
+// GetIssuerFrozenStoreKey - IssuerFrozenKey | symbol | : | AccAddress
+func GetIssuerFrozenStoreKey(symbol string, addr sdk.AccAddress) []byte {
+	return append(GetIssuerFrozenKeyPrefix(symbol), addr...)
+}
+
+// GetIssuerFrozenKeyPrefix - IssuerFrozenKey | symbol | :
+func GetIssuerFrozenKeyPrefix(symbol string) []byte {
+	return append(append(IssuerFrozenKey, symbol...), SeparateKey...)
+}
+
+// GetSnapshotStoreKey - SnapshotKey | id
+func GetSnapshotStoreKey(id uint64) []byte {
+	return append(SnapshotKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetSnapshotBalanceStoreKey - SnapshotBalanceKey | id | AccAddress
+func GetSnapshotBalanceStoreKey(id uint64, addr sdk.AccAddress) []byte {
+	return append(GetSnapshotBalanceKeyPrefix(id), addr...)
+}
+
+// GetSnapshotBalanceKeyPrefix - SnapshotBalanceKey | id
+func GetSnapshotBalanceKeyPrefix(id uint64) []byte {
+	return append(SnapshotBalanceKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetPendingSnapshotStoreKey - PendingSnapshotKey | id
+func GetPendingSnapshotStoreKey(id uint64) []byte {
+	return append(PendingSnapshotKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetSymbolAuctionStoreKey - SymbolAuctionKey | symbol
+func GetSymbolAuctionStoreKey(symbol string) []byte {
+	return append(SymbolAuctionKey, symbol...)
+}
+
+// GetSymbolReservationStoreKey - SymbolReservationKey | symbol
+func GetSymbolReservationStoreKey(symbol string) []byte {
+	return append(SymbolReservationKey, symbol...)
+}
+
+// GetSymbolAuctionEndStoreKey - SymbolAuctionEndKey | end time | symbol
+func GetSymbolAuctionEndStoreKey(endTime int64, symbol string) []byte {
+	return append(append(SymbolAuctionEndKey, sdk.Uint64ToBigEndian(uint64(endTime))...), symbol...)
+}
+
+// GetSymbolReservationDeadlineStoreKey - SymbolReservationDeadlineKey | deadline | symbol
+func GetSymbolReservationDeadlineStoreKey(deadline int64, symbol string) []byte {
+	return append(append(SymbolReservationDeadlineKey, sdk.Uint64ToBigEndian(uint64(deadline))...), symbol...)
+}
+
+// GetDistributionStoreKey - DistributionKey | id
+func GetDistributionStoreKey(id uint64) []byte {
+	return append(DistributionKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetPendingDistributionStoreKey - PendingDistributionKey | id
+func GetPendingDistributionStoreKey(id uint64) []byte {
+	return append(PendingDistributionKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetMintRecordStoreKey - MintRecordKey | symbol | : | time
+func GetMintRecordStoreKey(symbol string, time int64) []byte {
+	return append(GetMintRecordKeyPrefix(symbol), sdk.Uint64ToBigEndian(uint64(time))...)
+}
+
+// GetMintRecordKeyPrefix - MintRecordKey | symbol | :
+func GetMintRecordKeyPrefix(symbol string) []byte {
+	return append(append(MintRecordKey, symbol...), SeparateKey...)
+}
+
+// GetHolderStoreKey - HolderKey | symbol | : | AccAddress
+func GetHolderStoreKey(symbol string, addr sdk.AccAddress) []byte {
+	return append(append(append(HolderKey, symbol...), SeparateKey...), addr...)
+}
+
+// GetHolderRankStoreKey - HolderRankKey | symbol | : | amount | AccAddress
+func GetHolderRankStoreKey(symbol string, amount sdk.Int, addr sdk.AccAddress) []byte {
+	bz := amount.BigInt().Bytes()
+	padded := make([]byte, holderAmountLength-len(bz), holderAmountLength)
+	return append(append(GetHolderRankKeyPrefix(symbol), append(padded, bz...)...), addr...)
+}
+
+// GetHolderRankKeyPrefix - HolderRankKey | symbol | :
+func GetHolderRankKeyPrefix(symbol string) []byte {
+	return append(append(HolderRankKey, symbol...), SeparateKey...)
+}
+
+// GetHolderCountStoreKey - HolderCountKey | symbol
+func GetHolderCountStoreKey(symbol string) []byte {
+	return append(HolderCountKey, symbol...)
+}
+
+// SplitHolderRankStoreKey - return the amount and the address in a holder rank key
+func SplitHolderRankStoreKey(key []byte, symbol string) (sdk.Int, sdk.AccAddress) {
+	key = key[len(GetHolderRankKeyPrefix(symbol)):]
+	amount := sdk.NewIntFromBigInt(new(big.Int).SetBytes(key[:holderAmountLength]))
+	return amount, sdk.AccAddress(key[holderAmountLength:])
+}
@@ -0,0 +1,157 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	MaxTokenDecimals         = 18
+	MaxTokenDenomUnits       = 10
+	MaxTokenDenomAliases     = 5
+	MaxTokenSocialLinks      = 10
+	MaxTokenSocialNameLength = 32
+	// the logo hash is the hex encoded sha256 digest of the logo file
+	TokenLogoHashLength = 64
+)
+
+// Display denominations are case sensitive, such as "ABC" or "mABC"
+var tokenDenomUnitRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9.]{0,15}$`)
+
+// DenomUnit is a unit which a token amount can be displayed in, one unit equals to 10^Exponent base units
+type DenomUnit struct {
+	Denom    string   `json:"denom" yaml:"denom"`
+	Exponent uint32   `json:"exponent" yaml:"exponent"`
+	Aliases  []string `json:"aliases,omitempty" yaml:"aliases,omitempty"`
+}
+
+// SocialLink is a named link to the token's community, such as twitter or telegram
+type SocialLink struct {
+	Name string `json:"name" yaml:"name"`
+	URL  string `json:"url" yaml:"url"`
+}
+
+// TokenMetadata tells wallets and explorers how to display a token
+type TokenMetadata struct {
+	Display     string       `json:"display" yaml:"display"`   // the denomination shown to users
+	Decimals    uint32       `json:"decimals" yaml:"decimals"` // the exponent of the display denomination
+	DenomUnits  []DenomUnit  `json:"denom_units,omitempty" yaml:"denom_units,omitempty"`
+	LogoHash    string       `json:"logo_hash,omitempty" yaml:"logo_hash,omitempty"`
+	SocialLinks []SocialLink `json:"social_links,omitempty" yaml:"social_links,omitempty"`
+}
+
+// IsEmpty returns true if no metadata is provided, which is used to clear the metadata of a token
+func (m TokenMetadata) IsEmpty() bool {
+	return m.Display == "" && m.Decimals == 0 && len(m.DenomUnits) == 0 &&
+		m.LogoHash == "" && len(m.SocialLinks) == 0
+}
+
+// Validate checks the metadata of the token with the given symbol. When denom units are provided,
+// the first one must be the symbol itself with exponent 0, the exponents must be strictly increasing,
+// and the display denomination must be one of them with Decimals as its exponent.
+func (m TokenMetadata) Validate(symbol string) sdk.Error {
+	if m.IsEmpty() {
+		return nil
+	}
+	if m.Decimals > MaxTokenDecimals {
+		return ErrInvalidTokenMetadata(fmt.Sprintf("decimals can not be larger than %d", MaxTokenDecimals))
+	}
+	if !tokenDenomUnitRegex.MatchString(m.Display) {
+		return ErrInvalidTokenMetadata(fmt.Sprintf("invalid display denomination %s", m.Display))
+	}
+	if err := m.validateDenomUnits(symbol); err != nil {
+		return err
+	}
+	if m.LogoHash != "" {
+		if len(m.LogoHash) != TokenLogoHashLength {
+			return ErrInvalidTokenMetadata(fmt.Sprintf("logo hash must be %d hex characters", TokenLogoHashLength))
+		}
+		if _, err := hex.DecodeString(m.LogoHash); err != nil {
+			return ErrInvalidTokenMetadata("logo hash must be hex encoded")
+		}
+	}
+	return m.validateSocialLinks()
+}
+
+func (m TokenMetadata) validateDenomUnits(symbol string) sdk.Error {
+	if len(m.DenomUnits) == 0 {
+		return nil
+	}
+	if len(m.DenomUnits) > MaxTokenDenomUnits {
+		return ErrInvalidTokenMetadata(fmt.Sprintf("at most %d denom units are allowed", MaxTokenDenomUnits))
+	}
+	if m.DenomUnits[0].Denom != symbol || m.DenomUnits[0].Exponent != 0 {
+		return ErrInvalidTokenMetadata(fmt.Sprintf("the first denom unit must be %s with exponent 0", symbol))
+	}
+
+	names := make(map[string]bool)
+	displayFound := false
+	for i, unit := range m.DenomUnits {
+		if i > 0 && unit.Exponent <= m.DenomUnits[i-1].Exponent {
+			return ErrInvalidTokenMetadata("the exponents of denom units must be increasing")
+		}
+		if unit.Exponent > MaxTokenDecimals {
+			return ErrInvalidTokenMetadata(fmt.Sprintf("exponent of %s can not be larger than %d", unit.Denom, MaxTokenDecimals))
+		}
+		if len(unit.Aliases) > MaxTokenDenomAliases {
+			return ErrInvalidTokenMetadata(fmt.Sprintf("at most %d aliases are allowed for %s", MaxTokenDenomAliases, unit.Denom))
+		}
+		for _, name := range append([]string{unit.Denom}, unit.Aliases...) {
+			if name != symbol && !tokenDenomUnitRegex.MatchString(name) {
+				return ErrInvalidTokenMetadata(fmt.Sprintf("invalid denomination %s", name))
+			}
+			if names[name] {
+				return ErrInvalidTokenMetadata(fmt.Sprintf("duplicated denomination %s", name))
+			}
+			names[name] = true
+		}
+		if unit.Denom == m.Display {
+			if unit.Exponent != m.Decimals {
+				return ErrInvalidTokenMetadata(fmt.Sprintf("the exponent of display denomination %s must be %d", m.Display, m.Decimals))
+			}
+			displayFound = true
+		}
+	}
+	if !displayFound {
+		return ErrInvalidTokenMetadata(fmt.Sprintf("display denomination %s is not in the denom units", m.Display))
+	}
+	return nil
+}
+
+func (m TokenMetadata) validateSocialLinks() sdk.Error {
+	if len(m.SocialLinks) > MaxTokenSocialLinks {
+		return ErrInvalidTokenMetadata(fmt.Sprintf("at most %d social links are allowed", MaxTokenSocialLinks))
+	}
+	names := make(map[string]bool)
+	for _, link := range m.SocialLinks {
+		if link.Name == "" || utf8.RuneCountInString(link.Name) > MaxTokenSocialNameLength {
+			return ErrInvalidTokenMetadata(fmt.Sprintf("invalid social link name %s", link.Name))
+		}
+		if names[link.Name] {
+			return ErrInvalidTokenMetadata(fmt.Sprintf("duplicated social link %s", link.Name))
+		}
+		names[link.Name] = true
+		if link.URL == "" || utf8.RuneCountInString(link.URL) > MaxTokenURLLength {
+			return ErrInvalidTokenMetadata(fmt.Sprintf("invalid url of social link %s", link.Name))
+		}
+	}
+	return nil
+}
+
+func (m TokenMetadata) String() string {
+	units := make([]string, len(m.DenomUnits))
+	for i, unit := range m.DenomUnits {
+		units[i] = fmt.Sprintf("%s:%d", unit.Denom, unit.Exponent)
+	}
+	links := make([]string, len(m.SocialLinks))
+	for i, link := range m.SocialLinks {
+		links[i] = link.Name + "=" + link.URL
+	}
+	return fmt.Sprintf("%s (decimals %d) units [%s] logo %s links [%s]", m.Display, m.Decimals,
+		strings.Join(units, ","), m.LogoHash, strings.Join(links, ","))
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	MaxMintWindowSeconds = 365 * 24 * 3600
+
+	MintLimitSupplyCap    = "supply_cap"
+	MintLimitWindowAmount = "window_amount"
+)
+
+// MintPolicy limits how much the owner can mint by MintToken: TotalSupply can never exceed SupplyCap,
+// and at most WindowAmount can be minted within any WindowSeconds long period. A zero SupplyCap or
+// WindowAmount means no such limit. The policy can never be changed once set.
+type MintPolicy struct {
+	SupplyCap     sdk.Int `json:"supply_cap" yaml:"supply_cap"`
+	WindowAmount  sdk.Int `json:"window_amount" yaml:"window_amount"`
+	WindowSeconds int64   `json:"window_seconds" yaml:"window_seconds"`
+}
+
+func NewMintPolicy(supplyCap, windowAmount sdk.Int, windowSeconds int64) MintPolicy {
+	return MintPolicy{
+		SupplyCap:     supplyCap,
+		WindowAmount:  windowAmount,
+		WindowSeconds: windowSeconds,
+	}
+}
+
+func (p MintPolicy) HasSupplyCap() bool {
+	return p.SupplyCap.IsPositive()
+}
+
+func (p MintPolicy) HasRateLimit() bool {
+	return p.WindowAmount.IsPositive()
+}
+
+func (p MintPolicy) Validate() sdk.Error {
+	if p.SupplyCap == (sdk.Int{}) || p.WindowAmount == (sdk.Int{}) {
+		return ErrInvalidMintPolicy("missing supply cap or window amount")
+	}
+	if p.SupplyCap.IsNegative() {
+		return ErrInvalidMintPolicy("invalid supply cap " + p.SupplyCap.String())
+	}
+	if p.WindowAmount.IsNegative() {
+		return ErrInvalidMintPolicy("invalid window amount " + p.WindowAmount.String())
+	}
+	if !p.HasSupplyCap() && !p.HasRateLimit() {
+		return ErrInvalidMintPolicy("either supply cap or window amount must be positive")
+	}
+	if p.HasRateLimit() {
+		if p.WindowSeconds <= 0 || p.WindowSeconds > MaxMintWindowSeconds {
+			return ErrInvalidMintPolicy(fmt.Sprintf("window must be 1 ~ %d seconds", MaxMintWindowSeconds))
+		}
+	} else if p.WindowSeconds != 0 {
+		return ErrInvalidMintPolicy("window seconds is set without window amount")
+	}
+	return nil
+}
+
+// MintRecord is the amount minted by MintToken at a block time (unix seconds), which is kept
+// as long as it is in the window of the mint policy
+type MintRecord struct {
+	Symbol string  `json:"symbol" yaml:"symbol"`
+	Time   int64   `json:"time" yaml:"time"`
+	Amount sdk.Int `json:"amount" yaml:"amount"`
+}
+
+func NewMintRecord(symbol string, time int64, amount sdk.Int) MintRecord {
+	return MintRecord{
+		Symbol: symbol,
+		Time:   time,
+		Amount: amount,
+	}
+}
+
+func (r MintRecord) Validate() sdk.Error {
+	if err := ValidateTokenSymbol(r.Symbol); err != nil {
+		return err
+	}
+	if r.Time <= 0 {
+		return ErrInvalidMintPolicy("invalid mint record time")
+	}
+	if r.Amount == (sdk.Int{}) || !r.Amount.IsPositive() {
+		return ErrInvalidTokenMintAmt(r.Amount.String())
+	}
+	return nil
+}
+
+// MintPolicyInfo is the mint policy of a token and how much it can still mint
+type MintPolicyInfo struct {
+	Symbol       string     `json:"symbol" yaml:"symbol"`
+	Policy       MintPolicy `json:"policy" yaml:"policy"`
+	WindowMinted sdk.Int    `json:"window_minted" yaml:"window_minted"`
+	Mintable     sdk.Int    `json:"mintable" yaml:"mintable"`
+}
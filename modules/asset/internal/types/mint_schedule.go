@@ -0,0 +1,221 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	MaxMintTranches = 100
+	// the amounts in a schedule are limited to 128 bits, so that their sum can never overflow
+	maxScheduledAmountBits = 128
+)
+
+// MintTranche is an amount of token minted to Recipient once the block time reaches Time (unix seconds)
+type MintTranche struct {
+	Time      int64          `json:"time" yaml:"time"`
+	Amount    sdk.Int        `json:"amount" yaml:"amount"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+}
+
+// LinearEmission mints Rate tokens per second to Recipient, from StartTime to EndTime (unix seconds)
+type LinearEmission struct {
+	Rate      sdk.Int        `json:"rate" yaml:"rate"`
+	StartTime int64          `json:"start_time" yaml:"start_time"`
+	EndTime   int64          `json:"end_time" yaml:"end_time"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+}
+
+// MintSchedule decides how a token will be minted in the future, it is either a list of tranches
+// or a linear emission, and can never be changed once registered
+type MintSchedule struct {
+	Tranches []MintTranche   `json:"tranches" yaml:"tranches"`
+	Emission *LinearEmission `json:"emission,omitempty" yaml:"emission,omitempty"`
+}
+
+func (s MintSchedule) IsLinear() bool {
+	return s.Emission != nil
+}
+
+// TotalAmount returns the amount of token which will be minted by the whole schedule
+func (s MintSchedule) TotalAmount() sdk.Int {
+	if s.IsLinear() {
+		return s.Emission.Rate.MulRaw(s.Emission.EndTime - s.Emission.StartTime)
+	}
+	total := sdk.ZeroInt()
+	for _, tranche := range s.Tranches {
+		total = total.Add(tranche.Amount)
+	}
+	return total
+}
+
+// StartTime returns the earliest time at which some token is minted
+func (s MintSchedule) StartTime() int64 {
+	if s.IsLinear() {
+		return s.Emission.StartTime
+	}
+	return s.Tranches[0].Time
+}
+
+func (s MintSchedule) Validate() sdk.Error {
+	if s.IsLinear() {
+		if len(s.Tranches) != 0 {
+			return ErrInvalidMintSchedule("tranches and linear emission can not be used together")
+		}
+		e := s.Emission
+		if !validScheduledAmount(e.Rate) {
+			return ErrInvalidMintSchedule("invalid emission rate " + e.Rate.String())
+		}
+		if e.StartTime <= 0 || e.EndTime <= e.StartTime {
+			return ErrInvalidMintSchedule("emission must end after it starts")
+		}
+		if e.Recipient.Empty() {
+			return ErrInvalidMintSchedule("missing emission recipient")
+		}
+		return nil
+	}
+
+	if len(s.Tranches) == 0 {
+		return ErrInvalidMintSchedule("neither tranches nor linear emission is provided")
+	}
+	if len(s.Tranches) > MaxMintTranches {
+		return ErrInvalidMintSchedule(fmt.Sprintf("no more than %d tranches are allowed", MaxMintTranches))
+	}
+	var lastTime int64
+	for _, tranche := range s.Tranches {
+		if tranche.Time <= lastTime {
+			return ErrInvalidMintSchedule("tranche times must be positive and increasing")
+		}
+		if !validScheduledAmount(tranche.Amount) {
+			return ErrInvalidMintSchedule("invalid tranche amount " + tranche.Amount.String())
+		}
+		if tranche.Recipient.Empty() {
+			return ErrInvalidMintSchedule("missing tranche recipient")
+		}
+		lastTime = tranche.Time
+	}
+	return nil
+}
+
+func validScheduledAmount(amt sdk.Int) bool {
+	return amt.IsPositive() && amt.BigInt().BitLen() <= maxScheduledAmountBits
+}
+
+func (s MintSchedule) Recipients() []sdk.AccAddress {
+	if s.IsLinear() {
+		return []sdk.AccAddress{s.Emission.Recipient}
+	}
+	res := make([]sdk.AccAddress, len(s.Tranches))
+	for i, tranche := range s.Tranches {
+		res[i] = tranche.Recipient
+	}
+	return res
+}
+
+func (s MintSchedule) String() string {
+	if s.IsLinear() {
+		e := s.Emission
+		return fmt.Sprintf("Emission: %s per second to %s, from %d to %d",
+			e.Rate.String(), e.Recipient.String(), e.StartTime, e.EndTime)
+	}
+	lines := make([]string, len(s.Tranches))
+	for i, tranche := range s.Tranches {
+		lines[i] = fmt.Sprintf("Tranche: %s to %s at %d",
+			tranche.Amount.String(), tranche.Recipient.String(), tranche.Time)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// MintScheduleInfo is a token's mint schedule, together with how far it has been executed
+type MintScheduleInfo struct {
+	Symbol   string       `json:"symbol" yaml:"symbol"`
+	Schedule MintSchedule `json:"schedule" yaml:"schedule"`
+	// index of the first tranche which has not been minted
+	NextTranche int64 `json:"next_tranche" yaml:"next_tranche"`
+	// the linear emission has been minted until this time
+	EmittedUntil int64 `json:"emitted_until" yaml:"emitted_until"`
+	// a schedule is stopped once one of its mints fails, and the rest of it is never minted
+	Stopped bool `json:"stopped,omitempty" yaml:"stopped,omitempty"`
+}
+
+func NewMintScheduleInfo(symbol string, schedule MintSchedule) MintScheduleInfo {
+	info := MintScheduleInfo{Symbol: symbol, Schedule: schedule}
+	if schedule.IsLinear() {
+		info.EmittedUntil = schedule.Emission.StartTime
+	}
+	return info
+}
+
+func (info MintScheduleInfo) Finished() bool {
+	if info.Schedule.IsLinear() {
+		return info.EmittedUntil >= info.Schedule.Emission.EndTime
+	}
+	return info.NextTranche >= int64(len(info.Schedule.Tranches))
+}
+
+// NextDueTime returns the earliest block time at which some token of the schedule can be minted,
+// false is returned if nothing is left to mint
+func (info MintScheduleInfo) NextDueTime() (int64, bool) {
+	if info.Stopped || info.Finished() {
+		return 0, false
+	}
+	if info.Schedule.IsLinear() {
+		return info.EmittedUntil + 1, true
+	}
+	return info.Schedule.Tranches[info.NextTranche].Time, true
+}
+
+// Execute returns the mints which are due at time 'now', and marks them as executed
+func (info *MintScheduleInfo) Execute(now int64) []MintTranche {
+	var mints []MintTranche
+	if info.Schedule.IsLinear() {
+		e := info.Schedule.Emission
+		until := now
+		if until > e.EndTime {
+			until = e.EndTime
+		}
+		if until > info.EmittedUntil {
+			amount := e.Rate.MulRaw(until - info.EmittedUntil)
+			mints = append(mints, MintTranche{Time: until, Amount: amount, Recipient: e.Recipient})
+			info.EmittedUntil = until
+		}
+		return mints
+	}
+	for ; info.NextTranche < int64(len(info.Schedule.Tranches)); info.NextTranche++ {
+		tranche := info.Schedule.Tranches[info.NextTranche]
+		if tranche.Time > now {
+			break
+		}
+		mints = append(mints, tranche)
+	}
+	return mints
+}
+
+// Remaining returns the amount of token which has not been minted
+func (info MintScheduleInfo) Remaining() sdk.Int {
+	s := info.Schedule
+	if s.IsLinear() {
+		return s.Emission.Rate.MulRaw(s.Emission.EndTime - info.EmittedUntil)
+	}
+	return MintSchedule{Tranches: s.Tranches[info.NextTranche:]}.TotalAmount()
+}
+
+func (info MintScheduleInfo) Validate() sdk.Error {
+	if err := ValidateTokenSymbol(info.Symbol); err != nil {
+		return err
+	}
+	if err := info.Schedule.Validate(); err != nil {
+		return err
+	}
+	s := info.Schedule
+	if s.IsLinear() {
+		if info.EmittedUntil < s.Emission.StartTime || info.EmittedUntil > s.Emission.EndTime {
+			return ErrInvalidMintSchedule("invalid emission progress")
+		}
+	} else if info.NextTranche < 0 || info.NextTranche > int64(len(s.Tranches)) {
+		return ErrInvalidMintSchedule("invalid tranche progress")
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"fmt"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,21 +21,40 @@ var (
 	_ sdk.Msg = &MsgForbidAddr{}
 	_ sdk.Msg = &MsgUnForbidAddr{}
 	_ sdk.Msg = &MsgModifyTokenInfo{}
+	_ sdk.Msg = &MsgSetMintSchedule{}
+	_ sdk.Msg = &MsgSetTokenCoOwners{}
+	_ sdk.Msg = &MsgProposeTokenAction{}
+	_ sdk.Msg = &MsgApproveTokenAction{}
+	_ sdk.Msg = &MsgAcceptOwnership{}
+	_ sdk.Msg = &MsgCancelOwnershipTransfer{}
+	_ sdk.Msg = &MsgHolderBurnToken{}
+	_ sdk.Msg = &MsgFreezeTokenAmount{}
+	_ sdk.Msg = &MsgUnfreezeTokenAmount{}
+	_ sdk.Msg = &MsgRequestTokenSnapshot{}
+	_ sdk.Msg = &MsgOpenSymbolAuction{}
+	_ sdk.Msg = &MsgBidSymbolAuction{}
+	_ sdk.Msg = &MsgDistributeToHolders{}
+	_ sdk.Msg = &MsgSetMintPolicy{}
 )
 
 // MsgIssueToken
 type MsgIssueToken struct {
-	Name             string         `json:"name" yaml:"name"`                           // Name of the newly issued asset, limited to 32 unicode characters
-	Symbol           string         `json:"symbol" yaml:"symbol"`                       // token symbol, [a-z][a-z0-9]{1,7}
-	TotalSupply      sdk.Int        `json:"total_supply" yaml:"total_supply"`           // The total supply for this token [0]
-	Owner            sdk.AccAddress `json:"owner" yaml:"owner"`                         // The initial issuer of this token [1]
-	Mintable         bool           `json:"mintable" yaml:"mintable"`                   // Whether this token could be minted after the issuing
-	Burnable         bool           `json:"burnable" yaml:"burnable"`                   // Whether this token could be burned
-	AddrForbiddable  bool           `json:"addr_forbiddable" yaml:"addr_forbiddable"`   // whether could forbid some addresses to forbid transaction
-	TokenForbiddable bool           `json:"token_forbiddable" yaml:"token_forbiddable"` // whether token could be global forbid
-	URL              string         `json:"url" yaml:"url"`                             //URL of token website
-	Description      string         `json:"description" yaml:"description"`             //Description of token info
-	Identity         string         `json:"identity" yaml:"identity"`                   //Identity of token
+	Name             string         `json:"name" yaml:"name"`                                       // Name of the newly issued asset, limited to 32 unicode characters
+	Symbol           string         `json:"symbol" yaml:"symbol"`                                   // token symbol, [a-z][a-z0-9]{1,7}
+	TotalSupply      sdk.Int        `json:"total_supply" yaml:"total_supply"`                       // The total supply for this token [0]
+	Owner            sdk.AccAddress `json:"owner" yaml:"owner"`                                     // The initial issuer of this token [1]
+	Mintable         bool           `json:"mintable" yaml:"mintable"`                               // Whether this token could be minted after the issuing
+	Burnable         bool           `json:"burnable" yaml:"burnable"`                               // Whether this token could be burned
+	AddrForbiddable  bool           `json:"addr_forbiddable" yaml:"addr_forbiddable"`               // whether could forbid some addresses to forbid transaction
+	TokenForbiddable bool           `json:"token_forbiddable" yaml:"token_forbiddable"`             // whether token could be global forbid
+	URL              string         `json:"url" yaml:"url"`                                         //URL of token website
+	Description      string         `json:"description" yaml:"description"`                         //Description of token info
+	Identity         string         `json:"identity" yaml:"identity"`                               //Identity of token
+	MintSchedule     *MintSchedule  `json:"mint_schedule,omitempty" yaml:"mint_schedule,omitempty"` // The optional mint schedule registered with the token
+	// Whether any holder could burn its own balance for redemption
+	HolderBurnable bool `json:"holder_burnable,omitempty" yaml:"holder_burnable,omitempty"`
+	// Whether only the owner and the whitelisted addresses could hold, send, receive or trade the token
+	Permissioned bool `json:"permissioned,omitempty" yaml:"permissioned,omitempty"`
 }
 
 // NewMsgIssueToken
@@ -54,6 +74,9 @@ func NewMsgIssueToken(name string, symbol string, amt sdk.Int, owner sdk.AccAddr
 		url,
 		description,
 		identity,
+		nil,
+		false,
+		false,
 	}
 }
 
@@ -75,7 +98,21 @@ func (msg MsgIssueToken) Type() string {
 func (msg MsgIssueToken) ValidateBasic() sdk.Error {
 	_, err := NewToken(msg.Name, msg.Symbol, msg.TotalSupply, msg.Owner,
 		msg.Mintable, msg.Burnable, msg.AddrForbiddable, msg.TokenForbiddable, msg.URL, msg.Description, msg.Identity)
-	return err
+	if err != nil {
+		return err
+	}
+	if msg.MintSchedule != nil {
+		if !msg.Mintable {
+			return ErrTokenMintNotSupported(msg.Symbol)
+		}
+		if err := msg.MintSchedule.Validate(); err != nil {
+			return err
+		}
+	}
+	if msg.HolderBurnable && !msg.Burnable {
+		return ErrTokenBurnNotSupported(msg.Symbol)
+	}
+	return nil
 }
 
 // GetSignBytes Implements Msg.
@@ -606,6 +643,9 @@ type MsgModifyTokenInfo struct {
 	Burnable         string         `json:"burnable" yaml:"burnable"`
 	AddrForbiddable  string         `json:"addr_forbiddable" yaml:"addr_forbiddable"`
 	TokenForbiddable string         `json:"token_forbiddable" yaml:"token_forbiddable"`
+	HolderBurnable   string         `json:"holder_burnable,omitempty" yaml:"holder_burnable,omitempty"`
+	// Metadata replaces the whole metadata of the token if it is not nil, and an empty one clears it
+	Metadata *TokenMetadata `json:"metadata,omitempty" yaml:"metadata,omitempty"`
 }
 
 func NewMsgModifyTokenInfo(symbol, url, description, identity string, owner sdk.AccAddress,
@@ -687,6 +727,17 @@ func (msg MsgModifyTokenInfo) ValidateBasic() sdk.Error {
 	if err := validateBoolField("TokenForbiddable", msg.TokenForbiddable); err != nil {
 		return err
 	}
+	// HolderBurnable is optional and left unchanged when it is empty
+	if msg.HolderBurnable != "" {
+		if err := validateBoolField("HolderBurnable", msg.HolderBurnable); err != nil {
+			return err
+		}
+	}
+	if msg.Metadata != nil {
+		if err := msg.Metadata.Validate(msg.Symbol); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -709,3 +760,770 @@ func (msg MsgModifyTokenInfo) GetSignBytes() []byte {
 func (msg MsgModifyTokenInfo) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.OwnerAddress}
 }
+
+// MsgSetMintSchedule
+type MsgSetMintSchedule struct {
+	Symbol       string         `json:"symbol" yaml:"symbol"`
+	OwnerAddress sdk.AccAddress `json:"owner_address" yaml:"owner_address"`
+	Schedule     MintSchedule   `json:"schedule" yaml:"schedule"`
+}
+
+func NewMsgSetMintSchedule(symbol string, owner sdk.AccAddress, schedule MintSchedule) MsgSetMintSchedule {
+	return MsgSetMintSchedule{
+		symbol,
+		owner,
+		schedule,
+	}
+}
+
+func (msg *MsgSetMintSchedule) SetAccAddress(addr sdk.AccAddress) {
+	msg.OwnerAddress = addr
+}
+
+// Route Implements Msg.
+func (msg MsgSetMintSchedule) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgSetMintSchedule) Type() string {
+	return "set_mint_schedule"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetMintSchedule) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if msg.OwnerAddress.Empty() {
+		return ErrNilTokenOwner()
+	}
+	return msg.Schedule.Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetMintSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetMintSchedule) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
+
+// MsgSetTokenCoOwners
+type MsgSetTokenCoOwners struct {
+	Symbol       string           `json:"symbol" yaml:"symbol"`
+	OwnerAddress sdk.AccAddress   `json:"owner_address" yaml:"owner_address"`
+	CoOwners     []sdk.AccAddress `json:"co_owners" yaml:"co_owners"` // an empty list removes the co-owners
+	Threshold    uint32           `json:"threshold" yaml:"threshold"`
+}
+
+func NewMsgSetTokenCoOwners(symbol string, owner sdk.AccAddress, coOwners []sdk.AccAddress, threshold uint32) MsgSetTokenCoOwners {
+	return MsgSetTokenCoOwners{
+		symbol,
+		owner,
+		coOwners,
+		threshold,
+	}
+}
+
+func (msg *MsgSetTokenCoOwners) SetAccAddress(addr sdk.AccAddress) {
+	msg.OwnerAddress = addr
+}
+
+// Route Implements Msg.
+func (msg MsgSetTokenCoOwners) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgSetTokenCoOwners) Type() string {
+	return "set_token_co_owners"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetTokenCoOwners) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if msg.OwnerAddress.Empty() {
+		return ErrNilTokenOwner()
+	}
+	if len(msg.CoOwners) == 0 && msg.Threshold == 0 {
+		return nil
+	}
+	return ValidateCoOwners(msg.CoOwners, msg.Threshold)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetTokenCoOwners) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetTokenCoOwners) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
+
+// MsgProposeTokenAction
+type MsgProposeTokenAction struct {
+	Proposer sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Action   sdk.Msg        `json:"action" yaml:"action"`     // the action, whose owner must be the token owner
+	Lifetime int64          `json:"lifetime" yaml:"lifetime"` // seconds before the proposal expires
+}
+
+func NewMsgProposeTokenAction(proposer sdk.AccAddress, action sdk.Msg, lifetime int64) MsgProposeTokenAction {
+	return MsgProposeTokenAction{
+		proposer,
+		action,
+		lifetime,
+	}
+}
+
+func (msg *MsgProposeTokenAction) SetAccAddress(addr sdk.AccAddress) {
+	msg.Proposer = addr
+}
+
+// Route Implements Msg.
+func (msg MsgProposeTokenAction) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgProposeTokenAction) Type() string {
+	return "propose_token_action"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgProposeTokenAction) ValidateBasic() sdk.Error {
+	if msg.Proposer.Empty() {
+		return ErrNilTokenOwner()
+	}
+	if msg.Action == nil {
+		return ErrInvalidTokenAction("missing action")
+	}
+	if _, _, ok := GetTokenAdminAction(msg.Action); !ok {
+		return ErrInvalidTokenAction("action " + msg.Action.Type() + " can not be proposed")
+	}
+	if msg.Lifetime <= 0 || msg.Lifetime > MaxTokenActionLifetime {
+		return ErrInvalidTokenAction(fmt.Sprintf("lifetime must be 1 ~ %d seconds", MaxTokenActionLifetime))
+	}
+	return msg.Action.ValidateBasic()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgProposeTokenAction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgProposeTokenAction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Proposer}
+}
+
+// MsgApproveTokenAction
+type MsgApproveTokenAction struct {
+	Symbol     string         `json:"symbol" yaml:"symbol"`
+	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
+	Approver   sdk.AccAddress `json:"approver" yaml:"approver"`
+}
+
+func NewMsgApproveTokenAction(symbol string, proposalID uint64, approver sdk.AccAddress) MsgApproveTokenAction {
+	return MsgApproveTokenAction{
+		symbol,
+		proposalID,
+		approver,
+	}
+}
+
+func (msg *MsgApproveTokenAction) SetAccAddress(addr sdk.AccAddress) {
+	msg.Approver = addr
+}
+
+// Route Implements Msg.
+func (msg MsgApproveTokenAction) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgApproveTokenAction) Type() string {
+	return "approve_token_action"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgApproveTokenAction) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if msg.Approver.Empty() {
+		return ErrNilTokenOwner()
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgApproveTokenAction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgApproveTokenAction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Approver}
+}
+
+// MsgAcceptOwnership
+type MsgAcceptOwnership struct {
+	Symbol   string         `json:"symbol" yaml:"symbol"`
+	NewOwner sdk.AccAddress `json:"new_owner" yaml:"new_owner"`
+}
+
+func NewMsgAcceptOwnership(symbol string, newOwner sdk.AccAddress) MsgAcceptOwnership {
+	return MsgAcceptOwnership{
+		symbol,
+		newOwner,
+	}
+}
+
+func (msg *MsgAcceptOwnership) SetAccAddress(addr sdk.AccAddress) {
+	msg.NewOwner = addr
+}
+
+// Route Implements Msg.
+func (msg MsgAcceptOwnership) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgAcceptOwnership) Type() string {
+	return "accept_ownership"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgAcceptOwnership) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if msg.NewOwner.Empty() {
+		return ErrNilTokenOwner()
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgAcceptOwnership) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgAcceptOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.NewOwner}
+}
+
+// MsgCancelOwnershipTransfer
+type MsgCancelOwnershipTransfer struct {
+	Symbol       string         `json:"symbol" yaml:"symbol"`
+	OwnerAddress sdk.AccAddress `json:"owner_address" yaml:"owner_address"`
+}
+
+func NewMsgCancelOwnershipTransfer(symbol string, owner sdk.AccAddress) MsgCancelOwnershipTransfer {
+	return MsgCancelOwnershipTransfer{
+		symbol,
+		owner,
+	}
+}
+
+func (msg *MsgCancelOwnershipTransfer) SetAccAddress(addr sdk.AccAddress) {
+	msg.OwnerAddress = addr
+}
+
+// Route Implements Msg.
+func (msg MsgCancelOwnershipTransfer) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgCancelOwnershipTransfer) Type() string {
+	return "cancel_ownership_transfer"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCancelOwnershipTransfer) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if msg.OwnerAddress.Empty() {
+		return ErrNilTokenOwner()
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCancelOwnershipTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCancelOwnershipTransfer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
+
+// MsgHolderBurnToken
+type MsgHolderBurnToken struct {
+	Symbol        string         `json:"symbol" yaml:"symbol"`
+	Amount        sdk.Int        `json:"amount" yaml:"amount"`
+	HolderAddress sdk.AccAddress `json:"holder_address" yaml:"holder_address"`
+	Memo          string         `json:"memo" yaml:"memo"` // redemption info for the issuer, such as a withdrawal address
+}
+
+func NewMsgHolderBurnToken(symbol string, amt sdk.Int, holder sdk.AccAddress, memo string) MsgHolderBurnToken {
+	return MsgHolderBurnToken{
+		symbol,
+		amt,
+		holder,
+		memo,
+	}
+}
+
+func (msg *MsgHolderBurnToken) SetAccAddress(addr sdk.AccAddress) {
+	msg.HolderAddress = addr
+}
+
+// Route Implements Msg.
+func (msg MsgHolderBurnToken) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgHolderBurnToken) Type() string {
+	return "holder_burn_token"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgHolderBurnToken) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+
+	if msg.HolderAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing holder address")
+	}
+
+	amt := msg.Amount
+	if !amt.IsPositive() {
+		return ErrInvalidTokenBurnAmt(amt.String())
+	}
+
+	return ValidateRedemptionMemo(msg.Memo)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgHolderBurnToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgHolderBurnToken) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.HolderAddress}
+}
+
+// MsgFreezeTokenAmount
+type MsgFreezeTokenAmount struct {
+	Symbol       string         `json:"symbol" yaml:"symbol"`
+	OwnerAddress sdk.AccAddress `json:"owner_address" yaml:"owner_address"`
+	Address      sdk.AccAddress `json:"address" yaml:"address"`
+	Amount       sdk.Int        `json:"amount" yaml:"amount"`
+}
+
+func NewMsgFreezeTokenAmount(symbol string, owner sdk.AccAddress, addr sdk.AccAddress, amt sdk.Int) MsgFreezeTokenAmount {
+	return MsgFreezeTokenAmount{
+		symbol,
+		owner,
+		addr,
+		amt,
+	}
+}
+
+func (msg *MsgFreezeTokenAmount) SetAccAddress(addr sdk.AccAddress) {
+	msg.OwnerAddress = addr
+}
+
+// Route Implements Msg.
+func (msg MsgFreezeTokenAmount) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgFreezeTokenAmount) Type() string {
+	return "freeze_token_amount"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgFreezeTokenAmount) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if msg.OwnerAddress.Empty() {
+		return ErrNilTokenOwner()
+	}
+	if msg.Address.Empty() {
+		return ErrNilForbiddenAddress()
+	}
+	if msg.Address.Equals(msg.OwnerAddress) {
+		return ErrTokenOwnerSelfForbidden()
+	}
+	if msg.Amount == (sdk.Int{}) || !msg.Amount.IsPositive() {
+		return ErrInvalidFreezeAmount(msg.Amount.String())
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgFreezeTokenAmount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgFreezeTokenAmount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
+
+// MsgUnfreezeTokenAmount
+type MsgUnfreezeTokenAmount struct {
+	Symbol       string         `json:"symbol" yaml:"symbol"`
+	OwnerAddress sdk.AccAddress `json:"owner_address" yaml:"owner_address"`
+	Address      sdk.AccAddress `json:"address" yaml:"address"`
+	Amount       sdk.Int        `json:"amount" yaml:"amount"`
+}
+
+func NewMsgUnfreezeTokenAmount(symbol string, owner sdk.AccAddress, addr sdk.AccAddress, amt sdk.Int) MsgUnfreezeTokenAmount {
+	return MsgUnfreezeTokenAmount{
+		symbol,
+		owner,
+		addr,
+		amt,
+	}
+}
+
+func (msg *MsgUnfreezeTokenAmount) SetAccAddress(addr sdk.AccAddress) {
+	msg.OwnerAddress = addr
+}
+
+// Route Implements Msg.
+func (msg MsgUnfreezeTokenAmount) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgUnfreezeTokenAmount) Type() string {
+	return "unfreeze_token_amount"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgUnfreezeTokenAmount) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if msg.OwnerAddress.Empty() {
+		return ErrNilTokenOwner()
+	}
+	if msg.Address.Empty() {
+		return ErrNilForbiddenAddress()
+	}
+	if msg.Address.Equals(msg.OwnerAddress) {
+		return ErrTokenOwnerSelfForbidden()
+	}
+	if msg.Amount == (sdk.Int{}) || !msg.Amount.IsPositive() {
+		return ErrInvalidFreezeAmount(msg.Amount.String())
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUnfreezeTokenAmount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUnfreezeTokenAmount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
+
+// MsgRequestTokenSnapshot
+type MsgRequestTokenSnapshot struct {
+	Symbol    string         `json:"symbol" yaml:"symbol"`
+	Requester sdk.AccAddress `json:"requester" yaml:"requester"`
+}
+
+func NewMsgRequestTokenSnapshot(symbol string, requester sdk.AccAddress) MsgRequestTokenSnapshot {
+	return MsgRequestTokenSnapshot{
+		symbol,
+		requester,
+	}
+}
+
+func (msg *MsgRequestTokenSnapshot) SetAccAddress(addr sdk.AccAddress) {
+	msg.Requester = addr
+}
+
+// Route Implements Msg.
+func (msg MsgRequestTokenSnapshot) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgRequestTokenSnapshot) Type() string {
+	return "request_token_snapshot"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgRequestTokenSnapshot) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if msg.Requester.Empty() {
+		return sdk.ErrInvalidAddress("missing requester address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRequestTokenSnapshot) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRequestTokenSnapshot) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Requester}
+}
+
+// MsgOpenSymbolAuction
+type MsgOpenSymbolAuction struct {
+	Symbol string         `json:"symbol" yaml:"symbol"`
+	Bidder sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	Amount sdk.Int        `json:"amount" yaml:"amount"` // The bid in CET, which is frozen until it is outbid
+}
+
+func NewMsgOpenSymbolAuction(symbol string, bidder sdk.AccAddress, amt sdk.Int) MsgOpenSymbolAuction {
+	return MsgOpenSymbolAuction{
+		symbol,
+		bidder,
+		amt,
+	}
+}
+
+func (msg *MsgOpenSymbolAuction) SetAccAddress(addr sdk.AccAddress) {
+	msg.Bidder = addr
+}
+
+// Route Implements Msg.
+func (msg MsgOpenSymbolAuction) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgOpenSymbolAuction) Type() string {
+	return "open_symbol_auction"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgOpenSymbolAuction) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if !IsAuctionableSymbol(msg.Symbol) {
+		return ErrSymbolNotAuctionable(msg.Symbol)
+	}
+	if msg.Bidder.Empty() {
+		return sdk.ErrInvalidAddress("missing bidder address")
+	}
+	if msg.Amount == (sdk.Int{}) || !msg.Amount.IsPositive() {
+		return ErrInvalidSymbolBid(msg.Amount.String())
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgOpenSymbolAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgOpenSymbolAuction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Bidder}
+}
+
+// MsgBidSymbolAuction
+type MsgBidSymbolAuction struct {
+	Symbol string         `json:"symbol" yaml:"symbol"`
+	Bidder sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	Amount sdk.Int        `json:"amount" yaml:"amount"` // The bid in CET, which is frozen until it is outbid
+}
+
+func NewMsgBidSymbolAuction(symbol string, bidder sdk.AccAddress, amt sdk.Int) MsgBidSymbolAuction {
+	return MsgBidSymbolAuction{
+		symbol,
+		bidder,
+		amt,
+	}
+}
+
+func (msg *MsgBidSymbolAuction) SetAccAddress(addr sdk.AccAddress) {
+	msg.Bidder = addr
+}
+
+// Route Implements Msg.
+func (msg MsgBidSymbolAuction) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgBidSymbolAuction) Type() string {
+	return "bid_symbol_auction"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgBidSymbolAuction) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if !IsAuctionableSymbol(msg.Symbol) {
+		return ErrSymbolNotAuctionable(msg.Symbol)
+	}
+	if msg.Bidder.Empty() {
+		return sdk.ErrInvalidAddress("missing bidder address")
+	}
+	if msg.Amount == (sdk.Int{}) || !msg.Amount.IsPositive() {
+		return ErrInvalidSymbolBid(msg.Amount.String())
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBidSymbolAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBidSymbolAuction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Bidder}
+}
+
+// MsgDistributeToHolders
+type MsgDistributeToHolders struct {
+	Symbol       string           `json:"symbol" yaml:"symbol"`               // The token whose holders receive the distribution
+	OwnerAddress sdk.AccAddress   `json:"owner_address" yaml:"owner_address"` // The token owner who pays the distribution
+	Denom        string           `json:"denom" yaml:"denom"`
+	Amount       sdk.Int          `json:"amount" yaml:"amount"`
+	Excluded     []sdk.AccAddress `json:"excluded,omitempty" yaml:"excluded,omitempty"` // The holders which do not take part in the distribution, besides the owner
+}
+
+func NewMsgDistributeToHolders(symbol string, owner sdk.AccAddress, denom string, amt sdk.Int,
+	excluded []sdk.AccAddress) MsgDistributeToHolders {
+	return MsgDistributeToHolders{
+		symbol,
+		owner,
+		denom,
+		amt,
+		excluded,
+	}
+}
+
+func (msg *MsgDistributeToHolders) SetAccAddress(addr sdk.AccAddress) {
+	msg.OwnerAddress = addr
+}
+
+// Route Implements Msg.
+func (msg MsgDistributeToHolders) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgDistributeToHolders) Type() string {
+	return "distribute_to_holders"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgDistributeToHolders) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if err := ValidateTokenSymbol(msg.Denom); err != nil {
+		return err
+	}
+	if msg.OwnerAddress.Empty() {
+		return ErrNilTokenOwner()
+	}
+	if msg.Amount == (sdk.Int{}) || !msg.Amount.IsPositive() {
+		return ErrInvalidDistributionAmount(msg.Amount.String())
+	}
+	if len(msg.Excluded) > MaxDistributionExcluded {
+		return ErrTooManyExcludedAddresses(len(msg.Excluded))
+	}
+	for _, addr := range msg.Excluded {
+		if addr.Empty() {
+			return sdk.ErrInvalidAddress("excluded address is empty")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgDistributeToHolders) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgDistributeToHolders) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
+
+// MsgSetMintPolicy
+type MsgSetMintPolicy struct {
+	Symbol       string         `json:"symbol" yaml:"symbol"`
+	OwnerAddress sdk.AccAddress `json:"owner_address" yaml:"owner_address"`
+	Policy       MintPolicy     `json:"policy" yaml:"policy"`
+}
+
+func NewMsgSetMintPolicy(symbol string, owner sdk.AccAddress, policy MintPolicy) MsgSetMintPolicy {
+	return MsgSetMintPolicy{
+		Symbol:       symbol,
+		OwnerAddress: owner,
+		Policy:       policy,
+	}
+}
+
+func (msg *MsgSetMintPolicy) SetAccAddress(addr sdk.AccAddress) {
+	msg.OwnerAddress = addr
+}
+
+// Route Implements Msg.
+func (msg MsgSetMintPolicy) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgSetMintPolicy) Type() string {
+	return "set_mint_policy"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetMintPolicy) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if msg.OwnerAddress.Empty() {
+		return ErrNilTokenOwner()
+	}
+	return msg.Policy.Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetMintPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetMintPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
@@ -93,6 +93,12 @@ func TestMsgIssueToken_ValidateBasic(t *testing.T) {
 				false, false, false, false, "", "", TestIdentityString),
 			nil,
 		},
+		{
+			"holder burnable without burnable",
+			MsgIssueToken{Name: "ABC Token", Symbol: "abc", TotalSupply: sdk.NewInt(10000), Owner: testAddr,
+				Identity: TestIdentityString, HolderBurnable: true},
+			ErrTokenBurnNotSupported("abc"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -221,6 +227,166 @@ func TestMsgBurnToken_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgHolderBurnToken_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgHolderBurnToken
+		want sdk.Error
+	}{
+		{
+			"base-case",
+			NewMsgHolderBurnToken("abc", sdk.NewInt(10000), testAddr, "withdrawal address"),
+			nil,
+		},
+		{
+			"case-invalidSymbol",
+			NewMsgHolderBurnToken("w♞", sdk.NewInt(10000), testAddr, ""),
+			ErrInvalidTokenSymbol("w♞"),
+		},
+		{
+			"case-invalidHolder",
+			NewMsgHolderBurnToken("abc", sdk.NewInt(10000), sdk.AccAddress{}, ""),
+			sdk.ErrInvalidAddress("missing holder address"),
+		},
+		{
+			"case-invalidAmt",
+			NewMsgHolderBurnToken("abc", sdk.NewInt(0), testAddr, ""),
+			ErrInvalidTokenBurnAmt(sdk.NewInt(0).String()),
+		},
+		{
+			"case-invalidMemo",
+			NewMsgHolderBurnToken("abc", sdk.NewInt(10000), testAddr, string(make([]byte, MaxRedemptionMemoLength+1))),
+			ErrInvalidRedemptionMemo(string(make([]byte, MaxRedemptionMemoLength+1))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MsgHolderBurnToken.ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgFreezeTokenAmount_ValidateBasic(t *testing.T) {
+	addr := mockAddrList()[0]
+	tests := []struct {
+		name string
+		msg  sdk.Msg
+		want sdk.Error
+	}{
+		{
+			"base-case",
+			NewMsgFreezeTokenAmount("abc", testAddr, addr, sdk.NewInt(100)),
+			nil,
+		},
+		{
+			"case-invalidSymbol",
+			NewMsgFreezeTokenAmount("123", testAddr, addr, sdk.NewInt(100)),
+			ErrInvalidTokenSymbol("123"),
+		},
+		{
+			"case-invalidOwner",
+			NewMsgFreezeTokenAmount("abc", sdk.AccAddress{}, addr, sdk.NewInt(100)),
+			ErrNilTokenOwner(),
+		},
+		{
+			"case-invalidAddr",
+			NewMsgFreezeTokenAmount("abc", testAddr, sdk.AccAddress{}, sdk.NewInt(100)),
+			ErrNilForbiddenAddress(),
+		},
+		{
+			"case-selfFreeze",
+			NewMsgFreezeTokenAmount("abc", testAddr, testAddr, sdk.NewInt(100)),
+			ErrTokenOwnerSelfForbidden(),
+		},
+		{
+			"case-invalidAmt",
+			NewMsgFreezeTokenAmount("abc", testAddr, addr, sdk.NewInt(0)),
+			ErrInvalidFreezeAmount("0"),
+		},
+		{
+			"unfreeze-base-case",
+			NewMsgUnfreezeTokenAmount("abc", testAddr, addr, sdk.NewInt(100)),
+			nil,
+		},
+		{
+			"unfreeze-case-invalidAmt",
+			NewMsgUnfreezeTokenAmount("abc", testAddr, addr, sdk.NewInt(-1)),
+			ErrInvalidFreezeAmount("-1"),
+		},
+		{
+			"request-snapshot-base-case",
+			NewMsgRequestTokenSnapshot("abc", addr),
+			nil,
+		},
+		{
+			"request-snapshot-case-invalidSymbol",
+			NewMsgRequestTokenSnapshot("123", addr),
+			ErrInvalidTokenSymbol("123"),
+		},
+		{
+			"request-snapshot-case-nilRequester",
+			NewMsgRequestTokenSnapshot("abc", sdk.AccAddress{}),
+			sdk.ErrInvalidAddress("missing requester address"),
+		},
+		{
+			"open-auction-base-case",
+			NewMsgOpenSymbolAuction("abc", addr, sdk.NewInt(100)),
+			nil,
+		},
+		{
+			"open-auction-case-notAuctionable",
+			NewMsgOpenSymbolAuction("abcdefg", addr, sdk.NewInt(100)),
+			ErrSymbolNotAuctionable("abcdefg"),
+		},
+		{
+			"open-auction-case-nilBidder",
+			NewMsgOpenSymbolAuction("abc", sdk.AccAddress{}, sdk.NewInt(100)),
+			sdk.ErrInvalidAddress("missing bidder address"),
+		},
+		{
+			"bid-auction-base-case",
+			NewMsgBidSymbolAuction("abc", addr, sdk.NewInt(100)),
+			nil,
+		},
+		{
+			"bid-auction-case-invalidAmt",
+			NewMsgBidSymbolAuction("abc", addr, sdk.NewInt(0)),
+			ErrInvalidSymbolBid("0"),
+		},
+		{
+			"distribute-base-case",
+			NewMsgDistributeToHolders("abc", testAddr, "cet", sdk.NewInt(100), []sdk.AccAddress{addr}),
+			nil,
+		},
+		{
+			"distribute-case-invalidDenom",
+			NewMsgDistributeToHolders("abc", testAddr, "123", sdk.NewInt(100), nil),
+			ErrInvalidTokenSymbol("123"),
+		},
+		{
+			"distribute-case-invalidAmt",
+			NewMsgDistributeToHolders("abc", testAddr, "cet", sdk.NewInt(-1), nil),
+			ErrInvalidDistributionAmount("-1"),
+		},
+		{
+			"distribute-case-emptyExcluded",
+			NewMsgDistributeToHolders("abc", testAddr, "cet", sdk.NewInt(100), []sdk.AccAddress{{}}),
+			sdk.ErrInvalidAddress("excluded address is empty"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Msg.ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestMsgForbidToken_ValidateBasic(t *testing.T) {
 	tests := []struct {
 		name string
@@ -498,6 +664,30 @@ func TestMsgModifyTokenURL_ValidateBasic(t *testing.T) {
 			NewMsgModifyTokenInfo("abc", "www.abc.org", "abc example description", string(make([]byte, MaxTokenIdentityLength+1)), testAddr, "ABC token", "1000", "true", "true", "true", "true"),
 			ErrInvalidTokenIdentity(string(make([]byte, MaxTokenIdentityLength+1))),
 		},
+		{
+			"case-invalidHolderBurnable",
+			MsgModifyTokenInfo{Symbol: "abc", OwnerAddress: testAddr, URL: DoNotModifyTokenInfo, Description: DoNotModifyTokenInfo,
+				Identity: DoNotModifyTokenInfo, Name: DoNotModifyTokenInfo, TotalSupply: DoNotModifyTokenInfo,
+				Mintable: DoNotModifyTokenInfo, Burnable: DoNotModifyTokenInfo, AddrForbiddable: DoNotModifyTokenInfo,
+				TokenForbiddable: DoNotModifyTokenInfo, HolderBurnable: "yes"},
+			ErrInvalidTokenInfo("HolderBurnable", "yes"),
+		},
+		{
+			"case-invalidMetadata",
+			MsgModifyTokenInfo{Symbol: "abc", OwnerAddress: testAddr, URL: DoNotModifyTokenInfo, Description: DoNotModifyTokenInfo,
+				Identity: DoNotModifyTokenInfo, Name: DoNotModifyTokenInfo, TotalSupply: DoNotModifyTokenInfo,
+				Mintable: DoNotModifyTokenInfo, Burnable: DoNotModifyTokenInfo, AddrForbiddable: DoNotModifyTokenInfo,
+				TokenForbiddable: DoNotModifyTokenInfo, Metadata: &TokenMetadata{Display: "ABC", Decimals: MaxTokenDecimals + 1}},
+			ErrInvalidTokenMetadata("decimals can not be larger than 18"),
+		},
+		{
+			"case-clearMetadata",
+			MsgModifyTokenInfo{Symbol: "abc", OwnerAddress: testAddr, URL: DoNotModifyTokenInfo, Description: DoNotModifyTokenInfo,
+				Identity: DoNotModifyTokenInfo, Name: DoNotModifyTokenInfo, TotalSupply: DoNotModifyTokenInfo,
+				Mintable: DoNotModifyTokenInfo, Burnable: DoNotModifyTokenInfo, AddrForbiddable: DoNotModifyTokenInfo,
+				TokenForbiddable: DoNotModifyTokenInfo, Metadata: &TokenMetadata{}},
+			nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -509,6 +699,226 @@ func TestMsgModifyTokenURL_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgSetMintSchedule_ValidateBasic(t *testing.T) {
+	tranche := func(time, amt int64) MintTranche {
+		return MintTranche{Time: time, Amount: sdk.NewInt(amt), Recipient: testAddr}
+	}
+	tests := []struct {
+		name string
+		msg  MsgSetMintSchedule
+		want sdk.Error
+	}{
+		{
+			"base-case-tranches",
+			NewMsgSetMintSchedule("abc", testAddr, MintSchedule{Tranches: []MintTranche{tranche(100, 1), tranche(200, 2)}}),
+			nil,
+		},
+		{
+			"base-case-emission",
+			NewMsgSetMintSchedule("abc", testAddr, MintSchedule{Emission: &LinearEmission{
+				Rate: sdk.NewInt(1), StartTime: 100, EndTime: 200, Recipient: testAddr}}),
+			nil,
+		},
+		{
+			"case-invalidOwner",
+			NewMsgSetMintSchedule("abc", sdk.AccAddress{}, MintSchedule{Tranches: []MintTranche{tranche(100, 1)}}),
+			ErrNilTokenOwner(),
+		},
+		{
+			"case-empty",
+			NewMsgSetMintSchedule("abc", testAddr, MintSchedule{}),
+			ErrInvalidMintSchedule("neither tranches nor linear emission is provided"),
+		},
+		{
+			"case-unordered",
+			NewMsgSetMintSchedule("abc", testAddr, MintSchedule{Tranches: []MintTranche{tranche(200, 1), tranche(200, 2)}}),
+			ErrInvalidMintSchedule("tranche times must be positive and increasing"),
+		},
+		{
+			"case-invalidAmt",
+			NewMsgSetMintSchedule("abc", testAddr, MintSchedule{Tranches: []MintTranche{tranche(100, 0)}}),
+			ErrInvalidMintSchedule("invalid tranche amount 0"),
+		},
+		{
+			"case-invalidEmission",
+			NewMsgSetMintSchedule("abc", testAddr, MintSchedule{Emission: &LinearEmission{
+				Rate: sdk.NewInt(1), StartTime: 200, EndTime: 200, Recipient: testAddr}}),
+			ErrInvalidMintSchedule("emission must end after it starts"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MsgSetMintSchedule.ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgSetMintPolicy_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetMintPolicy
+		want sdk.Error
+	}{
+		{
+			"base-case",
+			NewMsgSetMintPolicy("abc", testAddr, NewMintPolicy(sdk.NewInt(10000), sdk.NewInt(100), 3600)),
+			nil,
+		},
+		{
+			"case-capOnly",
+			NewMsgSetMintPolicy("abc", testAddr, NewMintPolicy(sdk.NewInt(10000), sdk.ZeroInt(), 0)),
+			nil,
+		},
+		{
+			"case-invalidOwner",
+			NewMsgSetMintPolicy("abc", sdk.AccAddress{}, NewMintPolicy(sdk.NewInt(10000), sdk.ZeroInt(), 0)),
+			ErrNilTokenOwner(),
+		},
+		{
+			"case-noLimit",
+			NewMsgSetMintPolicy("abc", testAddr, NewMintPolicy(sdk.ZeroInt(), sdk.ZeroInt(), 0)),
+			ErrInvalidMintPolicy("either supply cap or window amount must be positive"),
+		},
+		{
+			"case-negativeCap",
+			NewMsgSetMintPolicy("abc", testAddr, NewMintPolicy(sdk.NewInt(-1), sdk.NewInt(100), 3600)),
+			ErrInvalidMintPolicy("invalid supply cap -1"),
+		},
+		{
+			"case-invalidWindow",
+			NewMsgSetMintPolicy("abc", testAddr, NewMintPolicy(sdk.ZeroInt(), sdk.NewInt(100), MaxMintWindowSeconds+1)),
+			ErrInvalidMintPolicy("window must be 1 ~ 31536000 seconds"),
+		},
+		{
+			"case-windowWithoutAmount",
+			NewMsgSetMintPolicy("abc", testAddr, NewMintPolicy(sdk.NewInt(10000), sdk.ZeroInt(), 3600)),
+			ErrInvalidMintPolicy("window seconds is set without window amount"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MsgSetMintPolicy.ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgTokenCoOwners_ValidateBasic(t *testing.T) {
+	addrs := []sdk.AccAddress{testAddr, sdk.AccAddress("other_addr")}
+	tests := []struct {
+		name string
+		msg  sdk.Msg
+		want sdk.Error
+	}{
+		{
+			"base-case-set",
+			NewMsgSetTokenCoOwners("abc", testAddr, addrs, 2),
+			nil,
+		},
+		{
+			"base-case-remove",
+			NewMsgSetTokenCoOwners("abc", testAddr, nil, 0),
+			nil,
+		},
+		{
+			"case-invalidThreshold",
+			NewMsgSetTokenCoOwners("abc", testAddr, addrs, 3),
+			ErrInvalidTokenCoOwners("threshold must be 1 ~ 2"),
+		},
+		{
+			"case-duplicated",
+			NewMsgSetTokenCoOwners("abc", testAddr, []sdk.AccAddress{testAddr, testAddr}, 1),
+			ErrInvalidTokenCoOwners("duplicated co-owner " + testAddr.String()),
+		},
+		{
+			"base-case-propose",
+			NewMsgProposeTokenAction(testAddr, NewMsgMintToken("abc", sdk.NewInt(1), testAddr), 3600),
+			nil,
+		},
+		{
+			"case-proposeIssue",
+			NewMsgProposeTokenAction(testAddr, NewMsgIssueToken("ABC Token", "abc", sdk.NewInt(1), testAddr,
+				false, false, false, false, "", "", TestIdentityString), 3600),
+			ErrInvalidTokenAction("action issue_token can not be proposed"),
+		},
+		{
+			"case-invalidLifetime",
+			NewMsgProposeTokenAction(testAddr, NewMsgMintToken("abc", sdk.NewInt(1), testAddr), MaxTokenActionLifetime+1),
+			ErrInvalidTokenAction("lifetime must be 1 ~ 2592000 seconds"),
+		},
+		{
+			"case-invalidAction",
+			NewMsgProposeTokenAction(testAddr, NewMsgMintToken("abc", sdk.NewInt(-1), testAddr), 3600),
+			ErrInvalidTokenMintAmt("-1"),
+		},
+		{
+			"base-case-approve",
+			NewMsgApproveTokenAction("abc", 1, testAddr),
+			nil,
+		},
+		{
+			"case-invalidApprover",
+			NewMsgApproveTokenAction("abc", 1, sdk.AccAddress{}),
+			ErrNilTokenOwner(),
+		},
+		{
+			"base-case-cancelTransfer",
+			NewMsgCancelOwnershipTransfer("abc", testAddr),
+			nil,
+		},
+		{
+			"base-case-propose-cancelTransfer",
+			NewMsgProposeTokenAction(testAddr, NewMsgCancelOwnershipTransfer("abc", testAddr), 3600),
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%s.ValidateBasic() = %v, want %v", tt.msg.Type(), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgAcceptOwnership_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAcceptOwnership
+		want sdk.Error
+	}{
+		{
+			"base-case",
+			NewMsgAcceptOwnership("abc", testAddr),
+			nil,
+		},
+		{
+			"case-invalidSymbol",
+			NewMsgAcceptOwnership("123", testAddr),
+			ErrInvalidTokenSymbol("123"),
+		},
+		{
+			"case-invalidOwner",
+			NewMsgAcceptOwnership("abc", sdk.AccAddress{}),
+			ErrNilTokenOwner(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MsgAcceptOwnership.ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestMsg_Route(t *testing.T) {
 	want := RouterKey
 	tests := []struct {
@@ -527,6 +937,10 @@ func TestMsg_Route(t *testing.T) {
 			"burn-token",
 			MsgBurnToken{},
 		},
+		{
+			"holder-burn-token",
+			MsgHolderBurnToken{},
+		},
 		{
 			"mint-token",
 			MsgMintToken{},
@@ -559,6 +973,34 @@ func TestMsg_Route(t *testing.T) {
 			"modify-token-info",
 			MsgModifyTokenInfo{},
 		},
+		{
+			"freeze-token-amount",
+			MsgFreezeTokenAmount{},
+		},
+		{
+			"unfreeze-token-amount",
+			MsgUnfreezeTokenAmount{},
+		},
+		{
+			"request-token-snapshot",
+			MsgRequestTokenSnapshot{},
+		},
+		{
+			"open-symbol-auction",
+			MsgOpenSymbolAuction{},
+		},
+		{
+			"bid-symbol-auction",
+			MsgBidSymbolAuction{},
+		},
+		{
+			"distribute-to-holders",
+			MsgDistributeToHolders{},
+		},
+		{
+			"set-mint-policy",
+			MsgSetMintPolicy{},
+		},
 	}
 
 	for _, tt := range tests {
@@ -591,6 +1033,11 @@ func TestMsg_Type(t *testing.T) {
 			MsgBurnToken{},
 			"burn_token",
 		},
+		{
+			"holder-burn-token",
+			MsgHolderBurnToken{},
+			"holder_burn_token",
+		},
 		{
 			"mint-token",
 			MsgMintToken{},
@@ -631,6 +1078,41 @@ func TestMsg_Type(t *testing.T) {
 			MsgModifyTokenInfo{},
 			"modify_token_info",
 		},
+		{
+			"freeze-token-amount",
+			MsgFreezeTokenAmount{},
+			"freeze_token_amount",
+		},
+		{
+			"unfreeze-token-amount",
+			MsgUnfreezeTokenAmount{},
+			"unfreeze_token_amount",
+		},
+		{
+			"request-token-snapshot",
+			MsgRequestTokenSnapshot{},
+			"request_token_snapshot",
+		},
+		{
+			"open-symbol-auction",
+			MsgOpenSymbolAuction{},
+			"open_symbol_auction",
+		},
+		{
+			"bid-symbol-auction",
+			MsgBidSymbolAuction{},
+			"bid_symbol_auction",
+		},
+		{
+			"distribute-to-holders",
+			MsgDistributeToHolders{},
+			"distribute_to_holders",
+		},
+		{
+			"set-mint-policy",
+			MsgSetMintPolicy{},
+			"set_mint_policy",
+		},
 	}
 
 	for _, tt := range tests {
@@ -664,6 +1146,11 @@ func TestMsg_GetSigners(t *testing.T) {
 			NewMsgBurnToken("abc", sdk.NewInt(10000), testAddr),
 			[]sdk.AccAddress{testAddr},
 		},
+		{
+			"holder-burn-token",
+			NewMsgHolderBurnToken("abc", sdk.NewInt(10000), testAddr, ""),
+			[]sdk.AccAddress{testAddr},
+		},
 		{
 			"mint-token",
 			NewMsgMintToken("abc", sdk.NewInt(10000), testAddr),
@@ -705,6 +1192,41 @@ func TestMsg_GetSigners(t *testing.T) {
 				"ABC token", "1000", "true", "true", "true", "true"),
 			[]sdk.AccAddress{testAddr},
 		},
+		{
+			"freeze-token-amount",
+			NewMsgFreezeTokenAmount("abc", testAddr, mockAddrList()[0], sdk.NewInt(100)),
+			[]sdk.AccAddress{testAddr},
+		},
+		{
+			"unfreeze-token-amount",
+			NewMsgUnfreezeTokenAmount("abc", testAddr, mockAddrList()[0], sdk.NewInt(100)),
+			[]sdk.AccAddress{testAddr},
+		},
+		{
+			"request-token-snapshot",
+			NewMsgRequestTokenSnapshot("abc", testAddr),
+			[]sdk.AccAddress{testAddr},
+		},
+		{
+			"open-symbol-auction",
+			NewMsgOpenSymbolAuction("abc", testAddr, sdk.NewInt(100)),
+			[]sdk.AccAddress{testAddr},
+		},
+		{
+			"bid-symbol-auction",
+			NewMsgBidSymbolAuction("abc", testAddr, sdk.NewInt(100)),
+			[]sdk.AccAddress{testAddr},
+		},
+		{
+			"distribute-to-holders",
+			NewMsgDistributeToHolders("abc", testAddr, "cet", sdk.NewInt(100), nil),
+			[]sdk.AccAddress{testAddr},
+		},
+		{
+			"set-mint-policy",
+			NewMsgSetMintPolicy("abc", testAddr, NewMintPolicy(sdk.NewInt(100), sdk.ZeroInt(), 0)),
+			[]sdk.AccAddress{testAddr},
+		},
 	}
 
 	for _, tt := range tests {
@@ -745,6 +1267,31 @@ func TestMsg_GetSignBytes(t *testing.T) {
 			NewMsgBurnToken("abc", sdk.NewInt(10000), owner),
 			`{"type":"asset/MsgBurnToken","value":{"amount":"10000","owner_address":"coinex15fvnexrvsm9ryw3nn4mcrnqyhvhazkkrd4aqvd","symbol":"abc"}}`,
 		},
+		{
+			"holder-burn-token",
+			NewMsgHolderBurnToken("abc", sdk.NewInt(10000), addr, "memo"),
+			`{"type":"asset/MsgHolderBurnToken","value":{"amount":"10000","holder_address":"coinex1e9kx6klg6z9p9ea4ehqmypl6dvjrp96vfxecd5","memo":"memo","symbol":"abc"}}`,
+		},
+		{
+			"freeze-token-amount",
+			NewMsgFreezeTokenAmount("abc", owner, addr, sdk.NewInt(100)),
+			`{"type":"asset/MsgFreezeTokenAmount","value":{"address":"coinex1e9kx6klg6z9p9ea4ehqmypl6dvjrp96vfxecd5","amount":"100","owner_address":"coinex15fvnexrvsm9ryw3nn4mcrnqyhvhazkkrd4aqvd","symbol":"abc"}}`,
+		},
+		{
+			"request-token-snapshot",
+			NewMsgRequestTokenSnapshot("abc", addr),
+			`{"type":"asset/MsgRequestTokenSnapshot","value":{"requester":"coinex1e9kx6klg6z9p9ea4ehqmypl6dvjrp96vfxecd5","symbol":"abc"}}`,
+		},
+		{
+			"open-symbol-auction",
+			NewMsgOpenSymbolAuction("abc", addr, sdk.NewInt(100)),
+			`{"type":"asset/MsgOpenSymbolAuction","value":{"amount":"100","bidder":"coinex1e9kx6klg6z9p9ea4ehqmypl6dvjrp96vfxecd5","symbol":"abc"}}`,
+		},
+		{
+			"distribute-to-holders",
+			NewMsgDistributeToHolders("abc", owner, "cet", sdk.NewInt(100), nil),
+			`{"type":"asset/MsgDistributeToHolders","value":{"amount":"100","denom":"cet","owner_address":"coinex15fvnexrvsm9ryw3nn4mcrnqyhvhazkkrd4aqvd","symbol":"abc"}}`,
+		},
 		{
 			"mint-token",
 			NewMsgMintToken("abc", sdk.NewInt(10000), owner),
@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// the nominated owner must accept the ownership within 7 days
+const OwnershipTransferTimeout = 7 * 24 * 3600
+
+// PendingOwnershipTransfer is an ownership transfer which waits for the acceptance of NewOwner
+// until Deadline (unix seconds)
+type PendingOwnershipTransfer struct {
+	Symbol        string         `json:"symbol" yaml:"symbol"`
+	OriginalOwner sdk.AccAddress `json:"original_owner" yaml:"original_owner"`
+	NewOwner      sdk.AccAddress `json:"new_owner" yaml:"new_owner"`
+	Deadline      int64          `json:"deadline" yaml:"deadline"`
+}
+
+func NewPendingOwnershipTransfer(symbol string, originalOwner, newOwner sdk.AccAddress, deadline int64) PendingOwnershipTransfer {
+	return PendingOwnershipTransfer{
+		Symbol:        symbol,
+		OriginalOwner: originalOwner,
+		NewOwner:      newOwner,
+		Deadline:      deadline,
+	}
+}
+
+func (t PendingOwnershipTransfer) Validate() sdk.Error {
+	if err := ValidateTokenSymbol(t.Symbol); err != nil {
+		return err
+	}
+	if t.OriginalOwner.Empty() || t.NewOwner.Empty() {
+		return ErrNilTokenOwner()
+	}
+	if t.OriginalOwner.Equals(t.NewOwner) {
+		return ErrTransferSelfTokenOwner()
+	}
+	return nil
+}
@@ -17,6 +17,8 @@ const (
 	DefaultIssue5CharTokenFee = 200e8   //   200 * 10^8
 	DefaultIssue6CharTokenFee = 100e8   //   100 * 10^8
 	DefaultIssueLongTokenFee  = 50e8    //    50 * 10^8
+
+	DefaultSnapshotFee = 10e8 // 10 * 10^8
 )
 
 // Parameter keys
@@ -27,6 +29,7 @@ var (
 	KeyIssue4CharTokenFee = []byte("Issue4CharTokenFee") // DEX2
 	KeyIssue5CharTokenFee = []byte("Issue5CharTokenFee") // DEX2
 	KeyIssue6CharTokenFee = []byte("Issue6CharTokenFee") // DEX2
+	KeySnapshotFee        = []byte("SnapshotFee")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -40,6 +43,8 @@ type Params struct {
 	Issue4CharTokenFee int64 `json:"issue_4char_token_fee" yaml:"issue_4char_token_fee"` // 4 char
 	Issue5CharTokenFee int64 `json:"issue_5char_token_fee" yaml:"issue_5char_token_fee"` // 5 char
 	Issue6CharTokenFee int64 `json:"issue_6char_token_fee" yaml:"issue_6char_token_fee"` // 6 char
+	// SnapshotFee is charged when a token snapshot is requested, including the one requested by a distribution
+	SnapshotFee int64 `json:"snapshot_fee" yaml:"snapshot_fee"`
 }
 
 // DefaultParams returns a default set of parameters.
@@ -51,6 +56,7 @@ func DefaultParams() Params {
 		Issue4CharTokenFee: DefaultIssue4CharTokenFee,
 		Issue5CharTokenFee: DefaultIssue5CharTokenFee,
 		Issue6CharTokenFee: DefaultIssue6CharTokenFee,
+		SnapshotFee:        DefaultSnapshotFee,
 	}
 }
 
@@ -64,6 +70,7 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyIssue4CharTokenFee, Value: &p.Issue4CharTokenFee},
 		{Key: KeyIssue5CharTokenFee, Value: &p.Issue5CharTokenFee},
 		{Key: KeyIssue6CharTokenFee, Value: &p.Issue6CharTokenFee},
+		{Key: KeySnapshotFee, Value: &p.SnapshotFee},
 	}
 }
 
@@ -108,12 +115,14 @@ func (p Params) String() string {
   Issue3CharTokenFee: %d
   Issue4CharTokenFee: %d
   Issue5CharTokenFee: %d
-  Issue6CharTokenFee: %d`,
+  Issue6CharTokenFee: %d
+  SnapshotFee:        %d`,
 		p.IssueTokenFee,
 		p.IssueRareTokenFee,
 		p.Issue3CharTokenFee,
 		p.Issue4CharTokenFee,
 		p.Issue5CharTokenFee,
 		p.Issue6CharTokenFee,
+		p.SnapshotFee,
 	)
 }
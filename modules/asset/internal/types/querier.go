@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // query endpoints supported by the asset Querier
 const (
 	QueryToken           = "token-info"
@@ -8,6 +12,30 @@ const (
 	QueryForbiddenAddr   = "addr-forbidden"
 	QueryReservedSymbols = "reserved-symbols"
 	QueryParameters      = "parameters"
+	QueryMintSchedule    = "mint-schedule"
+	QueryCoOwners        = "token-co-owners"
+	QueryProposals       = "token-proposals"
+	QueryPendingOwner    = "pending-owner"
+	QueryPendingOwners   = "pending-owners"
+	QueryFrozenAmounts   = "frozen-amounts"
+	QueryAddrFrozen      = "addr-frozen-amounts"
+	QuerySnapshot        = "token-snapshot"
+	QuerySnapshots       = "token-snapshots"
+	QuerySnapshotBalance = "snapshot-balances"
+
+	QuerySymbolAuction     = "symbol-auction"
+	QuerySymbolAuctions    = "symbol-auctions"
+	QuerySymbolReservation = "symbol-reservation"
+
+	QueryDistribution  = "token-distribution"
+	QueryDistributions = "token-distributions"
+
+	QueryTokenMetadata = "token-metadata"
+	QueryMintPolicy    = "mint-policy"
+
+	QueryHolderCount = "holder-count"
+	QueryTopHolders  = "top-holders"
+	QueryHolderRank  = "holder-rank"
 )
 
 // QueryTokenParams defines the params for query: "custom/asset/token-info"
@@ -42,3 +70,81 @@ func NewQueryForbiddenAddrParams(s string) QueryForbiddenAddrParams {
 		Symbol: s,
 	}
 }
+
+// QueryAddrFrozenParams defines the params for query: "custom/asset/addr-frozen-amounts"
+type QueryAddrFrozenParams struct {
+	Address sdk.AccAddress
+}
+
+func NewQueryAddrFrozenParams(addr sdk.AccAddress) QueryAddrFrozenParams {
+	return QueryAddrFrozenParams{
+		Address: addr,
+	}
+}
+
+// QuerySnapshotParams defines the params for query: "custom/asset/token-snapshot"
+type QuerySnapshotParams struct {
+	ID uint64
+}
+
+func NewQuerySnapshotParams(id uint64) QuerySnapshotParams {
+	return QuerySnapshotParams{
+		ID: id,
+	}
+}
+
+// QuerySnapshotBalancesParams defines the params for query: "custom/asset/snapshot-balances",
+// Page starts from 1, and a zero Limit means DefaultSnapshotLimit
+type QuerySnapshotBalancesParams struct {
+	ID    uint64
+	Page  int
+	Limit int
+}
+
+func NewQuerySnapshotBalancesParams(id uint64, page, limit int) QuerySnapshotBalancesParams {
+	return QuerySnapshotBalancesParams{
+		ID:    id,
+		Page:  page,
+		Limit: limit,
+	}
+}
+
+// QueryDistributionParams defines the params for query: "custom/asset/token-distribution"
+type QueryDistributionParams struct {
+	ID uint64
+}
+
+func NewQueryDistributionParams(id uint64) QueryDistributionParams {
+	return QueryDistributionParams{
+		ID: id,
+	}
+}
+
+// QueryTopHoldersParams defines the params for query: "custom/asset/top-holders",
+// Page starts from 1, and a zero Limit means DefaultHolderLimit
+type QueryTopHoldersParams struct {
+	Symbol string
+	Page   int
+	Limit  int
+}
+
+func NewQueryTopHoldersParams(symbol string, page, limit int) QueryTopHoldersParams {
+	return QueryTopHoldersParams{
+		Symbol: symbol,
+		Page:   page,
+		Limit:  limit,
+	}
+}
+
+// QueryHolderRankParams defines the params for query: "custom/asset/holder-rank"
+type QueryHolderRankParams struct {
+	Symbol  string
+	Address sdk.AccAddress
+}
+
+func NewQueryHolderRankParams(symbol string, addr sdk.AccAddress) QueryHolderRankParams {
+	return QueryHolderRankParams{
+		Symbol:  symbol,
+		Address: addr,
+	}
+}
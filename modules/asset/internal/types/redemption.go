@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// the memo of a redemption usually carries the address which the redeemed asset should be paid to
+const MaxRedemptionMemoLength = 256
+
+// TokenRedemption is the record of a holder burn, which is sent to the issuer's off-chain processor
+type TokenRedemption struct {
+	Symbol string         `json:"symbol" yaml:"symbol"`
+	Holder sdk.AccAddress `json:"holder" yaml:"holder"`
+	Owner  sdk.AccAddress `json:"owner" yaml:"owner"`
+	Amount sdk.Int        `json:"amount" yaml:"amount"`
+	Memo   string         `json:"memo" yaml:"memo"`
+	Height int64          `json:"height" yaml:"height"`
+}
+
+func NewTokenRedemption(symbol string, holder, owner sdk.AccAddress, amount sdk.Int, memo string, height int64) TokenRedemption {
+	return TokenRedemption{
+		Symbol: symbol,
+		Holder: holder,
+		Owner:  owner,
+		Amount: amount,
+		Memo:   memo,
+		Height: height,
+	}
+}
+
+func ValidateRedemptionMemo(memo string) sdk.Error {
+	if len(memo) > MaxRedemptionMemoLength {
+		return ErrInvalidRedemptionMemo(memo)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultSnapshotLimit = 100
+	// the holder balances recorded by all the snapshots taken in one block, unless the earliest pending
+	// snapshot alone has more holders
+	SnapshotEntriesPerBlock = 10000
+	// a snapshot and its balances are removed this many blocks after it is taken, unless a pending
+	// distribution still uses it
+	SnapshotRetentionBlocks = 100000
+)
+
+// TokenSnapshot records the balances of all the holders of a token at a block height,
+// it is requested by a transaction and taken at an EndBlock from the holder index
+type TokenSnapshot struct {
+	ID            uint64         `json:"id" yaml:"id"`
+	Symbol        string         `json:"symbol" yaml:"symbol"`
+	Requester     sdk.AccAddress `json:"requester" yaml:"requester"`
+	RequestHeight int64          `json:"request_height" yaml:"request_height"`
+	// Height is zero until the snapshot is taken
+	Height  int64  `json:"height" yaml:"height"`
+	Holders uint64 `json:"holders" yaml:"holders"`
+}
+
+func NewTokenSnapshot(id uint64, symbol string, requester sdk.AccAddress, requestHeight int64) TokenSnapshot {
+	return TokenSnapshot{
+		ID:            id,
+		Symbol:        symbol,
+		Requester:     requester,
+		RequestHeight: requestHeight,
+	}
+}
+
+func (s TokenSnapshot) Taken() bool {
+	return s.Height != 0
+}
+
+func (s TokenSnapshot) Validate() error {
+	if s.ID == 0 {
+		return errors.New("snapshot id must be positive")
+	}
+	if err := ValidateTokenSymbol(s.Symbol); err != nil {
+		return err
+	}
+	if s.Requester.Empty() {
+		return errors.New("snapshot requester is empty")
+	}
+	if s.Height != 0 && s.Height < s.RequestHeight {
+		return errors.New("snapshot is taken before it is requested")
+	}
+	if s.Height == 0 && s.Holders != 0 {
+		return errors.New("snapshot which is not taken has holders")
+	}
+	return nil
+}
+
+// SnapshotBalance is the balance of a holder recorded in a snapshot, including its locked and frozen coins
+type SnapshotBalance struct {
+	SnapshotID uint64         `json:"snapshot_id" yaml:"snapshot_id"`
+	Address    sdk.AccAddress `json:"address" yaml:"address"`
+	Amount     sdk.Int        `json:"amount" yaml:"amount"`
+}
+
+func NewSnapshotBalance(id uint64, addr sdk.AccAddress, amount sdk.Int) SnapshotBalance {
+	return SnapshotBalance{
+		SnapshotID: id,
+		Address:    addr,
+		Amount:     amount,
+	}
+}
+
+func (b SnapshotBalance) Validate() error {
+	if b.Address.Empty() {
+		return errors.New("snapshot balance address is empty")
+	}
+	if b.Amount == (sdk.Int{}) || !b.Amount.IsPositive() {
+		return errors.New("snapshot balance must be positive")
+	}
+	return nil
+}
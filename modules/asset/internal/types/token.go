@@ -67,6 +67,24 @@ type Token interface {
 	GetIdentity() string
 	SetIdentity(string) sdk.Error
 
+	GetMintScheduled() bool
+	SetMintScheduled(bool)
+
+	GetScheduledSupply() sdk.Int
+	SetScheduledSupply(sdk.Int) sdk.Error
+
+	GetHolderBurnable() bool
+	SetHolderBurnable(bool)
+
+	GetPermissioned() bool
+	SetPermissioned(bool)
+
+	GetMetadata() *TokenMetadata
+	SetMetadata(TokenMetadata) sdk.Error
+
+	GetMintPolicy() *MintPolicy
+	SetMintPolicy(MintPolicy) sdk.Error
+
 	Validate() sdk.Error
 	// Ensure that token implements stringer
 	String() string
@@ -92,6 +110,14 @@ type BaseToken struct {
 	URL              string         `json:"url" yaml:"url"`                             //URL of token website
 	Description      string         `json:"description" yaml:"description"`             //Description of token info
 	Identity         string         `json:"identity" yaml:"identity"`                   //Identity of token
+	MintScheduled    bool           `json:"mint_scheduled" yaml:"mint_scheduled"`       // Whether token can only be minted by its mint schedule
+	ScheduledSupply  sdk.Int        `json:"scheduled_supply" yaml:"scheduled_supply"`   // The amount which has not been minted by the mint schedule
+	HolderBurnable   bool           `json:"holder_burnable" yaml:"holder_burnable"`     // Whether any holder could burn its own balance for redemption
+	Permissioned     bool           `json:"permissioned" yaml:"permissioned"`           // Whether only the owner and the whitelisted addresses could hold the token
+	// How wallets and explorers should display the token, nil if the owner has not provided it
+	Metadata *TokenMetadata `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	// How much the owner could mint, nil if the minting is only bounded by MaxTokenAmount
+	MintPolicy *MintPolicy `json:"mint_policy,omitempty" yaml:"mint_policy,omitempty"`
 }
 
 //nolint
@@ -143,6 +169,9 @@ func NewToken(name string, symbol string, totalSupply sdk.Int, owner sdk.AccAddr
 	if err = t.SetSendLock(sdk.ZeroInt()); err != nil {
 		return nil, err
 	}
+	if err = t.SetScheduledSupply(sdk.ZeroInt()); err != nil {
+		return nil, err
+	}
 	t.SetIsForbidden(false)
 
 	return t, nil
@@ -180,6 +209,36 @@ func (t *BaseToken) Validate() sdk.Error {
 		return ErrInvalidSendLockAmt(t.SendLock.String())
 	}
 
+	if !t.Mintable && t.MintScheduled {
+		return ErrTokenMintNotSupported(t.Symbol)
+	}
+
+	if t.GetScheduledSupply().IsNegative() {
+		return ErrInvalidTokenMintAmt(t.ScheduledSupply.String())
+	}
+
+	if !t.Burnable && t.HolderBurnable {
+		return ErrTokenBurnNotSupported(t.Symbol)
+	}
+
+	if t.Metadata != nil {
+		if t.Metadata.IsEmpty() {
+			return ErrInvalidTokenMetadata("empty metadata")
+		}
+		if err := t.Metadata.Validate(t.Symbol); err != nil {
+			return err
+		}
+	}
+
+	if t.MintPolicy != nil {
+		if err := t.MintPolicy.Validate(); err != nil {
+			return err
+		}
+		if t.MintPolicy.HasSupplyCap() && t.TotalSupply.GT(t.MintPolicy.SupplyCap) {
+			return ErrMintCapExceeded(t.Symbol, t.MintPolicy.SupplyCap)
+		}
+	}
+
 	return nil
 }
 
@@ -360,6 +419,75 @@ func (t *BaseToken) SetIsForbidden(enable bool) {
 	t.IsForbidden = enable
 }
 
+func (t BaseToken) GetMintScheduled() bool {
+	return t.MintScheduled
+}
+
+func (t *BaseToken) SetMintScheduled(enable bool) {
+	t.MintScheduled = enable
+}
+
+func (t BaseToken) GetScheduledSupply() sdk.Int {
+	// tokens saved before mint schedules were introduced have no ScheduledSupply
+	if t.ScheduledSupply == (sdk.Int{}) {
+		return sdk.ZeroInt()
+	}
+	return t.ScheduledSupply
+}
+
+func (t *BaseToken) SetScheduledSupply(amt sdk.Int) sdk.Error {
+	if amt.IsNegative() {
+		return ErrInvalidTokenMintAmt(amt.String())
+	}
+	t.ScheduledSupply = amt
+	return nil
+}
+
+func (t BaseToken) GetHolderBurnable() bool {
+	return t.HolderBurnable
+}
+
+func (t *BaseToken) SetHolderBurnable(enable bool) {
+	t.HolderBurnable = enable
+}
+
+func (t BaseToken) GetPermissioned() bool {
+	return t.Permissioned
+}
+
+func (t *BaseToken) SetPermissioned(enable bool) {
+	t.Permissioned = enable
+}
+
+func (t BaseToken) GetMetadata() *TokenMetadata {
+	return t.Metadata
+}
+
+// SetMetadata replaces the metadata of the token, and an empty metadata clears it
+func (t *BaseToken) SetMetadata(metadata TokenMetadata) sdk.Error {
+	if metadata.IsEmpty() {
+		t.Metadata = nil
+		return nil
+	}
+	if err := metadata.Validate(t.Symbol); err != nil {
+		return err
+	}
+	t.Metadata = &metadata
+	return nil
+}
+
+func (t BaseToken) GetMintPolicy() *MintPolicy {
+	return t.MintPolicy
+}
+
+func (t *BaseToken) SetMintPolicy(policy MintPolicy) sdk.Error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	t.MintPolicy = &policy
+	return nil
+}
+
 func (t BaseToken) String() string {
 	return fmt.Sprintf(`Token Info: 
 [
@@ -378,13 +506,35 @@ func (t BaseToken) String() string {
   URL:              %s
   Description:      %s
   Identity:			%s
+  MintScheduled:    %t
+  ScheduledSupply:  %s
+  HolderBurnable:   %t
+  Permissioned:     %t
+  Metadata:         %s
+  MintPolicy:       %s
 ]`,
 		t.Name, t.Symbol, t.TotalSupply.String(), t.SendLock.String(), t.Owner.String(), t.Mintable, t.Burnable,
 		t.AddrForbiddable, t.TokenForbiddable, t.TotalBurn.String(), t.TotalMint.String(), t.IsForbidden,
-		t.URL, t.Description, t.Identity,
+		t.URL, t.Description, t.Identity, t.MintScheduled, t.GetScheduledSupply().String(), t.HolderBurnable,
+		t.Permissioned, t.metadataString(), t.mintPolicyString(),
 	)
 }
 
+func (t BaseToken) metadataString() string {
+	if t.Metadata == nil {
+		return ""
+	}
+	return t.Metadata.String()
+}
+
+func (t BaseToken) mintPolicyString() string {
+	if t.MintPolicy == nil {
+		return ""
+	}
+	p := t.MintPolicy
+	return fmt.Sprintf("cap %s, %s per %d seconds", p.SupplyCap.String(), p.WindowAmount.String(), p.WindowSeconds)
+}
+
 func MustUnmarshalToken(cdc *codec.Codec, value []byte) Token {
 	validator, err := UnmarshalToken(cdc, value)
 	if err != nil {
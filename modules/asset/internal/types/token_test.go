@@ -38,6 +38,12 @@ func TestBaseToken_Validate(t *testing.T) {
 				"",
 				"",
 				TestIdentityString,
+				false,
+				sdk.ZeroInt(),
+				false,
+				false,
+				nil,
+				nil,
 			},
 			nil,
 		},
@@ -59,6 +65,12 @@ func TestBaseToken_Validate(t *testing.T) {
 				"",
 				"",
 				TestIdentityString,
+				false,
+				sdk.ZeroInt(),
+				false,
+				false,
+				nil,
+				nil,
 			},
 			ErrTokenMintNotSupported("abc"),
 		},
@@ -80,6 +92,12 @@ func TestBaseToken_Validate(t *testing.T) {
 				"",
 				"",
 				TestIdentityString,
+				false,
+				sdk.ZeroInt(),
+				false,
+				false,
+				nil,
+				nil,
 			},
 			ErrTokenBurnNotSupported("abc"),
 		},
@@ -101,9 +119,69 @@ func TestBaseToken_Validate(t *testing.T) {
 				"",
 				"",
 				TestIdentityString,
+				false,
+				sdk.ZeroInt(),
+				false,
+				false,
+				nil,
+				nil,
 			},
 			ErrTokenForbiddenNotSupported("abc"),
 		},
+		{
+			"case-invalid-metadata",
+			&BaseToken{
+				"ABC Token",
+				"abc",
+				sdk.NewInt(2100),
+				sdk.ZeroInt(),
+				testAddr,
+				false,
+				false,
+				false,
+				false,
+				sdk.ZeroInt(),
+				sdk.ZeroInt(),
+				false,
+				"",
+				"",
+				TestIdentityString,
+				false,
+				sdk.ZeroInt(),
+				false,
+				false,
+				&TokenMetadata{Display: "ABC", Decimals: 8, DenomUnits: []DenomUnit{{"abc", 0, nil}, {"ABC", 6, nil}}},
+				nil,
+			},
+			ErrInvalidTokenMetadata("the exponent of display denomination ABC must be 8"),
+		},
+		{
+			"case-supply-over-cap",
+			&BaseToken{
+				"ABC Token",
+				"abc",
+				sdk.NewInt(2100),
+				sdk.ZeroInt(),
+				testAddr,
+				true,
+				false,
+				false,
+				false,
+				sdk.ZeroInt(),
+				sdk.ZeroInt(),
+				false,
+				"",
+				"",
+				TestIdentityString,
+				false,
+				sdk.ZeroInt(),
+				false,
+				false,
+				nil,
+				&MintPolicy{SupplyCap: sdk.NewInt(2000), WindowAmount: sdk.ZeroInt()},
+			},
+			ErrMintCapExceeded("abc", sdk.NewInt(2000)),
+		},
 	}
 
 	for _, tt := range tests {
@@ -114,3 +192,37 @@ func TestBaseToken_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenMetadata_Validate(t *testing.T) {
+	units := []DenomUnit{{"abc", 0, nil}, {"mABC", 5, []string{"milliABC"}}, {"ABC", 8, nil}}
+	logoHash := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	tests := []struct {
+		name     string
+		metadata TokenMetadata
+		wantErr  bool
+	}{
+		{"empty", TokenMetadata{}, false},
+		{"display-only", TokenMetadata{Display: "ABC", Decimals: 8}, false},
+		{"full", TokenMetadata{"ABC", 8, units, logoHash, []SocialLink{{"twitter", "https://twitter.com/abc"}}}, false},
+		{"too-many-decimals", TokenMetadata{Display: "ABC", Decimals: MaxTokenDecimals + 1}, true},
+		{"invalid-display", TokenMetadata{Display: "1ABC", Decimals: 8}, true},
+		{"missing-base-unit", TokenMetadata{Display: "ABC", Decimals: 8, DenomUnits: units[1:]}, true},
+		{"display-not-in-units", TokenMetadata{Display: "kABC", Decimals: 8, DenomUnits: units}, true},
+		{"decreasing-exponents", TokenMetadata{Display: "ABC", Decimals: 8,
+			DenomUnits: []DenomUnit{units[0], units[2], units[1]}}, true},
+		{"duplicated-alias", TokenMetadata{Display: "ABC", Decimals: 8,
+			DenomUnits: []DenomUnit{units[0], {"mABC", 5, []string{"ABC"}}, units[2]}}, true},
+		{"short-logo-hash", TokenMetadata{Display: "ABC", Decimals: 8, LogoHash: logoHash[1:]}, true},
+		{"non-hex-logo-hash", TokenMetadata{Display: "ABC", Decimals: 8, LogoHash: "x" + logoHash[1:]}, true},
+		{"empty-social-url", TokenMetadata{Display: "ABC", Decimals: 8, SocialLinks: []SocialLink{{"twitter", ""}}}, true},
+		{"duplicated-social-link", TokenMetadata{Display: "ABC", Decimals: 8,
+			SocialLinks: []SocialLink{{"twitter", "a.com"}, {"twitter", "b.com"}}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.metadata.Validate("abc")
+			require.Equal(t, tt.wantErr, err != nil, "%v", err)
+		})
+	}
+}
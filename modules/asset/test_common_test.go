@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/tendermint/tendermint/crypto"
@@ -40,6 +41,8 @@ func createTestInput() testInput {
 	notBondedPool := supply.NewEmptyModuleAccount(staking.NotBondedPoolName, supply.Burner, supply.Staking)
 	_ = notBondedPool.SetCoins(initSupply)
 	app.SupplyKeeper.SetModuleAccount(ctx, notBondedPool)
+	// the winning bids of symbol auctions are donated to the community pool
+	app.DistrKeeper.SetFeePool(ctx, distribution.InitialFeePool())
 
 	return testInput{app.Cdc, ctx, app.AssetKeeper}
 }
@@ -17,6 +17,10 @@ const (
 
 	DefaultParamspace       = types.DefaultParamspace
 	DefaultMinGasPriceLimit = types.DefaultMinGasPriceLimit
+
+	HashLockLength     = types.HashLockLength
+	MaxSecretLength    = types.MaxSecretLength
+	MaxVestingTranches = types.MaxVestingTranches
 )
 
 var (
@@ -31,12 +35,21 @@ var (
 	ModuleCdc                  = types.ModuleCdc
 	NewAccountXWithAddress     = types.NewAccountXWithAddress
 	NewKeeper                  = keepers.NewKeeper
+	NewHashLockedCoin          = types.NewHashLockedCoin
+	NewHTLC                    = types.NewHTLC
+	GetHashLock                = types.GetHashLock
+	NewStreamLockedCoin        = types.NewStreamLockedCoin
+	NewStream                  = types.NewStream
+	NewVestingSchedule         = types.NewVestingSchedule
 )
 
 type (
 	AccountX              = types.AccountX
 	LockedCoin            = types.LockedCoin
 	LockedCoins           = types.LockedCoins
+	HTLC                  = types.HTLC
+	Stream                = types.Stream
+	VestingSchedule       = types.VestingSchedule
 	MsgSetReferee         = types.MsgSetReferee
 	AccountXKeeper        = keepers.AccountXKeeper
 	ExpectedAccountKeeper = keepers.ExpectedAccountKeeper
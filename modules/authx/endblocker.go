@@ -37,7 +37,8 @@ func withdrawUnlockedCoins(accx *AccountX, time int64, ctx sdk.Context, kx Accou
 	var unlocked = sdk.Coins{}
 	var stillLocked LockedCoins
 	for _, c := range accx.LockedCoins {
-		if c.UnlockTime <= time {
+		// the hash-locked and streamed coins are only released by the messages of the HTLCs and streams
+		if c.UnlockTime <= time && !c.IsHashLocked() && !c.IsStreamed() {
 			unlocked = unlocked.Add(sdk.Coins{c.Coin})
 		} else {
 			stillLocked = append(stillLocked, c)
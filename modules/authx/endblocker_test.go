@@ -61,3 +61,29 @@ func TestEndBlocker(t *testing.T) {
 	acc2 = input.ak.GetAccount(input.ctx, addr2)
 	require.Equal(t, int64(20), acc2.GetCoins().AmountOf("cet").Int64())
 }
+
+func TestEndBlockerSkipHashLockedAndStreamedCoins(t *testing.T) {
+	input := setupTestInput()
+	now := input.ctx.BlockHeader().Time.Unix()
+
+	addr := sdk.AccAddress("addr")
+	accX := authx.AccountX{Address: addr}
+	accX.LockedCoins = authx.LockedCoins{
+		authx.NewLockedCoin("cet", sdk.NewInt(1), now-1),
+		authx.NewHashLockedCoin("cet", sdk.NewInt(2), now-1, authx.GetHashLock([]byte("secret"))),
+		authx.NewStreamLockedCoin("cet", sdk.NewInt(3), now-1, 1),
+	}
+	input.axk.SetAccountX(input.ctx, accX)
+	acc := input.ak.NewAccountWithAddress(input.ctx, addr)
+	input.ak.SetAccount(input.ctx, acc)
+
+	input.axk.InsertUnlockedCoinsQueue(input.ctx, now-1, addr)
+	authx.EndBlocker(input.ctx, input.axk, input.ak, input.tk)
+
+	acc = input.ak.GetAccount(input.ctx, addr)
+	require.Equal(t, int64(1), acc.GetCoins().AmountOf("cet").Int64())
+	accX, _ = input.axk.GetAccountX(input.ctx, addr)
+	require.Equal(t, 2, len(accX.LockedCoins))
+	require.True(t, accX.LockedCoins[0].IsHashLocked())
+	require.True(t, accX.LockedCoins[1].IsStreamed())
+}
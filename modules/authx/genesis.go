@@ -11,18 +11,26 @@ import (
 type GenesisState struct {
 	Params    types.Params    `json:"params"`
 	AccountXs types.AccountXs `json:"accountxs"`
+	HTLCs     []types.HTLC    `json:"htlcs"`
+	Streams   []types.Stream  `json:"streams"`
+	// NextStreamID is the id assigned to the next stream, which is never reused
+	NextStreamID uint64 `json:"next_stream_id"`
 }
 
-func NewGenesisState(params types.Params, accountXs types.AccountXs) GenesisState {
+func NewGenesisState(params types.Params, accountXs types.AccountXs, htlcs []types.HTLC,
+	streams []types.Stream, nextStreamID uint64) GenesisState {
 	return GenesisState{
-		Params:    params,
-		AccountXs: accountXs,
+		Params:       params,
+		AccountXs:    accountXs,
+		HTLCs:        htlcs,
+		Streams:      streams,
+		NextStreamID: nextStreamID,
 	}
 }
 
 // DefaultGenesisState - Return a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(types.DefaultParams(), types.AccountXs{})
+	return NewGenesisState(types.DefaultParams(), types.AccountXs{}, []types.HTLC{}, []types.Stream{}, 1)
 }
 
 // InitGenesis - Init store state from genesis data
@@ -35,6 +43,17 @@ func InitGenesis(ctx sdk.Context, keeper AccountXKeeper, data GenesisState) {
 			accx.Referee, accx.RefereeChangeTime)
 		keeper.SetAccountX(ctx, accountX)
 	}
+
+	// the amounts of the HTLCs and streams are kept in the locked coins of the imported accounts
+	for _, htlc := range data.HTLCs {
+		keeper.SetHTLC(ctx, htlc)
+	}
+	for _, stream := range data.Streams {
+		keeper.SetStream(ctx, stream)
+	}
+	if data.NextStreamID > 0 {
+		keeper.SetNextStreamID(ctx, data.NextStreamID)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
@@ -46,7 +65,19 @@ func ExportGenesis(ctx sdk.Context, keeper AccountXKeeper) GenesisState {
 		return false
 	})
 
-	return NewGenesisState(keeper.GetParams(ctx), accountXs)
+	var htlcs []types.HTLC
+	keeper.IterateHTLCs(ctx, func(htlc types.HTLC) (stop bool) {
+		htlcs = append(htlcs, htlc)
+		return false
+	})
+
+	var streams []types.Stream
+	keeper.IterateStreams(ctx, func(stream types.Stream) (stop bool) {
+		streams = append(streams, stream)
+		return false
+	})
+
+	return NewGenesisState(keeper.GetParams(ctx), accountXs, htlcs, streams, keeper.GetNextStreamID(ctx))
 }
 
 // ValidateGenesis performs basic validation of asset genesis data returning an
@@ -70,5 +101,31 @@ func (data GenesisState) ValidateGenesis() error {
 		addrMap[addrStr] = true
 	}
 
+	htlcMap := make(map[string]bool, len(data.HTLCs))
+	for _, htlc := range data.HTLCs {
+		if err := htlc.Validate(); err != nil {
+			return err
+		}
+		id := htlc.Sender.String() + "/" + htlc.HashLock.String()
+		if htlcMap[id] {
+			return fmt.Errorf("duplicate htlc found in genesis state; sender: %s, hash lock: %s", htlc.Sender, htlc.HashLock)
+		}
+		htlcMap[id] = true
+	}
+
+	streamIDMap := make(map[uint64]bool, len(data.Streams))
+	for _, stream := range data.Streams {
+		if err := stream.Validate(); err != nil {
+			return err
+		}
+		if streamIDMap[stream.ID] {
+			return fmt.Errorf("duplicate stream found in genesis state; id: %d", stream.ID)
+		}
+		if data.NextStreamID > 0 && stream.ID >= data.NextStreamID {
+			return fmt.Errorf("stream id %d is not less than the next stream id %d", stream.ID, data.NextStreamID)
+		}
+		streamIDMap[stream.ID] = true
+	}
+
 	return nil
 }
@@ -17,31 +17,64 @@ func TestValidate(t *testing.T) {
 	genState := authx.DefaultGenesisState()
 	require.Nil(t, genState.ValidateGenesis())
 
-	genState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 1000), []authx.AccountX{authx.NewAccountXWithAddress(addr1), authx.NewAccountXWithAddress(addr2)})
+	genState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 1000), []authx.AccountX{authx.NewAccountXWithAddress(addr1), authx.NewAccountXWithAddress(addr2)}, nil, nil, 0)
 	require.Nil(t, genState.ValidateGenesis())
 
-	errGenState := authx.NewGenesisState(authx.NewParams(sdk.NewDec(-1), 24*60*60*1000, 1000), []authx.AccountX{})
+	errGenState := authx.NewGenesisState(authx.NewParams(sdk.NewDec(-1), 24*60*60*1000, 1000), []authx.AccountX{}, nil, nil, 0)
 	require.NotNil(t, errGenState.ValidateGenesis())
 
-	errGenState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 1000), []authx.AccountX{authx.NewAccountXWithAddress(sdk.AccAddress{})})
+	errGenState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 1000), []authx.AccountX{authx.NewAccountXWithAddress(sdk.AccAddress{})}, nil, nil, 0)
 	require.NotNil(t, errGenState.ValidateGenesis())
 
-	errGenState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 1000), []authx.AccountX{authx.NewAccountXWithAddress(addr1), authx.NewAccountXWithAddress(addr1)})
+	errGenState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 1000), []authx.AccountX{authx.NewAccountXWithAddress(addr1), authx.NewAccountXWithAddress(addr1)}, nil, nil, 0)
 	require.NotNil(t, errGenState.ValidateGenesis())
 
-	errGenState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), -1, 1000), []authx.AccountX{})
+	errGenState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), -1, 1000), []authx.AccountX{}, nil, nil, 0)
 	require.NotNil(t, errGenState.ValidateGenesis())
 
-	errGenState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 100000), []authx.AccountX{})
+	errGenState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 100000), []authx.AccountX{}, nil, nil, 0)
+	require.NotNil(t, errGenState.ValidateGenesis())
+
+	htlc := authx.NewHTLC(authx.GetHashLock([]byte("secret")), addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("cet", 100)), 1000)
+	genState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 1000), []authx.AccountX{}, []authx.HTLC{htlc}, nil, 0)
+	require.Nil(t, genState.ValidateGenesis())
+
+	errGenState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 1000), []authx.AccountX{}, []authx.HTLC{htlc, htlc}, nil, 0)
+	require.NotNil(t, errGenState.ValidateGenesis())
+
+	htlc2 := authx.NewHTLC(htlc.HashLock, addr2, addr1, sdk.NewCoins(sdk.NewInt64Coin("cet", 100)), 1000)
+	genState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 1000), []authx.AccountX{}, []authx.HTLC{htlc, htlc2}, nil, 0)
+	require.Nil(t, genState.ValidateGenesis())
+
+	stream := authx.NewStream(1, addr1, addr2, sdk.NewInt64Coin("cet", 100), 1000, 2000)
+	genState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 1000), []authx.AccountX{}, nil, []authx.Stream{stream}, 2)
+	require.Nil(t, genState.ValidateGenesis())
+
+	errGenState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 1000), []authx.AccountX{}, nil, []authx.Stream{stream, stream}, 2)
+	require.NotNil(t, errGenState.ValidateGenesis())
+
+	errGenState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 1000), []authx.AccountX{}, nil, []authx.Stream{stream}, 1)
+	require.NotNil(t, errGenState.ValidateGenesis())
+
+	errHTLC := authx.NewHTLC([]byte("short"), addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("cet", 100)), 1000)
+	errGenState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 1000), []authx.AccountX{}, []authx.HTLC{errHTLC}, nil, 0)
 	require.NotNil(t, errGenState.ValidateGenesis())
 
 }
 
 func TestExport(t *testing.T) {
-	accx := authx.NewAccountX(sdk.AccAddress([]byte("addr")), false, nil, nil, nil, 0)
+	hashLock := authx.GetHashLock([]byte("secret"))
+	htlc := authx.NewHTLC(hashLock, sdk.AccAddress([]byte("addr")), sdk.AccAddress([]byte("addr2")),
+		sdk.NewCoins(sdk.NewInt64Coin("cet", 100)), 1000)
+	stream := authx.NewStream(1, sdk.AccAddress([]byte("addr")), sdk.AccAddress([]byte("addr2")),
+		sdk.NewInt64Coin("cet", 100), 1000, 2000)
+	stream.Withdrawn = sdk.NewInt(40)
+	lockedCoins := append(htlc.GetLockedCoins(), authx.NewStreamLockedCoin("cet", sdk.NewInt(60), 2000, 1))
+	accx := authx.NewAccountX(sdk.AccAddress([]byte("addr")), false, lockedCoins, nil, nil, 0)
 
 	testInput := setupTestInput()
-	genState1 := authx.NewGenesisState(authx.NewParams(sdk.NewDec(50), 1000, 1000), []authx.AccountX{accx})
+	genState1 := authx.NewGenesisState(authx.NewParams(sdk.NewDec(50), 1000, 1000), []authx.AccountX{accx},
+		[]authx.HTLC{htlc}, []authx.Stream{stream}, 2)
 	authx.InitGenesis(testInput.ctx, testInput.axk, genState1)
 	genState2 := authx.ExportGenesis(testInput.ctx, testInput.axk)
 	require.Equal(t, genState1, genState2)
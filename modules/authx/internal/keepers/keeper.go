@@ -2,6 +2,7 @@ package keepers
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"time"
 
@@ -15,6 +16,16 @@ import (
 var (
 	// AddressStoreKeyPrefix prefix for accountx-by-address store
 	AddressStoreKeyPrefix = []byte{0x01}
+	// HTLCKeyPrefix prefix for htlc-by-sender-and-hash-lock store
+	HTLCKeyPrefix = []byte{0x02}
+	// AccountHTLCKeyPrefix prefix for the senders and hash locks of the HTLCs sent or received by an address
+	AccountHTLCKeyPrefix = []byte{0x03}
+	// StreamKeyPrefix prefix for stream-by-id store
+	StreamKeyPrefix = []byte{0x04}
+	// AccountStreamKeyPrefix prefix for the ids of the streams sent or received by an address
+	AccountStreamKeyPrefix = []byte{0x05}
+	// NextStreamIDKey is the key of the id assigned to the next stream
+	NextStreamIDKey = []byte{0x06}
 
 	PrefixUnlockedCoinsQueue = []byte("UnlockedCoinsQueue")
 	KeyDelimiter             = []byte(";")
@@ -145,6 +156,137 @@ func (axk AccountXKeeper) GetRebateRatioBase(ctx sdk.Context) int64 {
 	return types.RebateRatioBase
 }
 
+// -----------------------------------------------------------------------------
+// HTLC
+
+// SetHTLC stores the HTLC under its sender and hash lock, so that the same hash lock used by another sender
+// does not collide with it, and indexes it under both its sender and its recipient
+func (axk AccountXKeeper) SetHTLC(ctx sdk.Context, htlc types.HTLC) {
+	store := ctx.KVStore(axk.key)
+	store.Set(HTLCKey(htlc.Sender, htlc.HashLock), axk.cdc.MustMarshalBinaryBare(htlc))
+	store.Set(AccountHTLCKey(htlc.Sender, htlc.Sender, htlc.HashLock), []byte{})
+	store.Set(AccountHTLCKey(htlc.Recipient, htlc.Sender, htlc.HashLock), []byte{})
+}
+
+func (axk AccountXKeeper) GetHTLC(ctx sdk.Context, sender sdk.AccAddress, hashLock []byte) (htlc types.HTLC, ok bool) {
+	store := ctx.KVStore(axk.key)
+	bz := store.Get(HTLCKey(sender, hashLock))
+	if bz == nil {
+		return
+	}
+	axk.cdc.MustUnmarshalBinaryBare(bz, &htlc)
+	return htlc, true
+}
+
+func (axk AccountXKeeper) RemoveHTLC(ctx sdk.Context, htlc types.HTLC) {
+	store := ctx.KVStore(axk.key)
+	store.Delete(HTLCKey(htlc.Sender, htlc.HashLock))
+	store.Delete(AccountHTLCKey(htlc.Sender, htlc.Sender, htlc.HashLock))
+	store.Delete(AccountHTLCKey(htlc.Recipient, htlc.Sender, htlc.HashLock))
+}
+
+func (axk AccountXKeeper) IterateHTLCs(ctx sdk.Context, process func(types.HTLC) (stop bool)) {
+	store := ctx.KVStore(axk.key)
+	iter := sdk.KVStorePrefixIterator(store, HTLCKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var htlc types.HTLC
+		axk.cdc.MustUnmarshalBinaryBare(iter.Value(), &htlc)
+		if process(htlc) {
+			return
+		}
+	}
+}
+
+// GetHTLCsOfAccount returns the HTLCs sent or received by addr
+func (axk AccountXKeeper) GetHTLCsOfAccount(ctx sdk.Context, addr sdk.AccAddress) []types.HTLC {
+	store := ctx.KVStore(axk.key)
+	prefix := AccountHTLCKey(addr, nil, nil)[:len(AccountHTLCKeyPrefix)+1+len(addr)]
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	htlcs := make([]types.HTLC, 0)
+	for ; iter.Valid(); iter.Next() {
+		bz := store.Get(append(HTLCKeyPrefix, iter.Key()[len(prefix):]...))
+		if bz != nil {
+			var htlc types.HTLC
+			axk.cdc.MustUnmarshalBinaryBare(bz, &htlc)
+			htlcs = append(htlcs, htlc)
+		}
+	}
+	return htlcs
+}
+
+// -----------------------------------------------------------------------------
+// Stream
+
+// GetNextStreamID returns the id assigned to the next stream, the ids start from 1
+func (axk AccountXKeeper) GetNextStreamID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(axk.key)
+	bz := store.Get(NextStreamIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (axk AccountXKeeper) SetNextStreamID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(axk.key)
+	store.Set(NextStreamIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// SetStream stores the stream and indexes it under both its sender and its recipient
+func (axk AccountXKeeper) SetStream(ctx sdk.Context, stream types.Stream) {
+	store := ctx.KVStore(axk.key)
+	store.Set(StreamKey(stream.ID), axk.cdc.MustMarshalBinaryBare(stream))
+	store.Set(AccountStreamKey(stream.Sender, stream.ID), []byte{})
+	store.Set(AccountStreamKey(stream.Recipient, stream.ID), []byte{})
+}
+
+func (axk AccountXKeeper) GetStream(ctx sdk.Context, id uint64) (stream types.Stream, ok bool) {
+	store := ctx.KVStore(axk.key)
+	bz := store.Get(StreamKey(id))
+	if bz == nil {
+		return
+	}
+	axk.cdc.MustUnmarshalBinaryBare(bz, &stream)
+	return stream, true
+}
+
+func (axk AccountXKeeper) RemoveStream(ctx sdk.Context, stream types.Stream) {
+	store := ctx.KVStore(axk.key)
+	store.Delete(StreamKey(stream.ID))
+	store.Delete(AccountStreamKey(stream.Sender, stream.ID))
+	store.Delete(AccountStreamKey(stream.Recipient, stream.ID))
+}
+
+func (axk AccountXKeeper) IterateStreams(ctx sdk.Context, process func(types.Stream) (stop bool)) {
+	store := ctx.KVStore(axk.key)
+	iter := sdk.KVStorePrefixIterator(store, StreamKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var stream types.Stream
+		axk.cdc.MustUnmarshalBinaryBare(iter.Value(), &stream)
+		if process(stream) {
+			return
+		}
+	}
+}
+
+// GetStreamsOfAccount returns the streams sent or received by addr
+func (axk AccountXKeeper) GetStreamsOfAccount(ctx sdk.Context, addr sdk.AccAddress) []types.Stream {
+	store := ctx.KVStore(axk.key)
+	prefix := AccountStreamKey(addr, 0)[:len(AccountStreamKeyPrefix)+1+len(addr)]
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	streams := make([]types.Stream, 0)
+	for ; iter.Valid(); iter.Next() {
+		if stream, ok := axk.GetStream(ctx, binary.BigEndian.Uint64(iter.Key()[len(prefix):])); ok {
+			streams = append(streams, stream)
+		}
+	}
+	return streams
+}
+
 // -----------------------------------------------------------------------------
 // Params
 
@@ -197,6 +339,39 @@ func KeyUnlockedCoinsQueue(unlockedTime int64, address sdk.AccAddress) []byte {
 	}, KeyDelimiter)
 }
 
+// htlcID identifies an HTLC by its sender and hash lock, it follows the prefixes of both HTLC keys
+func htlcID(sender sdk.AccAddress, hashLock []byte) []byte {
+	id := make([]byte, 0, 1+len(sender)+len(hashLock))
+	id = append(id, byte(len(sender)))
+	id = append(id, sender...)
+	return append(id, hashLock...)
+}
+
+func HTLCKey(sender sdk.AccAddress, hashLock []byte) []byte {
+	return append(HTLCKeyPrefix, htlcID(sender, hashLock)...)
+}
+
+func AccountHTLCKey(addr, sender sdk.AccAddress, hashLock []byte) []byte {
+	id := htlcID(sender, hashLock)
+	key := make([]byte, 0, len(AccountHTLCKeyPrefix)+1+len(addr)+len(id))
+	key = append(key, AccountHTLCKeyPrefix...)
+	key = append(key, byte(len(addr)))
+	key = append(key, addr...)
+	return append(key, id...)
+}
+
+func StreamKey(id uint64) []byte {
+	return append(StreamKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+func AccountStreamKey(addr sdk.AccAddress, id uint64) []byte {
+	key := make([]byte, 0, len(AccountStreamKeyPrefix)+1+len(addr)+8)
+	key = append(key, AccountStreamKeyPrefix...)
+	key = append(key, byte(len(addr)))
+	key = append(key, addr...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
 func PrefixUnlockedTimeQueueTime(unlockedTime int64) []byte {
 	return bytes.Join([][]byte{
 		PrefixUnlockedCoinsQueue,
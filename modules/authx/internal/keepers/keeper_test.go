@@ -81,3 +81,72 @@ func TestIteratorAccounts(t *testing.T) {
 
 	require.Equal(t, 4, len(accxs))
 }
+
+func TestHTLCGetSet(t *testing.T) {
+	input := setupTestInput()
+	sender := sdk.AccAddress([]byte("sender"))
+	recipient := sdk.AccAddress([]byte("recipient"))
+	other := sdk.AccAddress([]byte("other"))
+	hashLock := types.GetHashLock([]byte("secret"))
+
+	_, ok := input.axk.GetHTLC(input.ctx, sender, hashLock)
+	require.False(t, ok)
+
+	htlc := types.NewHTLC(hashLock, sender, recipient, sdk.NewCoins(sdk.NewInt64Coin("cet", 100)), 1000)
+	input.axk.SetHTLC(input.ctx, htlc)
+	htlc2, ok := input.axk.GetHTLC(input.ctx, sender, hashLock)
+	require.True(t, ok)
+	require.Equal(t, htlc, htlc2)
+	require.Equal(t, []types.HTLC{htlc}, input.axk.GetHTLCsOfAccount(input.ctx, sender))
+	require.Equal(t, []types.HTLC{htlc}, input.axk.GetHTLCsOfAccount(input.ctx, recipient))
+	require.Empty(t, input.axk.GetHTLCsOfAccount(input.ctx, other))
+
+	// the same hash lock from another sender is a different HTLC
+	htlc3 := types.NewHTLC(hashLock, other, recipient, sdk.NewCoins(sdk.NewInt64Coin("cet", 50)), 900)
+	input.axk.SetHTLC(input.ctx, htlc3)
+	htlc2, ok = input.axk.GetHTLC(input.ctx, sender, hashLock)
+	require.True(t, ok)
+	require.Equal(t, htlc, htlc2)
+	require.Equal(t, []types.HTLC{htlc3}, input.axk.GetHTLCsOfAccount(input.ctx, other))
+	require.Equal(t, 2, len(input.axk.GetHTLCsOfAccount(input.ctx, recipient)))
+	input.axk.RemoveHTLC(input.ctx, htlc3)
+
+	count := 0
+	input.axk.IterateHTLCs(input.ctx, func(types.HTLC) bool {
+		count++
+		return false
+	})
+	require.Equal(t, 1, count)
+
+	input.axk.RemoveHTLC(input.ctx, htlc)
+	_, ok = input.axk.GetHTLC(input.ctx, sender, hashLock)
+	require.False(t, ok)
+	require.Empty(t, input.axk.GetHTLCsOfAccount(input.ctx, sender))
+	require.Empty(t, input.axk.GetHTLCsOfAccount(input.ctx, recipient))
+}
+
+func TestStreamGetSet(t *testing.T) {
+	input := setupTestInput()
+	sender := sdk.AccAddress([]byte("sender"))
+	recipient := sdk.AccAddress([]byte("recipient"))
+
+	require.Equal(t, uint64(1), input.axk.GetNextStreamID(input.ctx))
+	input.axk.SetNextStreamID(input.ctx, 2)
+	require.Equal(t, uint64(2), input.axk.GetNextStreamID(input.ctx))
+
+	_, ok := input.axk.GetStream(input.ctx, 1)
+	require.False(t, ok)
+	stream := types.NewStream(1, sender, recipient, sdk.NewInt64Coin("cet", 100), 1000, 2000)
+	input.axk.SetStream(input.ctx, stream)
+	stream2, ok := input.axk.GetStream(input.ctx, 1)
+	require.True(t, ok)
+	require.Equal(t, stream.Recipient, stream2.Recipient)
+	require.Equal(t, "100cet", stream2.Amount.String())
+	require.Equal(t, 1, len(input.axk.GetStreamsOfAccount(input.ctx, sender)))
+	require.Equal(t, 1, len(input.axk.GetStreamsOfAccount(input.ctx, recipient)))
+
+	input.axk.RemoveStream(input.ctx, stream)
+	_, ok = input.axk.GetStream(input.ctx, 1)
+	require.False(t, ok)
+	require.Empty(t, input.axk.GetStreamsOfAccount(input.ctx, sender))
+}
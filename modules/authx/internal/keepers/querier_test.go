@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -70,4 +71,17 @@ func Test_queryAccount(t *testing.T) {
 	res, err = query(input.ctx, path0, req)
 	require.Nil(t, err)
 	require.NotNil(t, res)
+
+	// the tranches of a vesting schedule are shown as the locked coins
+	accx := authx.NewAccountXWithAddress(addr)
+	schedule := authx.NewVestingSchedule(1000, 1200, 1400, 100)
+	accx.AddLockedCoins(schedule.GetTranches(sdk.NewCoins(sdk.NewInt64Coin("cet", 100))))
+	input.axk.SetAccountX(input.ctx, accx)
+	res, err = query(input.ctx, path0, req)
+	require.Nil(t, err)
+	var mix types.AccountMix
+	input.cdc.MustUnmarshalJSON(res, &mix)
+	require.Equal(t, 3, len(mix.LockedCoins))
+	require.Equal(t, int64(1300), mix.LockedCoins[1].UnlockTime)
+	require.Equal(t, "25", mix.LockedCoins[1].Coin.Amount.String())
 }
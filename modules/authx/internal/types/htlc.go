@@ -0,0 +1,80 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// HashLockLength is the length of the sha256 hash locks
+	HashLockLength = sha256.Size
+	// MaxSecretLength limits the length of the secrets revealed to claim the HTLCs
+	MaxSecretLength = 64
+)
+
+// HTLC is a hash time-locked transfer, whose amount is kept in the locked coins of the sender until it is
+// claimed for the recipient with the secret of the hash lock, or refunded to the sender after it is expired
+type HTLC struct {
+	HashLock   cmn.HexBytes   `json:"hash_lock"`
+	Sender     sdk.AccAddress `json:"sender"`
+	Recipient  sdk.AccAddress `json:"recipient"`
+	Amount     sdk.Coins      `json:"amount"`
+	ExpireTime int64          `json:"expire_time"`
+}
+
+func NewHTLC(hashLock []byte, sender, recipient sdk.AccAddress, amount sdk.Coins, expireTime int64) HTLC {
+	return HTLC{
+		HashLock:   hashLock,
+		Sender:     sender,
+		Recipient:  recipient,
+		Amount:     amount,
+		ExpireTime: expireTime,
+	}
+}
+
+// GetHashLock returns the hash lock of a secret
+func GetHashLock(secret []byte) []byte {
+	hash := sha256.Sum256(secret)
+	return hash[:]
+}
+
+// IsSecretMatched returns true if the secret is the preimage of the hash lock
+func (htlc HTLC) IsSecretMatched(secret []byte) bool {
+	return bytes.Equal(GetHashLock(secret), htlc.HashLock)
+}
+
+// IsExpired returns true if the HTLC can not be claimed any more at the time
+func (htlc HTLC) IsExpired(time int64) bool {
+	return time >= htlc.ExpireTime
+}
+
+// GetLockedCoins returns the locked coins kept in the sender's account for the HTLC
+func (htlc HTLC) GetLockedCoins() LockedCoins {
+	lockedCoins := make(LockedCoins, len(htlc.Amount))
+	for i, coin := range htlc.Amount {
+		lockedCoins[i] = NewHashLockedCoin(coin.Denom, coin.Amount, htlc.ExpireTime, htlc.HashLock)
+	}
+	return lockedCoins
+}
+
+func (htlc HTLC) Validate() error {
+	if len(htlc.HashLock) != HashLockLength {
+		return fmt.Errorf("invalid hash lock: %s", htlc.HashLock)
+	}
+	if htlc.Sender.Empty() || htlc.Recipient.Empty() {
+		return errors.New("missing sender or recipient address")
+	}
+	if !htlc.Amount.IsValid() || htlc.Amount.Empty() {
+		return fmt.Errorf("invalid amount: %s", htlc.Amount)
+	}
+	if htlc.ExpireTime <= 0 {
+		return errors.New("expire time must be positive")
+	}
+	return nil
+}
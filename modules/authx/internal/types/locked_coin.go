@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 
+	cmn "github.com/tendermint/tendermint/libs/common"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -16,6 +18,8 @@ type LockedCoin struct {
 	FromAddress sdk.AccAddress `json:"from_address,omitempty"`
 	Supervisor  sdk.AccAddress `json:"supervisor,omitempty"`
 	Reward      int64          `json:"reward,omitempty"`
+	HashLock    cmn.HexBytes   `json:"hash_lock,omitempty"`
+	StreamID    uint64         `json:"stream_id,omitempty"`
 }
 
 func NewLockedCoin(denom string, amount sdk.Int, unlockTime int64) LockedCoin {
@@ -35,6 +39,26 @@ func NewSupervisedLockedCoin(denom string, amount sdk.Int, unlockTime int64, fro
 	}
 }
 
+// NewHashLockedCoin - the coin locked by a hash time-locked transfer, which is not unlocked by the EndBlocker
+// but claimed by the recipient or refunded to the sender
+func NewHashLockedCoin(denom string, amount sdk.Int, expireTime int64, hashLock []byte) LockedCoin {
+	return LockedCoin{
+		Coin:       sdk.NewCoin(denom, amount),
+		UnlockTime: expireTime,
+		HashLock:   hashLock,
+	}
+}
+
+// NewStreamLockedCoin - the unwithdrawn amount of a stream, which is not unlocked by the EndBlocker
+// but withdrawn by the recipient or returned to the sender when the stream is canceled
+func NewStreamLockedCoin(denom string, amount sdk.Int, endTime int64, streamID uint64) LockedCoin {
+	return LockedCoin{
+		Coin:       sdk.NewCoin(denom, amount),
+		UnlockTime: endTime,
+		StreamID:   streamID,
+	}
+}
+
 func (coin LockedCoin) String() string {
 	str := fmt.Sprintf("coin: %s, unlocked_time: %d", coin.Coin, coin.UnlockTime)
 	if coin.FromAddress != nil {
@@ -43,6 +67,12 @@ func (coin LockedCoin) String() string {
 	if coin.Supervisor != nil {
 		str += fmt.Sprintf(", supervisor: %s, reward: %d", coin.Supervisor.String(), coin.Reward)
 	}
+	if coin.HashLock != nil {
+		str += fmt.Sprintf(", hash_lock: %s", coin.HashLock.String())
+	}
+	if coin.StreamID != 0 {
+		str += fmt.Sprintf(", stream_id: %d", coin.StreamID)
+	}
 	str += "\n"
 	return str
 }
@@ -52,7 +82,19 @@ func (coin LockedCoin) IsEqual(other LockedCoin) bool {
 		coin.UnlockTime == other.UnlockTime &&
 		coin.Reward == other.Reward &&
 		bytes.Equal(coin.FromAddress, other.FromAddress) &&
-		bytes.Equal(coin.Supervisor, other.Supervisor)
+		bytes.Equal(coin.Supervisor, other.Supervisor) &&
+		bytes.Equal(coin.HashLock, other.HashLock) &&
+		coin.StreamID == other.StreamID
+}
+
+// IsHashLocked - return true if the coin is locked by a hash time-locked transfer
+func (coin LockedCoin) IsHashLocked() bool {
+	return len(coin.HashLock) != 0
+}
+
+// IsStreamed - return true if the coin is the unwithdrawn amount of a stream
+func (coin LockedCoin) IsStreamed() bool {
+	return coin.StreamID != 0
 }
 
 //-----------------------------------------------------------------------------
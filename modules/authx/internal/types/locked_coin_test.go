@@ -22,6 +22,11 @@ func TestToString(t *testing.T) {
 		"coin: 100cet, unlocked_time: 12345\n",
 		lockedCoin2.String())
 
+	lockedCoin3 := NewHashLockedCoin("cet", sdk.NewInt(100), 12345, []byte{0xab, 0xcd})
+	require.Equal(t,
+		"coin: 100cet, unlocked_time: 12345, hash_lock: ABCD\n",
+		lockedCoin3.String())
+
 	lockedCoins := LockedCoins{lockedCoin, lockedCoin2}
 	require.Equal(t,
 		"coin: 100cet, unlocked_time: 12345, from: coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a, supervisor: coinex15fvnexrvsm9ryw3nn4mcrnqyhvhazkkrd4aqvd, reward: 1\n"+
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Stream is a payment whose amount is kept in the locked coins of the sender and vested to the recipient
+// linearly from the start time to the end time, the recipient withdraws the vested amount at any time,
+// and the sender may cancel the stream to take back the unvested remainder
+type Stream struct {
+	ID        uint64         `json:"id"`
+	Sender    sdk.AccAddress `json:"sender"`
+	Recipient sdk.AccAddress `json:"recipient"`
+	Amount    sdk.Coin       `json:"amount"`
+	Withdrawn sdk.Int        `json:"withdrawn"`
+	StartTime int64          `json:"start_time"`
+	EndTime   int64          `json:"end_time"`
+}
+
+func NewStream(id uint64, sender, recipient sdk.AccAddress, amount sdk.Coin, startTime, endTime int64) Stream {
+	return Stream{
+		ID:        id,
+		Sender:    sender,
+		Recipient: recipient,
+		Amount:    amount,
+		Withdrawn: sdk.ZeroInt(),
+		StartTime: startTime,
+		EndTime:   endTime,
+	}
+}
+
+// GetVested returns the amount vested at the time, which is rounded down
+func (stream Stream) GetVested(time int64) sdk.Int {
+	if time <= stream.StartTime {
+		return sdk.ZeroInt()
+	}
+	if time >= stream.EndTime {
+		return stream.Amount.Amount
+	}
+	elapsed := sdk.NewInt(time - stream.StartTime)
+	duration := sdk.NewInt(stream.EndTime - stream.StartTime)
+	return stream.Amount.Amount.Mul(elapsed).Quo(duration)
+}
+
+// GetWithdrawable returns the vested amount which has not been withdrawn at the time
+func (stream Stream) GetWithdrawable(time int64) sdk.Int {
+	return stream.GetVested(time).Sub(stream.Withdrawn)
+}
+
+// GetRemaining returns the amount still kept in the locked coins of the sender
+func (stream Stream) GetRemaining() sdk.Int {
+	return stream.Amount.Amount.Sub(stream.Withdrawn)
+}
+
+func (stream Stream) Validate() error {
+	if stream.ID == 0 {
+		return errors.New("stream id must be positive")
+	}
+	if stream.Sender.Empty() || stream.Recipient.Empty() {
+		return errors.New("missing sender or recipient address")
+	}
+	if !stream.Amount.IsValid() || !stream.Amount.IsPositive() {
+		return fmt.Errorf("invalid amount: %s", stream.Amount)
+	}
+	if stream.Withdrawn == (sdk.Int{}) || stream.Withdrawn.IsNegative() || stream.Withdrawn.GTE(stream.Amount.Amount) {
+		return fmt.Errorf("invalid withdrawn amount: %s", stream.Withdrawn)
+	}
+	if stream.StartTime <= 0 || stream.EndTime <= stream.StartTime {
+		return errors.New("invalid start time or end time")
+	}
+	return nil
+}
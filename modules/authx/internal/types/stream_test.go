@@ -0,0 +1,35 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestStream_GetWithdrawable(t *testing.T) {
+	sender := sdk.AccAddress([]byte("sender"))
+	recipient := sdk.AccAddress([]byte("recipient"))
+	stream := NewStream(1, sender, recipient, sdk.NewInt64Coin("cet", 1000), 1000, 1300)
+	require.NoError(t, stream.Validate())
+
+	require.Equal(t, "0", stream.GetVested(900).String())
+	require.Equal(t, "0", stream.GetVested(1000).String())
+	require.Equal(t, "3", stream.GetVested(1001).String())
+	require.Equal(t, "333", stream.GetVested(1100).String())
+	require.Equal(t, "1000", stream.GetVested(1300).String())
+	require.Equal(t, "1000", stream.GetVested(2000).String())
+
+	stream.Withdrawn = sdk.NewInt(333)
+	require.Equal(t, "333", stream.GetWithdrawable(1200).String())
+	require.Equal(t, "667", stream.GetWithdrawable(1300).String())
+	require.Equal(t, "667", stream.GetRemaining().String())
+
+	stream.Withdrawn = sdk.NewInt(1000)
+	require.Error(t, stream.Validate())
+	stream.Withdrawn = sdk.Int{}
+	require.Error(t, stream.Validate())
+	require.Error(t, NewStream(0, sender, recipient, sdk.NewInt64Coin("cet", 1000), 1000, 1300).Validate())
+	require.Error(t, NewStream(1, sender, recipient, sdk.NewInt64Coin("cet", 1000), 1000, 1000).Validate())
+}
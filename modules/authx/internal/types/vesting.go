@@ -0,0 +1,91 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxVestingTranches limits the number of locked coins a vesting schedule adds to an account for each denom
+const MaxVestingTranches = 120
+
+// VestingSchedule releases the coins pro rata to the time elapsed since StartTime: nothing is released
+// before CliffTime, then the vested part is released at CliffTime and every Period after it, and the rest
+// at EndTime. A short Period, such as a day, approximates a linear release.
+type VestingSchedule struct {
+	StartTime int64 `json:"start_time"`
+	CliffTime int64 `json:"cliff_time"`
+	EndTime   int64 `json:"end_time"`
+	Period    int64 `json:"period"`
+}
+
+func NewVestingSchedule(startTime, cliffTime, endTime, period int64) VestingSchedule {
+	return VestingSchedule{
+		StartTime: startTime,
+		CliffTime: cliffTime,
+		EndTime:   endTime,
+		Period:    period,
+	}
+}
+
+// GetUnlockTimes returns the times at which the tranches of the schedule are unlocked
+func (vs VestingSchedule) GetUnlockTimes() []int64 {
+	times := []int64{vs.CliffTime}
+	for t := vs.CliffTime + vs.Period; t < vs.EndTime; t += vs.Period {
+		times = append(times, t)
+	}
+	if vs.CliffTime < vs.EndTime {
+		times = append(times, vs.EndTime)
+	}
+	return times
+}
+
+func (vs VestingSchedule) getTrancheCount() int64 {
+	span := vs.EndTime - vs.CliffTime
+	count := 1 + span/vs.Period
+	if span%vs.Period != 0 {
+		count++
+	}
+	return count
+}
+
+// GetTranches splits the amount into the locked coins unlocked at the unlock times of the schedule,
+// leaving out the empty tranches
+func (vs VestingSchedule) GetTranches(amount sdk.Coins) LockedCoins {
+	duration := sdk.NewInt(vs.EndTime - vs.StartTime)
+	var tranches LockedCoins
+	for _, coin := range amount {
+		released := sdk.ZeroInt()
+		for _, t := range vs.GetUnlockTimes() {
+			vested := coin.Amount.Mul(sdk.NewInt(t - vs.StartTime)).Quo(duration)
+			if t >= vs.EndTime {
+				vested = coin.Amount
+			}
+			if vested.GT(released) {
+				tranches = append(tranches, NewLockedCoin(coin.Denom, vested.Sub(released), t))
+				released = vested
+			}
+		}
+	}
+	return tranches
+}
+
+func (vs VestingSchedule) Validate() error {
+	if vs.StartTime <= 0 {
+		return errors.New("start time must be positive")
+	}
+	if vs.EndTime <= vs.StartTime {
+		return errors.New("end time must be later than start time")
+	}
+	if vs.CliffTime < vs.StartTime || vs.CliffTime > vs.EndTime {
+		return errors.New("cliff time must be between start time and end time")
+	}
+	if vs.Period <= 0 || vs.Period > vs.EndTime-vs.StartTime {
+		return errors.New("period must be positive and not longer than the vesting duration")
+	}
+	if vs.getTrancheCount() > MaxVestingTranches {
+		return fmt.Errorf("too many tranches, at most %d are allowed", MaxVestingTranches)
+	}
+	return nil
+}
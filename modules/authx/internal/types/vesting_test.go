@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestVestingSchedule_GetTranches(t *testing.T) {
+	// a grant over 12 periods with a cliff of 3 periods
+	vs := NewVestingSchedule(1000, 1300, 2200, 100)
+	require.NoError(t, vs.Validate())
+	require.Equal(t, []int64{1300, 1400, 1500, 1600, 1700, 1800, 1900, 2000, 2100, 2200}, vs.GetUnlockTimes())
+
+	tranches := vs.GetTranches(sdk.NewCoins(sdk.NewInt64Coin("abc", 1000), sdk.NewInt64Coin("cet", 5)))
+	total := sdk.ZeroInt()
+	for _, c := range tranches {
+		if c.Coin.Denom == "abc" {
+			total = total.Add(c.Coin.Amount)
+		}
+	}
+	require.Equal(t, "1000", total.String())
+	require.Equal(t, NewLockedCoin("abc", sdk.NewInt(250), 1300), tranches[0])
+	require.Equal(t, NewLockedCoin("abc", sdk.NewInt(83), 1400), tranches[1])
+	require.Equal(t, NewLockedCoin("abc", sdk.NewInt(83), 1500), tranches[2])
+
+	// the empty tranches of the small amount are left out
+	var cetTranches LockedCoins
+	for _, c := range tranches {
+		if c.Coin.Denom == "cet" {
+			cetTranches = append(cetTranches, c)
+		}
+	}
+	require.Equal(t, 5, len(cetTranches))
+	require.Equal(t, NewLockedCoin("cet", sdk.NewInt(1), 1300), cetTranches[0])
+	require.Equal(t, int64(2200), cetTranches[4].UnlockTime)
+
+	// the last period may be shorter
+	vs = NewVestingSchedule(1000, 1000, 1250, 100)
+	require.Equal(t, []int64{1000, 1100, 1200, 1250}, vs.GetUnlockTimes())
+	require.Equal(t, LockedCoins{NewLockedCoin("abc", sdk.NewInt(400), 1100),
+		NewLockedCoin("abc", sdk.NewInt(400), 1200), NewLockedCoin("abc", sdk.NewInt(200), 1250)},
+		vs.GetTranches(sdk.NewCoins(sdk.NewInt64Coin("abc", 1000))))
+}
+
+func TestVestingSchedule_Validate(t *testing.T) {
+	require.NoError(t, NewVestingSchedule(1000, 2000, 2000, 1000).Validate())
+	require.Error(t, NewVestingSchedule(0, 2000, 3000, 100).Validate())
+	require.Error(t, NewVestingSchedule(1000, 2000, 1000, 100).Validate())
+	require.Error(t, NewVestingSchedule(1000, 999, 2000, 100).Validate())
+	require.Error(t, NewVestingSchedule(1000, 2001, 2000, 100).Validate())
+	require.Error(t, NewVestingSchedule(1000, 1000, 2000, 0).Validate())
+	require.Error(t, NewVestingSchedule(1000, 1000, 2000, 1001).Validate())
+	require.NoError(t, NewVestingSchedule(1000, 1000, 1000+MaxVestingTranches-1, 1).Validate())
+	require.Error(t, NewVestingSchedule(1000, 1000, 1000+MaxVestingTranches, 1).Validate())
+}
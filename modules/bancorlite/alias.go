@@ -22,7 +22,18 @@ type (
 	BancorInfo                 = keepers.BancorInfo
 	MsgBancorTradeInfoForKafka = types.MsgBancorTradeInfoForKafka
 	MsgBancorInfoForKafka      = types.MsgBancorInfoForKafka
+	MsgBancorLiquidityForKafka = types.MsgBancorLiquidityForKafka
+	MsgBancorModifyForKafka    = types.MsgBancorModifyForKafka
 	MsgBancorInit              = types.MsgBancorInit
 	MsgBancorTrade             = types.MsgBancorTrade
 	MsgBancorCancel            = types.MsgBancorCancel
+	MsgBancorDeposit           = types.MsgBancorDeposit
+	MsgBancorWithdraw          = types.MsgBancorWithdraw
+	MsgBancorModify            = types.MsgBancorModify
+	MsgBancorSwap              = types.MsgBancorSwap
+	BancorModification         = keepers.BancorModification
+	BancorShare                = keepers.BancorShare
+	BancorTradeRecord          = keepers.BancorTradeRecord
+	BancorClosingPrice         = keepers.BancorClosingPrice
+	BancorHistory              = keepers.BancorHistory
 )
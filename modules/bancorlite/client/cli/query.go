@@ -1,11 +1,14 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/keepers"
 	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/types"
@@ -58,3 +61,161 @@ Example :
 		},
 	}
 }
+
+func QueryTradeQuoteCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quote [stock] [money]",
+		Short: "query how much money a trade with the bancor pool would cost or earn",
+		Long: `query how much money buying stocks from or selling stocks to a bancor pool would cost or earn,
+together with the commission and the pool's price after this trade. The result is calculated
+exactly as a bancor trade transaction would be executed at the latest block.
+
+Example :
+	cetcli query bancorlite quote stock money --side buy --amount=100 \
+	--trust-node=true --chain-id=coinexdex
+	cetcli query bancorlite quote stock money --side buy --money-amount=100 \
+	--trust-node=true --chain-id=coinexdex`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var isBuy bool
+			switch viper.GetString(FlagSide) {
+			case "buy":
+				isBuy = true
+			case "sell":
+				isBuy = false
+			default:
+				return errors.New("unknown Side. Please specify 'buy' or 'sell'")
+			}
+			query := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryTradeQuote)
+			param := &keepers.QueryTradeQuoteParam{
+				Symbol:      dex.GetSymbol(args[0], args[1]),
+				Amount:      viper.GetInt64(FlagAmount),
+				IsBuy:       isBuy,
+				MoneyAmount: viper.GetInt64(FlagMoneyAmount),
+			}
+			return cliutil.CliQuery(cdc, query, param)
+		},
+	}
+
+	cmd.Flags().Int(FlagAmount, 0, "The amount of tokens to be traded.")
+	cmd.Flags().Int(FlagMoneyAmount, 0, "The amount of money to be paid or earned, specify it instead of --amount.")
+	cmd.Flags().String(FlagSide, "", "the side of the trade, 'buy' or 'sell'.")
+	cmd.MarkFlagRequired(FlagSide)
+	return cmd
+}
+
+func QueryPositionCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "position [stock] [money] [address]",
+		Short: "query the liquidity an address provides to a bancor pool",
+		Long: `query the shares an address holds in a bancor pool, and the stocks, money and commission they own.
+
+Example :
+	cetcli query bancorlite position stock money coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a \
+	--trust-node=true --chain-id=coinexdex`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			provider, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+			query := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryPosition)
+			param := &keepers.QueryPositionParam{Symbol: dex.GetSymbol(args[0], args[1]), Provider: provider}
+			return cliutil.CliQuery(cdc, query, param)
+		},
+	}
+}
+
+func QueryPositionsCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "positions [address]",
+		Short: "query the liquidity an address provides to all the bancor pools",
+		Long: `query the liquidity an address provides to all the bancor pools, including the pools it owns.
+
+Example :
+	cetcli query bancorlite positions coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a \
+	--trust-node=true --chain-id=coinexdex`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			provider, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			query := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryPositions)
+			param := &keepers.QueryPositionParam{Provider: provider}
+			return cliutil.CliQuery(cdc, query, param)
+		},
+	}
+}
+
+func QueryModificationCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "modification [stock] [money]",
+		Short: "query the pending modification of a bancor pool",
+		Long: `query the pending modification of a bancor pool, and the time it takes effect.
+
+Example :
+	cetcli query bancorlite modification stock money --trust-node=true --chain-id=coinexdex`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			query := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryModification)
+			param := &keepers.QueryBancorInfoParam{Symbol: dex.GetSymbol(args[0], args[1])}
+			return cliutil.CliQuery(cdc, query, param)
+		},
+	}
+}
+
+func QueryModificationsCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "modifications",
+		Short: "query the pending modifications of all the bancor pools",
+		Long: `query the pending modifications of all the bancor pools.
+
+Example :
+	cetcli query bancorlite modifications --trust-node=true --chain-id=coinexdex`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			query := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryModifications)
+			return cliutil.CliQuery(cdc, query, nil)
+		},
+	}
+}
+
+func QueryTradesCmd(cdc *codec.Codec) *cobra.Command {
+	return queryHistoryCmd(cdc, "trades", keepers.QueryTrades,
+		"query the recent trades of a bancor pool",
+		`query the recent trades of a bancor pool, the latest first.
+
+Example :
+	cetcli query bancorlite trades stock money --page=1 --limit=20 --trust-node=true --chain-id=coinexdex`)
+}
+
+func QueryClosingPricesCmd(cdc *codec.Codec) *cobra.Command {
+	return queryHistoryCmd(cdc, "closing-prices", keepers.QueryClosingPrices,
+		"query the recent closing prices of a bancor pool",
+		`query the prices of a bancor pool after the last trade in recent blocks, the latest first.
+
+Example :
+	cetcli query bancorlite closing-prices stock money --page=1 --limit=20 --trust-node=true --chain-id=coinexdex`)
+}
+
+func queryHistoryCmd(cdc *codec.Codec, use, path, short, long string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " [stock] [money]",
+		Short: short,
+		Long:  long,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			query := fmt.Sprintf("custom/%s/%s", types.StoreKey, path)
+			param := &keepers.QueryHistoryParam{
+				Symbol: dex.GetSymbol(args[0], args[1]),
+				Page:   viper.GetInt(FlagPage),
+				Limit:  viper.GetInt(FlagLimit),
+			}
+			return cliutil.CliQuery(cdc, query, param)
+		},
+	}
+	cmd.Flags().Int(FlagPage, 1, "The page of the results, starting from 1")
+	cmd.Flags().Int(FlagLimit, keepers.DefaultHistoryLimit, "The number of results in a page")
+	return cmd
+}
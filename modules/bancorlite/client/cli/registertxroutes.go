@@ -19,6 +19,13 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		QueryParamsCmd(cdc),
 		QueryBancorInfoCmd(cdc),
 		QueryBancorListCmd(cdc),
+		QueryTradeQuoteCmd(cdc),
+		QueryPositionCmd(cdc),
+		QueryPositionsCmd(cdc),
+		QueryModificationCmd(cdc),
+		QueryModificationsCmd(cdc),
+		QueryTradesCmd(cdc),
+		QueryClosingPricesCmd(cdc),
 	)...)
 	return bancorliteQueryCmd
 }
@@ -34,6 +41,10 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		BancorInitCmd(cdc),
 		BancorTradeCmd(cdc),
 		BancorCancelCmd(cdc),
+		BancorDepositCmd(cdc),
+		BancorWithdrawCmd(cdc),
+		BancorModifyCmd(cdc),
+		BancorSwapCmd(cdc),
 	)...)
 
 	return bancorliteTxCmd
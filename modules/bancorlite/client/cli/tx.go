@@ -22,8 +22,19 @@ const (
 	FlagSide               = "side"
 	FlagAmount             = "amount"
 	FlagMoneyLimit         = "money-limit"
+	FlagMoneyAmount        = "money-amount"
 	FlagInitPrice          = "init-price"
 	FlagEarliestCancelTime = "earliest-cancel-time"
+	FlagCurveType          = "curve-type"
+	FlagCurveParam         = "curve-param"
+	FlagBreakpoints        = "breakpoints"
+	FlagShares             = "shares"
+	FlagSupplyIncrease     = "supply-increase"
+	FlagOwnerFeeRate       = "owner-fee-rate"
+	FlagPrice              = "price"
+	FlagPricePrecision     = "price-precision"
+	FlagPage               = "page"
+	FlagLimit              = "limit"
 )
 
 var bancorInitFlags = []string{
@@ -37,10 +48,15 @@ var bancorInitFlags = []string{
 
 var bancorTradeFlags = []string{
 	FlagSide,
-	FlagAmount,
 	FlagMoneyLimit,
 }
 
+var bancorSwapFlags = []string{
+	FlagSide,
+	FlagAmount,
+	FlagPrice,
+}
+
 func BancorInitCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "init [stock] [money]",
@@ -49,6 +65,14 @@ func BancorInitCmd(cdc *codec.Codec) *cobra.Command {
 
 Example: 
 	 cetcli tx bancorlite init stock money --max-supply=10000000000000 --max-money=100000 --stock-precision=3 --max-price=5 --init-price=1 --earliest-cancel-time=1563954165
+
+The curve is a power curve by default, other curves can be specified with --curve-type, then max money must be zero.
+The exponential and logistic curves need a steepness in (0, 20], specified by --curve-param as ten times of it.
+The piecewise-linear curve takes breakpoints of supplied stock and price, which must both increase.
+
+Example:
+	 cetcli tx bancorlite init stock money --max-supply=10000000000000 --max-money=0 --stock-precision=3 --max-price=5 --init-price=1 --earliest-cancel-time=1563954165 --curve-type=logistic --curve-param=80
+	 cetcli tx bancorlite init stock money --max-supply=10000000000000 --max-money=0 --stock-precision=3 --max-price=5 --init-price=1 --earliest-cancel-time=1563954165 --curve-type=piecewise-linear --breakpoints=5000000000000:2,8000000000000:4
 `,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -76,6 +100,14 @@ Example:
 			if err != nil {
 				return errors.New("bancor earliest-cancel-time is invalid")
 			}
+			curveType, ok := types.ParseCurveType(viper.GetString(FlagCurveType))
+			if !ok {
+				return errors.New("unknown curve type")
+			}
+			breakpoints, err := types.ParseBreakpoints(viper.GetString(FlagBreakpoints))
+			if err != nil {
+				return err
+			}
 			msg := &types.MsgBancorInit{
 				Stock:              args[0],
 				Money:              args[1],
@@ -85,6 +117,9 @@ Example:
 				MaxPrice:           viper.GetString(FlagMaxPrice),
 				MaxMoney:           maxMoney,
 				EarliestCancelTime: time,
+				CurveType:          curveType,
+				CurveParam:         viper.GetInt64(FlagCurveParam),
+				Breakpoints:        breakpoints,
 			}
 			return cliutil.CliRunCommand(cdc, msg)
 		},
@@ -96,6 +131,9 @@ Example:
 	cmd.Flags().String(FlagMaxPrice, "0", "The maximum reachable price when all the supply are sold out")
 	cmd.Flags().String(FlagEarliestCancelTime, "0", "The time that bancor can be canceled")
 	cmd.Flags().String(FlagInitPrice, "0", "The init price of this bancor")
+	cmd.Flags().String(FlagCurveType, "power", "The curve type, 'power', 'exponential', 'logistic' or 'piecewise-linear'")
+	cmd.Flags().Int64(FlagCurveParam, 0, "The steepness of exponential or logistic curve, multiplied by 10")
+	cmd.Flags().String(FlagBreakpoints, "", "The breakpoints of piecewise-linear curve, in the format of 'supply1:price1,supply2:price2'")
 	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
 	for _, flag := range bancorInitFlags {
 		cmd.MarkFlagRequired(flag)
@@ -108,10 +146,13 @@ func BancorTradeCmd(cdc *codec.Codec) *cobra.Command {
 		Use:   "trade [stock] [money]",
 		Short: "Trade with a bancor pool",
 		Long: `Sell Stocks to a bancor pool or buy Stocks from a bancor pool.
+Instead of the stock amount, the money amount can be specified, then it buys as much stock as the money can pay for,
+or sells as little stock as it brings in the money.
 
 Example: 
 	 cetcli tx bancorlite trade stock money --side buy --amount=100 --money-limit=120
 	 cetcli tx bancorlite trade stock money --side sell --amount=100 --money-limit=80
+	 cetcli tx bancorlite trade stock money --side buy --money-amount=100 --money-limit=0
 `,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -125,17 +166,19 @@ Example:
 				return errors.New("unknown Side. Please specify 'buy' or 'sell'")
 			}
 			msg := &types.MsgBancorTrade{
-				Stock:      args[0],
-				Money:      args[1],
-				Amount:     viper.GetInt64(FlagAmount),
-				IsBuy:      isBuy,
-				MoneyLimit: viper.GetInt64(FlagMoneyLimit),
+				Stock:       args[0],
+				Money:       args[1],
+				Amount:      viper.GetInt64(FlagAmount),
+				IsBuy:       isBuy,
+				MoneyLimit:  viper.GetInt64(FlagMoneyLimit),
+				MoneyAmount: viper.GetInt64(FlagMoneyAmount),
 			}
 			return cliutil.CliRunCommand(cdc, msg)
 		},
 	}
 
 	cmd.Flags().Int(FlagAmount, 0, "The amount of tokens to be traded.")
+	cmd.Flags().Int(FlagMoneyAmount, 0, "The amount of money to be paid or earned, specify it instead of --amount.")
 	cmd.Flags().Int(FlagMoneyLimit, 0, "The upper bound of money you want to pay when buying, or the lower bound of money you want to get when selling. Specify zero or negative value if you do not want a such a limit.")
 	cmd.Flags().String(FlagSide, "", "the side of the trade, 'buy' or 'sell'.")
 	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
@@ -168,3 +211,133 @@ Example:
 
 	return cmd
 }
+
+func BancorDepositCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit [stock] [money]",
+		Short: "Provide liquidity to a bancor pool",
+		Long: `Deposit stocks to a bancor pool, together with the money and commission it needs to keep its current price,
+and get the pool's shares in return. The money paid can be limited by --money-limit.
+
+Example: 
+	 cetcli tx bancorlite deposit stock money --amount=1000 --money-limit=1200
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg := &types.MsgBancorDeposit{
+				Stock:      args[0],
+				Money:      args[1],
+				Amount:     viper.GetInt64(FlagAmount),
+				MoneyLimit: viper.GetInt64(FlagMoneyLimit),
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+	cmd.Flags().Int(FlagAmount, 0, "The amount of stocks to be deposited.")
+	cmd.Flags().Int(FlagMoneyLimit, 0, "The upper bound of money you want to pay. Specify zero or negative value if you do not want a such a limit.")
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+	cmd.MarkFlagRequired(FlagAmount)
+	return cmd
+}
+
+func BancorWithdrawCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw [stock] [money]",
+		Short: "Withdraw liquidity from a bancor pool",
+		Long: `Give back shares of a bancor pool, and take the stocks, money and commission they own.
+
+Example: 
+	 cetcli tx bancorlite withdraw stock money --shares=1000
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg := &types.MsgBancorWithdraw{
+				Stock:  args[0],
+				Money:  args[1],
+				Shares: viper.GetInt64(FlagShares),
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+	cmd.Flags().Int64(FlagShares, 0, "The amount of shares to be given back.")
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+	cmd.MarkFlagRequired(FlagShares)
+	return cmd
+}
+
+func BancorModifyCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "modify [stock] [money]",
+		Short: "Modify a bancor pool after a timelock",
+		Long: `Propose changes to a bancor pool, which take effect after a timelock, sender must be the pool's owner.
+The earliest cancel time can only be extended. The max supply is raised by freezing more stocks, when the pool
+has no liquidity providers and the money in pool is not decreased. The owner fee rate, in 1/10000 of the trade
+volume, is charged in CET for the pool. No new proposal is accepted while one is pending.
+
+Example: 
+	 cetcli tx bancorlite modify stock money --earliest-cancel-time=1563954165 --supply-increase=1000 --owner-fee-rate=20
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg := &types.MsgBancorModify{
+				Stock:              args[0],
+				Money:              args[1],
+				EarliestCancelTime: viper.GetInt64(FlagEarliestCancelTime),
+				SupplyIncrease:     viper.GetInt64(FlagSupplyIncrease),
+				ChangeOwnerFee:     cmd.Flags().Changed(FlagOwnerFeeRate),
+				OwnerFeeRate:       viper.GetInt64(FlagOwnerFeeRate),
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+	cmd.Flags().Int64(FlagEarliestCancelTime, 0, "The new time before which no cancellation is allowed.")
+	cmd.Flags().Int64(FlagSupplyIncrease, 0, "The amount of stocks to be added to the max supply.")
+	cmd.Flags().Int64(FlagOwnerFeeRate, 0, "The new fee rate charged for the pool, in 1/10000 of the trade volume.")
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+	return cmd
+}
+
+func BancorSwapCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap [stock] [money]",
+		Short: "Trade with a bancor pool and the order book for the best average price",
+		Long: `Buy or sell stocks at a price no worse than the limit price, which is price/10^price-precision.
+The amount is split between the bancor pool, which trades right away, and an IOC order on the order book
+of the same pair, which is matched at the end of the block. The split is reported in the events.
+
+Example: 
+	 cetcli tx bancorlite swap stock money --side buy --amount=1000 --price=120 --price-precision=2
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var isBuy bool
+			switch viper.GetString(FlagSide) {
+			case "buy":
+				isBuy = true
+			case "sell":
+				isBuy = false
+			default:
+				return errors.New("unknown Side. Please specify 'buy' or 'sell'")
+			}
+			msg := &types.MsgBancorSwap{
+				Stock:          args[0],
+				Money:          args[1],
+				Amount:         viper.GetInt64(FlagAmount),
+				IsBuy:          isBuy,
+				Price:          viper.GetInt64(FlagPrice),
+				PricePrecision: byte(viper.GetInt(FlagPricePrecision)),
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+	cmd.Flags().Int64(FlagAmount, 0, "The amount of tokens to be traded.")
+	cmd.Flags().Int64(FlagPrice, 0, "The limit price, in 1/10^price-precision of money per token.")
+	cmd.Flags().Int(FlagPricePrecision, 0, "The precision of the limit price.")
+	cmd.Flags().String(FlagSide, "", "the side of the trade, 'buy' or 'sell'.")
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+
+	for _, flag := range bancorSwapFlags {
+		cmd.MarkFlagRequired(flag)
+	}
+	return cmd
+}
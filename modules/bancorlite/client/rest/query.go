@@ -3,12 +3,14 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 
 	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/keepers"
@@ -21,6 +23,14 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 	r.HandleFunc("/bancorlite/pools/{symbol}", queryBancorInfoHandlerFn(cdc, cliCtx)).Methods("GET")
 	r.HandleFunc("/bancorlite/parameters", queryParamsHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/bancorlite/infos", queryBancorsHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/bancorlite/pools/{symbol}/quote/{side}/{amount}", queryTradeQuoteHandlerFn(cdc, cliCtx, false)).Methods("GET")
+	r.HandleFunc("/bancorlite/pools/{symbol}/quote-by-money/{side}/{amount}", queryTradeQuoteHandlerFn(cdc, cliCtx, true)).Methods("GET")
+	r.HandleFunc("/bancorlite/pools/{symbol}/positions/{address}", queryPositionHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/bancorlite/positions/{address}", queryPositionsHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/bancorlite/pools/{symbol}/modification", queryModificationHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/bancorlite/modifications", queryModificationsHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/bancorlite/pools/{symbol}/trades", queryHistoryHandlerFn(cdc, cliCtx, keepers.QueryTrades)).Methods("GET")
+	r.HandleFunc("/bancorlite/pools/{symbol}/closing-prices", queryHistoryHandlerFn(cdc, cliCtx, keepers.QueryClosingPrices)).Methods("GET")
 }
 
 // format: barcorlite/pools/btc-cet
@@ -51,3 +61,113 @@ func queryBancorsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Han
 		restutil.RestQuery(cdc, cliCtx, w, r, query, nil, nil)
 	}
 }
+
+// format: barcorlite/pools/btc-cet/quote/buy/100 or barcorlite/pools/btc-cet/quote-by-money/buy/100
+func queryTradeQuoteHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext, byMoney bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		query := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryTradeQuote)
+		symbol := strings.Replace(vars["symbol"], "-", "/", 1)
+		if !market.IsValidTradingPair(strings.Split(symbol, "/")) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid Trading pair")
+			return
+		}
+		var isBuy bool
+		switch vars["side"] {
+		case "buy":
+			isBuy = true
+		case "sell":
+			isBuy = false
+		default:
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid side, please specify 'buy' or 'sell'")
+			return
+		}
+		amount, err := strconv.ParseInt(vars["amount"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid amount")
+			return
+		}
+		param := &keepers.QueryTradeQuoteParam{Symbol: symbol, Amount: amount, IsBuy: isBuy}
+		if byMoney {
+			param.Amount, param.MoneyAmount = 0, amount
+		}
+		restutil.RestQuery(cdc, cliCtx, w, r, query, param, nil)
+	}
+}
+
+// format: barcorlite/pools/btc-cet/positions/coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a
+func queryPositionHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		query := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryPosition)
+		symbol := strings.Replace(vars["symbol"], "-", "/", 1)
+		if !market.IsValidTradingPair(strings.Split(symbol, "/")) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid Trading pair")
+			return
+		}
+		provider, err := sdk.AccAddressFromBech32(vars["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		param := &keepers.QueryPositionParam{Symbol: symbol, Provider: provider}
+		restutil.RestQuery(cdc, cliCtx, w, r, query, param, nil)
+	}
+}
+
+// format: barcorlite/positions/coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a
+func queryPositionsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		query := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryPositions)
+		provider, err := sdk.AccAddressFromBech32(vars["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		param := &keepers.QueryPositionParam{Provider: provider}
+		restutil.RestQuery(cdc, cliCtx, w, r, query, param, nil)
+	}
+}
+
+// format: barcorlite/pools/btc-cet/modification
+func queryModificationHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		query := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryModification)
+		symbol := strings.Replace(vars["symbol"], "-", "/", 1)
+		if !market.IsValidTradingPair(strings.Split(symbol, "/")) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid Trading pair")
+			return
+		}
+		param := &keepers.QueryBancorInfoParam{Symbol: symbol}
+		restutil.RestQuery(cdc, cliCtx, w, r, query, param, nil)
+	}
+}
+
+func queryModificationsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryModifications)
+		restutil.RestQuery(cdc, cliCtx, w, r, query, nil, nil)
+	}
+}
+
+// format: barcorlite/pools/btc-cet/trades?page=1&limit=20 or barcorlite/pools/btc-cet/closing-prices?page=1&limit=20
+func queryHistoryHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext, path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		query := fmt.Sprintf("custom/%s/%s", types.StoreKey, path)
+		symbol := strings.Replace(vars["symbol"], "-", "/", 1)
+		if !market.IsValidTradingPair(strings.Split(symbol, "/")) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid Trading pair")
+			return
+		}
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, keepers.DefaultHistoryLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		param := &keepers.QueryHistoryParam{Symbol: symbol, Page: page, Limit: limit}
+		restutil.RestQuery(cdc, cliCtx, w, r, query, param, nil)
+	}
+}
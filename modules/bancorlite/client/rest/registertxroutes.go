@@ -16,4 +16,8 @@ func registerTXRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec
 	r.HandleFunc("/bancorlite/bancor-init", bancorInitHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/bancorlite/bancor-trade", bancorTradeHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/bancorlite/bancor-cancel", bancorCancelHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/bancorlite/bancor-deposit", bancorDepositHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/bancorlite/bancor-withdraw", bancorWithdrawHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/bancorlite/bancor-modify", bancorModifyHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/bancorlite/bancor-swap", bancorSwapHandlerFn(cdc, cliCtx)).Methods("POST")
 }
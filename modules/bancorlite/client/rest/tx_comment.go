@@ -24,6 +24,9 @@ type BancorInitReq struct {
 	StockPrecision     string       `json:"stock_precision"`
 	MaxPrice           string       `json:"max_price"`
 	EarliestCancelTime string       `json:"earliest_cancel_time"`
+	CurveType          string       `json:"curve_type"`
+	CurveParam         string       `json:"curve_param"`
+	Breakpoints        string       `json:"breakpoints"`
 }
 
 var _ restutil.RestReq = (*BancorInitReq)(nil)
@@ -66,6 +69,23 @@ func (req *BancorInitReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Ms
 		}
 	}
 
+	var curveType byte
+	if req.CurveType != "" {
+		if curveType, ok = types.ParseCurveType(req.CurveType); !ok {
+			return nil, errors.New("Unknown curve type")
+		}
+	}
+	var curveParam int64
+	if req.CurveParam != "" {
+		if curveParam, convertErr = strconv.ParseInt(req.CurveParam, 10, 64); convertErr != nil {
+			return nil, errors.New("Invalid curve param")
+		}
+	}
+	breakpoints, parseErr := types.ParseBreakpoints(req.Breakpoints)
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
 	return &types.MsgBancorInit{
 		Owner:              sender,
 		Stock:              req.Stock,
@@ -76,6 +96,9 @@ func (req *BancorInitReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Ms
 		StockPrecision:     byte(precision),
 		MaxPrice:           req.MaxPrice,
 		EarliestCancelTime: time,
+		CurveType:          curveType,
+		CurveParam:         curveParam,
+		Breakpoints:        breakpoints,
 	}, nil
 }
 
@@ -90,6 +113,8 @@ type BancorTradeReq struct {
 	Amount     string       `json:"amount"`
 	IsBuy      bool         `json:"is_buy"`
 	MoneyLimit string       `json:"money_limit"`
+	// optional, when specified Amount must be empty or zero
+	MoneyAmount string `json:"money_amount"`
 }
 
 var _ restutil.RestReq = (*BancorTradeReq)(nil)
@@ -102,9 +127,17 @@ func (req *BancorTradeReq) GetBaseReq() *rest.BaseReq {
 }
 
 func (req *BancorTradeReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
-	amount, err := strconv.ParseInt(req.Amount, 10, 64)
-	if err != nil {
-		return nil, errors.New("invalid amount")
+	var amount, moneyAmount int64
+	var err error
+	if req.MoneyAmount != "" {
+		if moneyAmount, err = strconv.ParseInt(req.MoneyAmount, 10, 64); err != nil {
+			return nil, errors.New("invalid money amount")
+		}
+	}
+	if req.Amount != "" || moneyAmount == 0 {
+		if amount, err = strconv.ParseInt(req.Amount, 10, 64); err != nil {
+			return nil, errors.New("invalid amount")
+		}
 	}
 
 	moneyLimit, err := strconv.ParseInt(req.MoneyLimit, 10, 64)
@@ -113,12 +146,13 @@ func (req *BancorTradeReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.M
 	}
 
 	return &types.MsgBancorTrade{
-		Sender:     sender,
-		Stock:      req.Stock,
-		Money:      req.Money,
-		Amount:     amount,
-		IsBuy:      req.IsBuy,
-		MoneyLimit: moneyLimit,
+		Sender:      sender,
+		Stock:       req.Stock,
+		Money:       req.Money,
+		Amount:      amount,
+		IsBuy:       req.IsBuy,
+		MoneyLimit:  moneyLimit,
+		MoneyAmount: moneyAmount,
 	}, nil
 }
 
@@ -152,3 +186,175 @@ func (req *BancorCancelReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.
 func bancorCancelHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return restutil.NewRestHandler(cdc, cliCtx, new(BancorCancelReq))
 }
+
+type BancorDepositReq struct {
+	BaseReq    rest.BaseReq `json:"base_req"`
+	Stock      string       `json:"stock"`
+	Money      string       `json:"money"`
+	Amount     string       `json:"amount"`
+	MoneyLimit string       `json:"money_limit"`
+}
+
+var _ restutil.RestReq = (*BancorDepositReq)(nil)
+
+func (req *BancorDepositReq) New() restutil.RestReq {
+	return new(BancorDepositReq)
+}
+func (req *BancorDepositReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+
+func (req *BancorDepositReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	amount, err := strconv.ParseInt(req.Amount, 10, 64)
+	if err != nil {
+		return nil, errors.New("invalid amount")
+	}
+	var moneyLimit int64
+	if req.MoneyLimit != "" {
+		if moneyLimit, err = strconv.ParseInt(req.MoneyLimit, 10, 64); err != nil {
+			return nil, errors.New("invalid money limit")
+		}
+	}
+	return &types.MsgBancorDeposit{
+		Sender:     sender,
+		Stock:      req.Stock,
+		Money:      req.Money,
+		Amount:     amount,
+		MoneyLimit: moneyLimit,
+	}, nil
+}
+
+func bancorDepositHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(BancorDepositReq))
+}
+
+type BancorWithdrawReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Stock   string       `json:"stock"`
+	Money   string       `json:"money"`
+	Shares  string       `json:"shares"`
+}
+
+var _ restutil.RestReq = (*BancorWithdrawReq)(nil)
+
+func (req *BancorWithdrawReq) New() restutil.RestReq {
+	return new(BancorWithdrawReq)
+}
+func (req *BancorWithdrawReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+
+func (req *BancorWithdrawReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	shares, err := strconv.ParseInt(req.Shares, 10, 64)
+	if err != nil {
+		return nil, errors.New("invalid shares")
+	}
+	return &types.MsgBancorWithdraw{
+		Sender: sender,
+		Stock:  req.Stock,
+		Money:  req.Money,
+		Shares: shares,
+	}, nil
+}
+
+func bancorWithdrawHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(BancorWithdrawReq))
+}
+
+type BancorModifyReq struct {
+	BaseReq            rest.BaseReq `json:"base_req"`
+	Stock              string       `json:"stock"`
+	Money              string       `json:"money"`
+	EarliestCancelTime string       `json:"earliest_cancel_time"`
+	SupplyIncrease     string       `json:"supply_increase"`
+	// optional, the owner fee rate is unchanged when it is empty
+	OwnerFeeRate string `json:"owner_fee_rate"`
+}
+
+var _ restutil.RestReq = (*BancorModifyReq)(nil)
+
+func (req *BancorModifyReq) New() restutil.RestReq {
+	return new(BancorModifyReq)
+}
+func (req *BancorModifyReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+
+func (req *BancorModifyReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	msg := &types.MsgBancorModify{
+		Owner: sender,
+		Stock: req.Stock,
+		Money: req.Money,
+	}
+	var err error
+	if req.EarliestCancelTime != "" {
+		if msg.EarliestCancelTime, err = strconv.ParseInt(req.EarliestCancelTime, 10, 64); err != nil {
+			return nil, errors.New("invalid earliest cancel time")
+		}
+	}
+	if req.SupplyIncrease != "" {
+		if msg.SupplyIncrease, err = strconv.ParseInt(req.SupplyIncrease, 10, 64); err != nil {
+			return nil, errors.New("invalid supply increase")
+		}
+	}
+	if req.OwnerFeeRate != "" {
+		msg.ChangeOwnerFee = true
+		if msg.OwnerFeeRate, err = strconv.ParseInt(req.OwnerFeeRate, 10, 64); err != nil {
+			return nil, errors.New("invalid owner fee rate")
+		}
+	}
+	return msg, nil
+}
+
+func bancorModifyHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(BancorModifyReq))
+}
+
+type BancorSwapReq struct {
+	BaseReq        rest.BaseReq `json:"base_req"`
+	Stock          string       `json:"stock"`
+	Money          string       `json:"money"`
+	Amount         string       `json:"amount"`
+	IsBuy          bool         `json:"is_buy"`
+	Price          string       `json:"price"`
+	PricePrecision string       `json:"price_precision"`
+}
+
+var _ restutil.RestReq = (*BancorSwapReq)(nil)
+
+func (req *BancorSwapReq) New() restutil.RestReq {
+	return new(BancorSwapReq)
+}
+func (req *BancorSwapReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+
+func (req *BancorSwapReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	amount, err := strconv.ParseInt(req.Amount, 10, 64)
+	if err != nil {
+		return nil, errors.New("invalid amount")
+	}
+	price, err := strconv.ParseInt(req.Price, 10, 64)
+	if err != nil {
+		return nil, errors.New("invalid price")
+	}
+	var pricePrecision uint64
+	if req.PricePrecision != "" {
+		if pricePrecision, err = strconv.ParseUint(req.PricePrecision, 10, 8); err != nil {
+			return nil, errors.New("invalid price precision")
+		}
+	}
+	return &types.MsgBancorSwap{
+		Sender:         sender,
+		Stock:          req.Stock,
+		Money:          req.Money,
+		Amount:         amount,
+		IsBuy:          req.IsBuy,
+		Price:          price,
+		PricePrecision: byte(pricePrecision),
+	}, nil
+}
+
+func bancorSwapHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(BancorSwapReq))
+}
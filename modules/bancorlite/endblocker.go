@@ -0,0 +1,37 @@
+package bancorlite
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/keepers"
+)
+
+// EndBlocker executes the pending modifications whose timelocks have expired. A modification
+// which can not be executed any more, e.g. the owner has not enough stock to freeze, is dropped.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	for _, mod := range k.GetDueModifications(ctx, ctx.BlockHeader().Time.Unix()) {
+		k.RemoveModification(ctx, mod.Symbol)
+		bi := k.Load(ctx, mod.Symbol)
+		if bi == nil {
+			continue
+		}
+		// all or nothing of the modification is executed
+		cacheCtx, write := ctx.CacheContext()
+		biNew, err := k.ExecuteModification(cacheCtx, mod)
+		result := "executed"
+		if err == nil {
+			write()
+			fillMsgQueue(ctx, k, KafkaBancorInfo, keepers.NewBancorInfoDisplay(biNew))
+		} else {
+			result = err.Error()
+		}
+		fillModifyMsgQueue(ctx, k, bi.Owner, mod, true, err)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeKeyBancorModifyExecuted,
+				sdk.NewAttribute(AttributeSymbol, mod.Symbol),
+				sdk.NewAttribute(AttributeModifyResult, result),
+			),
+		)
+	}
+}
@@ -4,9 +4,16 @@ package bancorlite
 var (
 	AttributeValueCategory = ModuleName
 
-	EventTypeKeyBancorInit   = "bancor_init"
-	EventTypeKeyBancorTrade  = "bancor_trade"
-	EventTypeKeyBancorCancel = "bancor_cancel"
+	EventTypeKeyBancorInit     = "bancor_init"
+	EventTypeKeyBancorTrade    = "bancor_trade"
+	EventTypeKeyBancorCancel   = "bancor_cancel"
+	EventTypeKeyBancorDeposit  = "bancor_deposit"
+	EventTypeKeyBancorWithdraw = "bancor_withdraw"
+	EventTypeKeyBancorModify   = "bancor_modify"
+	// a pending modification is executed, or is dropped because it can not be executed
+	EventTypeKeyBancorModifyExecuted = "bancor_modify_executed"
+	// a swap is split between the bancor pool and the order book
+	EventTypeKeyBancorSwap = "bancor_swap"
 
 	AttributeSymbol         = "symbol"
 	AttributeOwner          = "bancor_owner"
@@ -19,9 +26,22 @@ var (
 	AttributeTradeSide      = "bancor_trade_side"
 	AttributeRebateReferee  = "rebate_referee"
 	AttributeRebateAmount   = "rebate_amount"
+	AttributeProvider       = "bancor_provider"
+	AttributeShares         = "bancor_shares"
+	AttributeTotalShares    = "bancor_total_shares"
+	AttributeExecutionTime  = "bancor_execution_time"
+	AttributeModifyResult   = "bancor_modify_result"
+	AttributeBancorAmount   = "bancor_swap_bancor_amount"
+	AttributeBancorMoney    = "bancor_swap_bancor_money"
+	AttributeOrderAmount    = "bancor_swap_order_amount"
+	AttributeOrderID        = "bancor_swap_order_id"
+	AttributeUnrouted       = "bancor_swap_unrouted"
 
-	KafkaBancorTrade  = "bancor_trade"
-	KafkaBancorCreate = "bancor_create"
-	KafkaBancorCancel = "bancor_cancel"
-	KafkaBancorInfo   = "bancor_info"
+	KafkaBancorTrade    = "bancor_trade"
+	KafkaBancorCreate   = "bancor_create"
+	KafkaBancorCancel   = "bancor_cancel"
+	KafkaBancorInfo     = "bancor_info"
+	KafkaBancorDeposit  = "bancor_deposit"
+	KafkaBancorWithdraw = "bancor_withdraw"
+	KafkaBancorModify   = "bancor_modify"
 )
@@ -14,19 +14,29 @@ import (
 type GenesisState struct {
 	Params        types.Params                  `json:"params"`
 	BancorInfoMap map[string]keepers.BancorInfo `json:"bancor_info_map"`
+	BancorShares  []keepers.BancorShare         `json:"bancor_shares"`
+	// pending modifications of the pools
+	BancorModifications []keepers.BancorModification `json:"bancor_modifications"`
+	// recent trades and closing prices of the pools
+	BancorHistories []keepers.BancorHistory `json:"bancor_histories"`
 }
 
 // NewGenesisState - Create a new genesis state
-func NewGenesisState(params types.Params, bancorInfoMap map[string]keepers.BancorInfo) GenesisState {
+func NewGenesisState(params types.Params, bancorInfoMap map[string]keepers.BancorInfo,
+	bancorShares []keepers.BancorShare, bancorModifications []keepers.BancorModification,
+	bancorHistories []keepers.BancorHistory) GenesisState {
 	return GenesisState{
-		Params:        params,
-		BancorInfoMap: bancorInfoMap,
+		Params:              params,
+		BancorInfoMap:       bancorInfoMap,
+		BancorShares:        bancorShares,
+		BancorModifications: bancorModifications,
+		BancorHistories:     bancorHistories,
 	}
 }
 
 // DefaultGenesisState - Return a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(types.DefaultParams(), make(map[string]keepers.BancorInfo))
+	return NewGenesisState(types.DefaultParams(), make(map[string]keepers.BancorInfo), nil, nil, nil)
 }
 
 // InitGenesis - Init store state from genesis data
@@ -34,16 +44,37 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	for _, bi := range data.BancorInfoMap {
 		keeper.Save(ctx, &bi)
 	}
+	for _, share := range data.BancorShares {
+		keeper.SetShares(ctx, share.Symbol, share.Provider, share.Shares)
+	}
+	for _, mod := range data.BancorModifications {
+		keeper.SetModification(ctx, mod)
+	}
+	for _, history := range data.BancorHistories {
+		keeper.SetHistory(ctx, history)
+	}
 	keeper.SetParams(ctx, data.Params)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
 func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	m := make(map[string]keepers.BancorInfo)
+	var histories []keepers.BancorHistory
 	k.Iterate(ctx, func(bi *keepers.BancorInfo) {
 		m[bi.GetSymbol()] = *bi
+		if history := k.GetHistory(ctx, bi.GetSymbol()); history.NextSequence != 0 {
+			histories = append(histories, history)
+		}
+	})
+	var shares []keepers.BancorShare
+	k.IterateShares(ctx, "", func(share keepers.BancorShare) {
+		shares = append(shares, share)
 	})
-	return NewGenesisState(k.GetParams(ctx), m)
+	var mods []keepers.BancorModification
+	k.IterateModifications(ctx, func(mod keepers.BancorModification) {
+		mods = append(mods, mod)
+	})
+	return NewGenesisState(k.GetParams(ctx), m, shares, mods, histories)
 }
 
 func (data GenesisState) Validate() error {
@@ -95,5 +126,46 @@ func (data GenesisState) Validate() error {
 			return errors.New("BancorInfo is not consistent")
 		}
 	}
+	providerShares := make(map[string]int64)
+	for _, share := range data.BancorShares {
+		bi, ok := data.BancorInfoMap[share.Symbol]
+		if !ok {
+			return errors.New("shares of a nonexistent bancor pool")
+		}
+		if len(share.Provider) == 0 || bi.Owner.Equals(share.Provider) {
+			return errors.New("invalid liquidity provider")
+		}
+		if share.Shares <= 0 {
+			return errors.New("shares are not positive")
+		}
+		providerShares[share.Symbol] += share.Shares
+		// the owner must hold some shares
+		if providerShares[share.Symbol] >= bi.GetTotalShares() {
+			return errors.New("providers' shares exceed total shares")
+		}
+	}
+	for _, mod := range data.BancorModifications {
+		if _, ok := data.BancorInfoMap[mod.Symbol]; !ok {
+			return errors.New("modification of a nonexistent bancor pool")
+		}
+		if mod.EarliestCancelTime < 0 || mod.SupplyIncrease < 0 || mod.OwnerFeeRate < 0 {
+			return errors.New("invalid modification")
+		}
+	}
+	histories := make(map[string]bool)
+	for _, history := range data.BancorHistories {
+		if _, ok := data.BancorInfoMap[history.Symbol]; !ok {
+			return errors.New("history of a nonexistent bancor pool")
+		}
+		if histories[history.Symbol] {
+			return errors.New("duplicated history of a bancor pool")
+		}
+		histories[history.Symbol] = true
+		for _, record := range history.Trades {
+			if record.Sequence >= history.NextSequence {
+				return errors.New("invalid sequence of a bancor trade")
+			}
+		}
+	}
 	return data.Params.ValidateGenesis()
 }
@@ -34,6 +34,9 @@ func TestGenesisState_Validate(t *testing.T) {
 						TradeFeeRate:    0,
 					},
 					make(map[string]bancorlite.BancorInfo),
+					nil,
+					nil,
+					nil,
 				},
 			},
 			false,
@@ -47,6 +50,9 @@ func TestGenesisState_Validate(t *testing.T) {
 						TradeFeeRate:    100,
 					},
 					make(map[string]bancorlite.BancorInfo),
+					nil,
+					nil,
+					nil,
 				},
 			},
 			true,
@@ -71,3 +77,29 @@ func TestGenesis_ExportImport(t *testing.T) {
 	require.Equal(t, genesisState, exportState)
 
 }
+
+func TestGenesis_ExportImportHistory(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	require.True(t, prepareBancorInit(input))
+	symbol := stock + "/" + money
+	buy := types.MsgBancorTrade{Sender: tradeAddr, Stock: stock, Money: money, Amount: 100000, IsBuy: true}
+	require.True(t, input.handler(input.ctx, buy).IsOK())
+	require.True(t, input.handler(input.ctx.WithBlockHeight(2), buy).IsOK())
+
+	genesisState := bancorlite.ExportGenesis(input.ctx, input.bik)
+	require.Equal(t, 1, len(genesisState.BancorHistories))
+	require.Equal(t, symbol, genesisState.BancorHistories[0].Symbol)
+	require.EqualValues(t, 2, genesisState.BancorHistories[0].NextSequence)
+	require.Equal(t, 2, len(genesisState.BancorHistories[0].Trades))
+	require.Equal(t, 2, len(genesisState.BancorHistories[0].ClosingPrices))
+	require.NoError(t, genesisState.Validate())
+
+	testApp, ctx := prepareApp()
+	bancorlite.InitGenesis(ctx, testApp.BancorKeeper, genesisState)
+	require.Equal(t, genesisState, bancorlite.ExportGenesis(ctx, testApp.BancorKeeper))
+	require.Equal(t, input.bik.GetTrades(input.ctx, symbol), testApp.BancorKeeper.GetTrades(ctx, symbol))
+	require.Equal(t, input.bik.GetClosingPrices(input.ctx, symbol), testApp.BancorKeeper.GetClosingPrices(ctx, symbol))
+
+	genesisState.BancorHistories[0].NextSequence = 1
+	require.Error(t, genesisState.Validate())
+}
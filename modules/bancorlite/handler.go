@@ -2,6 +2,8 @@ package bancorlite
 
 import (
 	"bytes"
+	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -23,6 +25,14 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgBancorTrade(ctx, k, msg)
 		case types.MsgBancorCancel:
 			return handleMsgBancorCancel(ctx, k, msg)
+		case types.MsgBancorDeposit:
+			return handleMsgBancorDeposit(ctx, k, msg)
+		case types.MsgBancorWithdraw:
+			return handleMsgBancorWithdraw(ctx, k, msg)
+		case types.MsgBancorModify:
+			return handleMsgBancorModify(ctx, k, msg)
+		case types.MsgBancorSwap:
+			return handleMsgBancorSwap(ctx, k, msg)
 		default:
 			return dex.ErrUnknownRequest(ModuleName, msg)
 		}
@@ -39,6 +49,9 @@ func handleMsgBancorInit(ctx sdk.Context, k Keeper, msg types.MsgBancorInit) sdk
 	if !k.IsTokenIssuer(ctx, msg.Stock, msg.Owner) {
 		return types.ErrNonOwnerIsProhibited().Result()
 	}
+	if !k.IsPermittedByTokenIssuer(ctx, msg.Money, msg.Owner) {
+		return types.ErrAddressNotPermitted(msg.Money, msg.Owner).Result()
+	}
 	suppliedCoins := sdk.Coins{sdk.NewCoin(msg.Stock, msg.MaxSupply)}
 	if err := k.FreezeCoins(ctx, msg.Owner, suppliedCoins); err != nil {
 		return err.Result()
@@ -76,6 +89,10 @@ func handleMsgBancorInit(ctx sdk.Context, k Keeper, msg types.MsgBancorInit) sdk
 		StockInPool:        msg.MaxSupply,
 		MoneyInPool:        sdk.ZeroInt(),
 		EarliestCancelTime: msg.EarliestCancelTime,
+		CurveType:          msg.CurveType,
+		CurveParam:         msg.CurveParam,
+		Breakpoints:        msg.Breakpoints,
+		TotalShares:        msg.MaxSupply.Int64(),
 	}
 	k.Save(ctx, bi)
 	info := keepers.NewBancorInfoDisplay(bi)
@@ -115,12 +132,29 @@ func handleMsgBancorCancel(ctx sdk.Context, k Keeper, msg types.MsgBancorCancel)
 		return err.Result()
 	}
 	k.Remove(ctx, bi)
+	k.RemoveModification(ctx, bi.GetSymbol())
+	k.RemoveHistory(ctx, bi.GetSymbol())
 	if err := k.UnFreezeCoins(ctx, bi.Owner, sdk.NewCoins(sdk.NewCoin(bi.Stock, bi.StockInPool))); err != nil {
 		return err.Result()
 	}
 	if err := k.UnFreezeCoins(ctx, bi.Owner, sdk.NewCoins(sdk.NewCoin(bi.Money, bi.MoneyInPool))); err != nil {
 		return err.Result()
 	}
+	if err := k.UnFreezeCoins(ctx, bi.Owner, sdk.NewCoins(sdk.NewInt64Coin(dex.CET, bi.CommissionInPool))); err != nil {
+		return err.Result()
+	}
+	// the providers get their parts of the pool, and the owner keeps the rest. The number of
+	// providers is bounded by MaxProvidersPerPool, so is the work of this loop.
+	var providers []keepers.BancorShare
+	k.IterateShares(ctx, bi.GetSymbol(), func(share keepers.BancorShare) {
+		providers = append(providers, share)
+	})
+	for _, share := range providers {
+		if err := k.SendCoins(ctx, bi.Owner, share.Provider, bi.CoinsOfShares(share.Shares)); err != nil {
+			return err.Result()
+		}
+		k.SetShares(ctx, share.Symbol, share.Provider, 0)
+	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -153,42 +187,33 @@ func handleMsgBancorTrade(ctx sdk.Context, k Keeper, msg types.MsgBancorTrade) s
 		k.IsForbiddenByTokenIssuer(ctx, bi.Money, bi.Owner) {
 		return types.ErrTokenForbiddenByOwner().Result()
 	}
-	if !types.CheckStockPrecision(sdk.NewInt(msg.Amount), bi.StockPrecision) {
-		return types.ErrStockAmountPrecisionNotMatch().Result()
+	if err := checkPermitted(ctx, k, bi, msg.Sender, bi.Owner); err != nil {
+		return err.Result()
 	}
-	stockInPool := bi.StockInPool.AddRaw(msg.Amount)
-	if msg.IsBuy {
-		stockInPool = bi.StockInPool.SubRaw(msg.Amount)
+	amount := msg.Amount
+	if msg.MoneyAmount > 0 {
+		var err sdk.Error
+		if amount, err = k.GetStockAmountForMoney(bi, msg.MoneyAmount, msg.IsBuy); err != nil {
+			return err.Result()
+		}
 	}
-	biNew := *bi
-	if ok := biNew.UpdateStockInPool(stockInPool); !ok {
-		return types.ErrStockInPoolOutofBound().Result()
+	res, err := k.CalculateTrade(ctx, bi, amount, msg.IsBuy)
+	if err != nil {
+		return err.Result()
 	}
+	biNew := res.NewBancorInfo
+	diff := res.Money
+	coinsFromPool := res.CoinsFromPool
+	coinsToPool := res.CoinsToPool
 
 	var (
-		diff            sdk.Int
-		coinsFromPool   sdk.Coins
-		coinsToPool     sdk.Coins
 		moneyCrossLimit bool
 		moneyErr        string
 	)
-
 	if msg.IsBuy {
-		diff = biNew.MoneyInPool.Sub(bi.MoneyInPool)
-		if !diff.IsPositive() {
-			return types.ErrTradeMoneyNotPositive().Result()
-		}
-		coinsToPool = sdk.Coins{sdk.NewCoin(msg.Money, diff)}
-		coinsFromPool = sdk.Coins{sdk.NewCoin(msg.Stock, sdk.NewInt(msg.Amount))}
 		moneyCrossLimit = msg.MoneyLimit > 0 && diff.GT(sdk.NewInt(msg.MoneyLimit))
 		moneyErr = "more than"
 	} else {
-		diff = bi.MoneyInPool.Sub(biNew.MoneyInPool)
-		if !diff.IsPositive() {
-			return types.ErrTradeMoneyNotPositive().Result()
-		}
-		coinsFromPool = sdk.Coins{sdk.NewCoin(msg.Money, diff)}
-		coinsToPool = sdk.Coins{sdk.NewCoin(msg.Stock, sdk.NewInt(msg.Amount))}
 		moneyCrossLimit = msg.MoneyLimit > 0 && diff.LT(sdk.NewInt(msg.MoneyLimit))
 		moneyErr = "less than"
 	}
@@ -197,19 +222,36 @@ func handleMsgBancorTrade(ctx sdk.Context, k Keeper, msg types.MsgBancorTrade) s
 		return types.ErrMoneyCrossLimit(moneyErr).Result()
 	}
 
-	commission := getTradeFee(ctx, k, msg, diff)
+	commission := res.Commission
 	rebateAcc, rebate, balance, exist := k.GetRebate(ctx, msg.Sender, commission)
+	fee := commission
+	if exist {
+		fee = balance
+	}
+	// part of the commission is paid to the pool, and shared by its owner and providers
+	providerFee := fee.MulRaw(k.GetParams(ctx).ProviderFeeRatio).QuoRaw(types.ProviderFeeRatioBase)
 	if exist {
-		if err := k.DeductFee(ctx, msg.Sender, sdk.NewCoins(sdk.NewCoin(dex.CET, balance))); err != nil {
+		if err := k.DeductFee(ctx, msg.Sender, sdk.NewCoins(sdk.NewCoin(dex.CET, balance.Sub(providerFee)))); err != nil {
 			return err.Result()
 		}
 		if err := k.SendCoins(ctx, msg.Sender, rebateAcc, sdk.NewCoins(sdk.NewCoin(dex.CET, rebate))); err != nil {
 			return err.Result()
 		}
 	} else {
-		if err := k.DeductFee(ctx, msg.Sender, sdk.NewCoins(sdk.NewCoin(dex.CET, commission))); err != nil {
+		if err := k.DeductFee(ctx, msg.Sender, sdk.NewCoins(sdk.NewCoin(dex.CET, commission.Sub(providerFee)))); err != nil {
+			return err.Result()
+		}
+	}
+	// the fee set by the owner is paid to the pool too
+	if poolFee := providerFee.Add(res.OwnerFee); poolFee.IsPositive() {
+		feeCoins := sdk.NewCoins(sdk.NewCoin(dex.CET, poolFee))
+		if err := k.SendCoins(ctx, msg.Sender, bi.Owner, feeCoins); err != nil {
+			return err.Result()
+		}
+		if err := k.FreezeCoins(ctx, bi.Owner, feeCoins); err != nil {
 			return err.Result()
 		}
+		biNew.CommissionInPool += poolFee.Int64()
 	}
 
 	if err := swapStockAndMoney(ctx, k, msg.Sender, bi.Owner, coinsFromPool, coinsToPool); err != nil {
@@ -224,15 +266,16 @@ func handleMsgBancorTrade(ctx sdk.Context, k Keeper, msg types.MsgBancorTrade) s
 		sideStr = "buy"
 		side = market.BUY
 	}
+	k.RecordTrade(ctx, &biNew, byte(side), amount, res)
 
 	m := types.MsgBancorTradeInfoForKafka{
 		Sender:            msg.Sender,
 		Stock:             msg.Stock,
 		Money:             msg.Money,
-		Amount:            msg.Amount,
+		Amount:            amount,
 		Side:              byte(side),
 		MoneyLimit:        msg.MoneyLimit,
-		TxPrice:           sdk.NewDecFromInt(diff).QuoInt64(msg.Amount),
+		TxPrice:           res.TxPrice(amount),
 		UsedCommission:    balance.Int64(),
 		RebateAmount:      rebate.Int64(),
 		RebateRefereeAddr: rebateAcc,
@@ -267,25 +310,263 @@ func handleMsgBancorTrade(ctx sdk.Context, k Keeper, msg types.MsgBancorTrade) s
 	}
 }
 
-func fillMsgQueue(ctx sdk.Context, keeper Keeper, key string, msg interface{}) {
-	if keeper.IsSubscribed(types.Topic) {
-		msgqueue.FillMsgs(ctx, key, msg)
+// checkPermitted returns an error if one of addrs is not permitted to hold the permissioned stock or money of the pool
+func checkPermitted(ctx sdk.Context, k Keeper, bi *keepers.BancorInfo, addrs ...sdk.AccAddress) sdk.Error {
+	for _, addr := range addrs {
+		for _, denom := range []string{bi.Stock, bi.Money} {
+			if !k.IsPermittedByTokenIssuer(ctx, denom, addr) {
+				return types.ErrAddressNotPermitted(denom, addr)
+			}
+		}
+	}
+	return nil
+}
+
+func handleMsgBancorDeposit(ctx sdk.Context, k Keeper, msg types.MsgBancorDeposit) sdk.Result {
+	bi := k.Load(ctx, msg.GetSymbol())
+	if bi == nil {
+		return types.ErrNoBancorExists().Result()
+	}
+	if bytes.Equal(bi.Owner, msg.Sender) {
+		return types.ErrOwnerIsProhibited().Result()
+	}
+	if k.IsForbiddenByTokenIssuer(ctx, bi.Stock, msg.Sender) ||
+		k.IsForbiddenByTokenIssuer(ctx, bi.Money, msg.Sender) {
+		return types.ErrTokenForbiddenByOwner().Result()
+	}
+	if err := checkPermitted(ctx, k, bi, msg.Sender); err != nil {
+		return err.Result()
+	}
+	shares := k.GetShares(ctx, bi.GetSymbol(), msg.Sender)
+	if shares == 0 && k.GetProviderCount(ctx, bi.GetSymbol()) >= types.MaxProvidersPerPool {
+		return types.ErrTooManyProviders().Result()
+	}
+	res, err := keepers.CalculateDeposit(bi, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	if msg.MoneyLimit > 0 && res.MoneyAmount.GT(sdk.NewInt(msg.MoneyLimit)) {
+		return types.ErrMoneyCrossLimit("more than").Result()
+	}
+	coinsToPool := res.Coins(bi)
+	if err := k.SendCoins(ctx, msg.Sender, bi.Owner, coinsToPool); err != nil {
+		return err.Result()
+	}
+	if err := k.FreezeCoins(ctx, bi.Owner, coinsToPool); err != nil {
+		return err.Result()
+	}
+	k.SetShares(ctx, bi.GetSymbol(), msg.Sender, shares+res.Shares)
+	biNew := res.NewBancorInfo
+	k.Save(ctx, &biNew)
+
+	fillLiquidityMsgQueue(ctx, k, KafkaBancorDeposit, msg.Sender, &biNew, res)
+	emitLiquidityEvents(ctx, EventTypeKeyBancorDeposit, msg.Sender, &biNew, res.Shares,
+		sdk.NewAttribute(AttributeCoinsToPool, coinsToPool.String()))
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
 	}
 }
 
-func getTradeFee(ctx sdk.Context, k keepers.Keeper, msg types.MsgBancorTrade,
-	amountOfMoney sdk.Int) sdk.Int {
+func handleMsgBancorWithdraw(ctx sdk.Context, k Keeper, msg types.MsgBancorWithdraw) sdk.Result {
+	bi := k.Load(ctx, msg.GetSymbol())
+	if bi == nil {
+		return types.ErrNoBancorExists().Result()
+	}
+	shares := k.GetShares(ctx, bi.GetSymbol(), msg.Sender)
+	if shares < msg.Shares {
+		return types.ErrInsufficientShares().Result()
+	}
+	res, err := keepers.CalculateWithdraw(bi, msg.Shares)
+	if err != nil {
+		return err.Result()
+	}
+	coinsFromPool := res.Coins(bi)
+	if err := k.UnFreezeCoins(ctx, bi.Owner, coinsFromPool); err != nil {
+		return err.Result()
+	}
+	if err := k.SendCoins(ctx, bi.Owner, msg.Sender, coinsFromPool); err != nil {
+		return err.Result()
+	}
+	k.SetShares(ctx, bi.GetSymbol(), msg.Sender, shares-msg.Shares)
+	biNew := res.NewBancorInfo
+	k.Save(ctx, &biNew)
 
-	volume := k.GetMarketVolume(ctx, msg.Stock, msg.Money, sdk.NewDec(msg.Amount), sdk.NewDecFromInt(amountOfMoney))
-	commission := volume.
-		Mul(sdk.NewDec(k.GetParams(ctx).TradeFeeRate)).
-		QuoInt64(10000).TruncateInt64()
+	fillLiquidityMsgQueue(ctx, k, KafkaBancorWithdraw, msg.Sender, &biNew, res)
+	emitLiquidityEvents(ctx, EventTypeKeyBancorWithdraw, msg.Sender, &biNew, res.Shares,
+		sdk.NewAttribute(AttributeCoinsFromPool, coinsFromPool.String()))
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
 
-	min := k.GetMarketFeeMin(ctx)
-	if commission < min {
-		return sdk.NewInt(min)
+func handleMsgBancorModify(ctx sdk.Context, k Keeper, msg types.MsgBancorModify) sdk.Result {
+	bi := k.Load(ctx, msg.GetSymbol())
+	if bi == nil {
+		return types.ErrNoBancorExists().Result()
+	}
+	if !bytes.Equal(bi.Owner, msg.Owner) {
+		return types.ErrNotBancorOwner().Result()
+	}
+	// a new modification replaces the pending one, and its timelock starts again, so the users can
+	// always see a modification for a whole timelock before it takes effect
+	executionTime := ctx.BlockHeader().Time.Unix() + k.GetParams(ctx).ModifyTimelock
+	mod := keepers.NewBancorModification(msg, executionTime)
+	if _, err := k.CheckModification(ctx, bi, mod); err != nil {
+		return err.Result()
+	}
+	k.SetModification(ctx, mod)
+
+	fillModifyMsgQueue(ctx, k, bi.Owner, mod, false, nil)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeKeyBancorModify,
+			sdk.NewAttribute(AttributeSymbol, bi.GetSymbol()),
+			sdk.NewAttribute(AttributeOwner, bi.Owner.String()),
+			sdk.NewAttribute(AttributeExecutionTime, fmt.Sprintf("%d", executionTime)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgBancorSwap(ctx sdk.Context, k Keeper, msg types.MsgBancorSwap) sdk.Result {
+	bi := k.Load(ctx, msg.GetSymbol())
+	if bi == nil {
+		return types.ErrNoBancorExists().Result()
+	}
+	route, err := k.CalculateSwapRoute(ctx, bi, msg.Amount, msg.IsBuy, msg.LimitPrice())
+	if err != nil {
+		return err.Result()
+	}
+	sideStr := "sell"
+	side := market.SELL
+	if msg.IsBuy {
+		sideStr = "buy"
+		side = market.BUY
+	}
+
+	bancorMoney := sdk.ZeroInt()
+	if route.BancorAmount != 0 {
+		bancorMoney = route.BancorTrade.Money
+		trade := types.MsgBancorTrade{
+			Sender:     msg.Sender,
+			Stock:      msg.Stock,
+			Money:      msg.Money,
+			Amount:     route.BancorAmount,
+			IsBuy:      msg.IsBuy,
+			MoneyLimit: bancorMoney.Int64(),
+		}
+		if res := handleMsgBancorTrade(ctx, k, trade); !res.IsOK() {
+			return res
+		}
+	}
+	var orderID string
+	if route.OrderAmount != 0 {
+		order := market.MsgCreateOrder{
+			Sender:         msg.Sender,
+			TradingPair:    msg.GetSymbol(),
+			OrderType:      market.LimitOrder,
+			PricePrecision: msg.PricePrecision,
+			Price:          msg.Price,
+			Quantity:       route.OrderAmount,
+			Side:           byte(side),
+			TimeInForce:    market.IOC,
+		}
+		if orderID, err = k.CreateOrder(ctx, order); err != nil {
+			return err.Result()
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeKeyBancorSwap,
+			sdk.NewAttribute(AttributeSymbol, bi.GetSymbol()),
+			sdk.NewAttribute(AttributeTradeSide, sideStr),
+			sdk.NewAttribute(AttributeBancorAmount, fmt.Sprintf("%d", route.BancorAmount)),
+			sdk.NewAttribute(AttributeBancorMoney, bancorMoney.String()),
+			sdk.NewAttribute(AttributeOrderAmount, fmt.Sprintf("%d", route.OrderAmount)),
+			sdk.NewAttribute(AttributeOrderID, orderID),
+			sdk.NewAttribute(AttributeUnrouted, fmt.Sprintf("%d", route.Unrouted)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func fillModifyMsgQueue(ctx sdk.Context, k Keeper, owner sdk.AccAddress, mod keepers.BancorModification, done bool, err sdk.Error) {
+	stockAndMoney := strings.Split(mod.Symbol, dex.SymbolSeparator)
+	m := types.MsgBancorModifyForKafka{
+		Owner:              owner,
+		Stock:              stockAndMoney[0],
+		Money:              stockAndMoney[1],
+		EarliestCancelTime: mod.EarliestCancelTime,
+		SupplyIncrease:     mod.SupplyIncrease,
+		ChangeOwnerFee:     mod.ChangeOwnerFee,
+		OwnerFeeRate:       mod.OwnerFeeRate,
+		ExecutionTime:      mod.ExecutionTime,
+		Done:               done,
+		BlockHeight:        ctx.BlockHeight(),
+	}
+	if err != nil {
+		m.Error = err.Error()
+	}
+	fillMsgQueue(ctx, k, KafkaBancorModify, m)
+}
+
+func fillLiquidityMsgQueue(ctx sdk.Context, k Keeper, key string, provider sdk.AccAddress,
+	biNew *keepers.BancorInfo, res keepers.LiquidityResult) {
+	m := types.MsgBancorLiquidityForKafka{
+		Provider:    provider,
+		Stock:       biNew.Stock,
+		Money:       biNew.Money,
+		Shares:      res.Shares,
+		StockAmount: res.StockAmount,
+		MoneyAmount: res.MoneyAmount,
+		Commission:  res.Commission,
+		BlockHeight: ctx.BlockHeight(),
+	}
+	fillMsgQueue(ctx, k, key, m)
+	fillMsgQueue(ctx, k, KafkaBancorInfo, keepers.NewBancorInfoDisplay(biNew))
+}
+
+func emitLiquidityEvents(ctx sdk.Context, eventType string, provider sdk.AccAddress,
+	biNew *keepers.BancorInfo, shares int64, coins sdk.Attribute) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(AttributeSymbol, biNew.GetSymbol()),
+			sdk.NewAttribute(AttributeProvider, provider.String()),
+			sdk.NewAttribute(AttributeShares, fmt.Sprintf("%d", shares)),
+			sdk.NewAttribute(AttributeTotalShares, fmt.Sprintf("%d", biNew.GetTotalShares())),
+			sdk.NewAttribute(AttributeNewStockInPool, biNew.StockInPool.String()),
+			sdk.NewAttribute(AttributeNewMoneyInPool, biNew.MoneyInPool.String()),
+			sdk.NewAttribute(AttributeMaxSupply, biNew.MaxSupply.String()),
+			coins,
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, provider.String()),
+		),
+	})
+}
+
+func fillMsgQueue(ctx sdk.Context, keeper Keeper, key string, msg interface{}) {
+	if keeper.IsSubscribed(types.Topic) {
+		msgqueue.FillMsgs(ctx, key, msg)
 	}
-	return sdk.NewInt(commission)
 }
 
 func swapStockAndMoney(ctx sdk.Context, k keepers.Keeper, trader sdk.AccAddress, owner sdk.AccAddress,
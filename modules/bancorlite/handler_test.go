@@ -1,13 +1,17 @@
 package bancorlite_test
 
 import (
+	"fmt"
 	"math"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -22,6 +26,7 @@ import (
 	"github.com/coinexchain/cet-sdk/modules/bankx"
 	"github.com/coinexchain/cet-sdk/modules/market"
 	"github.com/coinexchain/cet-sdk/testapp"
+	"github.com/coinexchain/cet-sdk/testutil"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
 
@@ -30,6 +35,8 @@ type testInput struct {
 	bik     keepers.Keeper
 	handler sdk.Handler
 	akp     auth.AccountKeeper
+	mk      market.Keeper
+	tk      asset.Keeper
 	cdc     *codec.Codec // mk.cdc
 }
 
@@ -127,7 +134,8 @@ func prepareMockInput(t *testing.T, addrForbid, tokenForbid bool) testInput {
 	prepareBankx(ctx, testApp.BankxKeeper)
 	prepareMarket(ctx, testApp.MarketKeeper)
 
-	return testInput{ctx: ctx, bik: testApp.BancorKeeper, handler: bancorlite.NewHandler(testApp.BancorKeeper), akp: testApp.AccountKeeper, cdc: testApp.Cdc}
+	return testInput{ctx: ctx, bik: testApp.BancorKeeper, handler: bancorlite.NewHandler(testApp.BancorKeeper), akp: testApp.AccountKeeper,
+		mk: testApp.MarketKeeper, tk: testApp.AssetKeeper, cdc: testApp.Cdc}
 }
 
 func Test_handleMsgBancorInit(t *testing.T) {
@@ -533,6 +541,187 @@ func Test_handleMsgBancorTradeAfterInit(t *testing.T) {
 	}
 }
 
+func Test_handleMsgBancorTradePermissionedToken(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	require.True(t, prepareBancorInit(input))
+	token := input.tk.GetToken(input.ctx, stock)
+	token.SetPermissioned(true)
+	require.NoError(t, input.tk.SetToken(input.ctx, token))
+
+	msgTrade := types.MsgBancorTrade{
+		Sender:     tradeAddr,
+		Stock:      stock,
+		Money:      money,
+		Amount:     200000,
+		IsBuy:      true,
+		MoneyLimit: 2000000,
+	}
+	res := input.handler(input.ctx, msgTrade)
+	require.Equal(t, types.CodeAddressNotPermitted, res.Code)
+
+	require.NoError(t, input.tk.AddTokenWhitelist(input.ctx, stock, haveCetAddress, []sdk.AccAddress{tradeAddr}))
+	res = input.handler(input.ctx, msgTrade)
+	require.True(t, res.IsOK())
+}
+
+func Test_handleMsgBancorTradeFrozenStock(t *testing.T) {
+	input := prepareMockInput(t, true, false)
+	require.True(t, prepareBancorInit(input))
+
+	msgTrade := types.MsgBancorTrade{
+		Sender:     tradeAddr,
+		Stock:      stock,
+		Money:      money,
+		Amount:     200000,
+		IsBuy:      true,
+		MoneyLimit: 2000000,
+	}
+	res := input.handler(input.ctx, msgTrade)
+	require.True(t, res.IsOK())
+
+	// the stock frozen by its owner can not be sold to the pool
+	_, err := input.tk.FreezeTokenAmount(input.ctx, stock, haveCetAddress, tradeAddr, sdk.NewInt(150000))
+	require.NoError(t, err)
+	msgTrade.IsBuy = false
+	msgTrade.Amount = 100000
+	msgTrade.MoneyLimit = 0
+	res = input.handler(input.ctx, msgTrade)
+	require.Equal(t, bankx.CodeTokenFrozenByOwner, res.Code)
+
+	msgTrade.Amount = 50000
+	res = input.handler(input.ctx, msgTrade)
+	require.True(t, res.IsOK())
+}
+
+func Test_TradeQuoteMatchesTrade(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	require.True(t, prepareBancorInit(input))
+	querier := keepers.NewQuerier(input.bik)
+
+	for _, isBuy := range []bool{true, false} {
+		param := keepers.QueryTradeQuoteParam{Symbol: stock + "/" + money, Amount: 200000, IsBuy: isBuy}
+		bz, err := querier(input.ctx, []string{keepers.QueryTradeQuote}, abci.RequestQuery{Data: input.cdc.MustMarshalJSON(param)})
+		require.Nil(t, err)
+		var quote keepers.TradeQuoteDisplay
+		input.cdc.MustUnmarshalJSON(bz, &quote)
+
+		coinsBefore := input.akp.GetAccount(input.ctx, tradeAddr).GetCoins()
+		msg := types.MsgBancorTrade{
+			Sender: tradeAddr,
+			Stock:  stock,
+			Money:  money,
+			Amount: param.Amount,
+			IsBuy:  isBuy,
+		}
+		require.True(t, input.handler(input.ctx, msg).IsOK())
+		coinsAfter := input.akp.GetAccount(input.ctx, tradeAddr).GetCoins()
+
+		moneyDiff := coinsBefore.AmountOf(money).Sub(coinsAfter.AmountOf(money))
+		if !isBuy {
+			moneyDiff = moneyDiff.Neg()
+		}
+		require.Equal(t, quote.Money, moneyDiff.String())
+		require.Equal(t, quote.Commission, coinsBefore.AmountOf(dex.CET).Sub(coinsAfter.AmountOf(dex.CET)).String())
+
+		bi := input.bik.Load(input.ctx, param.Symbol)
+		require.Equal(t, quote.NewPrice, bi.Price.String())
+		require.Equal(t, quote.NewMoneyInPool, bi.MoneyInPool.String())
+		require.Equal(t, quote.NewStockInPool, bi.StockInPool.String())
+	}
+
+	param := keepers.QueryTradeQuoteParam{Symbol: stock + "/" + money, Amount: 2000000, IsBuy: true}
+	_, err := querier(input.ctx, []string{keepers.QueryTradeQuote}, abci.RequestQuery{Data: input.cdc.MustMarshalJSON(param)})
+	require.Equal(t, types.CodeStockInPoolOutOfBound, err.Code())
+
+	param = keepers.QueryTradeQuoteParam{Symbol: money + "/" + stock, Amount: 100, IsBuy: true}
+	_, err = querier(input.ctx, []string{keepers.QueryTradeQuote}, abci.RequestQuery{Data: input.cdc.MustMarshalJSON(param)})
+	require.Equal(t, types.CodeNoBancorExists, err.Code())
+}
+
+func Test_handleMsgBancorTradeByMoney(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	require.True(t, prepareBancorInit(input))
+	querier := keepers.NewQuerier(input.bik)
+
+	for _, isBuy := range []bool{true, false} {
+		param := keepers.QueryTradeQuoteParam{Symbol: stock + "/" + money, IsBuy: isBuy, MoneyAmount: 100000}
+		bz, err := querier(input.ctx, []string{keepers.QueryTradeQuote}, abci.RequestQuery{Data: input.cdc.MustMarshalJSON(param)})
+		require.Nil(t, err)
+		var quote keepers.TradeQuoteDisplay
+		input.cdc.MustUnmarshalJSON(bz, &quote)
+
+		coinsBefore := input.akp.GetAccount(input.ctx, tradeAddr).GetCoins()
+		msg := types.MsgBancorTrade{
+			Sender:      tradeAddr,
+			Stock:       stock,
+			Money:       money,
+			IsBuy:       isBuy,
+			MoneyAmount: param.MoneyAmount,
+		}
+		require.True(t, input.handler(input.ctx, msg).IsOK())
+		coinsAfter := input.akp.GetAccount(input.ctx, tradeAddr).GetCoins()
+
+		stockDiff := coinsAfter.AmountOf(stock).Sub(coinsBefore.AmountOf(stock))
+		moneyDiff := coinsBefore.AmountOf(money).Sub(coinsAfter.AmountOf(money))
+		if isBuy {
+			require.True(t, moneyDiff.LTE(sdk.NewInt(param.MoneyAmount)))
+		} else {
+			stockDiff, moneyDiff = stockDiff.Neg(), moneyDiff.Neg()
+			require.True(t, moneyDiff.GTE(sdk.NewInt(param.MoneyAmount)))
+		}
+		require.Equal(t, quote.Amount, stockDiff.Int64())
+		require.Equal(t, quote.Money, moneyDiff.String())
+	}
+
+	msg := types.MsgBancorTrade{
+		Sender:      tradeAddr,
+		Stock:       stock,
+		Money:       money,
+		IsBuy:       false,
+		MoneyAmount: 1e13,
+	}
+	require.Equal(t, types.ErrStockInPoolOutofBound().Result().Log, input.handler(input.ctx, msg).Log)
+}
+
+func Test_handleMsgBancorInitWithCurve(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	msg := types.MsgBancorInit{
+		Owner:      haveCetAddress,
+		Stock:      stock,
+		Money:      money,
+		InitPrice:  "1",
+		MaxSupply:  sdk.NewInt(1000000),
+		MaxMoney:   sdk.ZeroInt(),
+		MaxPrice:   "10",
+		CurveType:  types.CurveLogistic,
+		CurveParam: 80,
+	}
+	require.Nil(t, msg.ValidateBasic())
+	require.True(t, input.handler(input.ctx, msg).IsOK())
+
+	trade := types.MsgBancorTrade{
+		Sender: tradeAddr,
+		Stock:  stock,
+		Money:  money,
+		Amount: 500000,
+		IsBuy:  true,
+	}
+	require.True(t, input.handler(input.ctx, trade).IsOK())
+	bi := input.bik.Load(input.ctx, stock+"/"+money)
+	require.True(t, bi.IsConsistent())
+	// the logistic curve is symmetric, so the price at half supply is in the middle
+	require.True(t, bi.Price.Sub(sdk.NewDecWithPrec(55, 1)).Abs().LT(sdk.NewDecWithPrec(1, 6)), bi.Price.String())
+
+	querier := keepers.NewQuerier(input.bik)
+	param := keepers.QueryBancorInfoParam{Symbol: stock + "/" + money}
+	bz, err := querier(input.ctx, []string{keepers.QueryBancorInfo}, abci.RequestQuery{Data: input.cdc.MustMarshalJSON(param)})
+	require.Nil(t, err)
+	var info keepers.BancorInfoDisplay
+	input.cdc.MustUnmarshalJSON(bz, &info)
+	require.Equal(t, "logistic", info.CurveType)
+	require.Equal(t, "80", info.CurveParam)
+}
+
 func Test_BancorCancel(t *testing.T) {
 	type args struct {
 		ctx       sdk.Context
@@ -623,3 +812,235 @@ func TestKeeper(t *testing.T) {
 	e = k.IsBancorExist(ctx, "ccc")
 	assert.False(t, e)
 }
+
+func Test_handleMsgBancorDepositAndWithdraw(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	require.True(t, prepareBancorInit(input))
+	params := input.bik.GetParams(input.ctx)
+	params.ProviderFeeRatio = 5000
+	input.bik.SetParams(input.ctx, params)
+	symbol := stock + "/" + money
+	buy := types.MsgBancorTrade{Sender: tradeAddr, Stock: stock, Money: money, Amount: 400000, IsBuy: true}
+	require.True(t, input.handler(input.ctx, buy).IsOK())
+	bi := input.bik.Load(input.ctx, symbol)
+	require.True(t, bi.CommissionInPool > 0)
+
+	deposit := types.MsgBancorDeposit{Sender: tradeAddr, Stock: stock, Money: money, Amount: 100000, MoneyLimit: 1}
+	require.Equal(t, types.ErrMoneyCrossLimit("more than").Result().Log, input.handler(input.ctx, deposit).Log)
+	deposit.Sender = haveCetAddress
+	require.Equal(t, types.ErrOwnerIsProhibited().Result().Log, input.handler(input.ctx, deposit).Log)
+
+	coinsBefore := input.akp.GetAccount(input.ctx, tradeAddr).GetCoins()
+	deposit.Sender, deposit.MoneyLimit = tradeAddr, 0
+	require.True(t, input.handler(input.ctx, deposit).IsOK())
+	coinsAfter := input.akp.GetAccount(input.ctx, tradeAddr).GetCoins()
+	biNew := input.bik.Load(input.ctx, symbol)
+	require.True(t, biNew.IsConsistent())
+	require.Equal(t, bi.StockInPool.AddRaw(100000), biNew.StockInPool)
+	require.Equal(t, int64(100000), coinsBefore.AmountOf(stock).Sub(coinsAfter.AmountOf(stock)).Int64())
+	require.Equal(t, biNew.MoneyInPool.Sub(bi.MoneyInPool), coinsBefore.AmountOf(money).Sub(coinsAfter.AmountOf(money)))
+	// the price stays the same, except the rounding of the supplied stock
+	require.True(t, biNew.Price.Sub(bi.Price).Abs().LT(sdk.NewDecWithPrec(1, 3)), biNew.Price.String())
+
+	shares := input.bik.GetPosition(input.ctx, biNew, tradeAddr)
+	require.Equal(t, bi.GetTotalShares()*100000/600000, shares)
+	require.Equal(t, bi.GetTotalShares()+shares, biNew.GetTotalShares())
+	require.Equal(t, bi.GetTotalShares(), input.bik.GetPosition(input.ctx, biNew, haveCetAddress))
+
+	withdraw := types.MsgBancorWithdraw{Sender: tradeAddr, Stock: stock, Money: money, Shares: shares + 1}
+	require.Equal(t, types.ErrInsufficientShares().Result().Log, input.handler(input.ctx, withdraw).Log)
+	withdraw.Sender, withdraw.Shares = haveCetAddress, 1
+	require.Equal(t, types.ErrInsufficientShares().Result().Log, input.handler(input.ctx, withdraw).Log)
+
+	withdraw.Sender, withdraw.Shares = tradeAddr, shares
+	require.True(t, input.handler(input.ctx, withdraw).IsOK())
+	coinsWithdrawn := input.akp.GetAccount(input.ctx, tradeAddr).GetCoins()
+	biWithdrawn := input.bik.Load(input.ctx, symbol)
+	require.True(t, biWithdrawn.IsConsistent())
+	require.Equal(t, bi.GetTotalShares(), biWithdrawn.TotalShares)
+	require.Equal(t, int64(0), input.bik.GetShares(input.ctx, symbol, tradeAddr))
+	// the curve's money is only proportional to its size up to rounding
+	require.True(t, coinsWithdrawn.AmountOf(stock).LTE(coinsBefore.AmountOf(stock)))
+	require.True(t, coinsWithdrawn.AmountOf(dex.CET).LTE(coinsBefore.AmountOf(dex.CET)))
+	require.True(t, coinsWithdrawn.AmountOf(money).Sub(coinsBefore.AmountOf(money)).LTE(sdk.OneInt()))
+	require.True(t, coinsWithdrawn.AmountOf(stock).GT(coinsAfter.AmountOf(stock)))
+
+	require.True(t, input.handler(input.ctx, deposit).IsOK())
+	shares = input.bik.GetShares(input.ctx, symbol, tradeAddr)
+	biNew = input.bik.Load(input.ctx, symbol)
+	expected := biNew.CoinsOfShares(shares)
+	coinsBefore = input.akp.GetAccount(input.ctx, tradeAddr).GetCoins()
+	cancel := types.MsgBancorCancel{Owner: haveCetAddress, Stock: stock, Money: money}
+	require.True(t, input.handler(input.ctx, cancel).IsOK())
+	coinsAfter = input.akp.GetAccount(input.ctx, tradeAddr).GetCoins()
+	require.Equal(t, coinsBefore.Add(expected), coinsAfter)
+	require.Equal(t, int64(0), input.bik.GetShares(input.ctx, symbol, tradeAddr))
+}
+
+func Test_handleMsgBancorDepositWithTooManyProviders(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	require.True(t, prepareBancorInit(input))
+	symbol := stock + "/" + money
+	buy := types.MsgBancorTrade{Sender: tradeAddr, Stock: stock, Money: money, Amount: 400000, IsBuy: true}
+	require.True(t, input.handler(input.ctx, buy).IsOK())
+	for i := 0; i < types.MaxProvidersPerPool; i++ {
+		input.bik.SetShares(input.ctx, symbol, sdk.AccAddress(fmt.Sprintf("provider%03d", i)), 1)
+	}
+	deposit := types.MsgBancorDeposit{Sender: tradeAddr, Stock: stock, Money: money, Amount: 100000}
+	require.Equal(t, types.ErrTooManyProviders().Result().Log, input.handler(input.ctx, deposit).Log)
+
+	// a new provider can join after another one leaves
+	input.bik.SetShares(input.ctx, symbol, sdk.AccAddress("provider000"), 0)
+	require.True(t, input.handler(input.ctx, deposit).IsOK())
+}
+
+func Test_handleMsgBancorModify(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	require.True(t, prepareBancorInit(input))
+	symbol := stock + "/" + money
+	bi := input.bik.Load(input.ctx, symbol)
+
+	modify := types.MsgBancorModify{Owner: tradeAddr, Stock: stock, Money: money, EarliestCancelTime: 100}
+	require.Equal(t, types.ErrNotBancorOwner().Result().Log, input.handler(input.ctx, modify).Log)
+	modify = types.MsgBancorModify{Owner: haveCetAddress, Stock: stock, Money: money, ChangeOwnerFee: true,
+		OwnerFeeRate: types.DefaultMaxOwnerFeeRate + 1}
+	require.Equal(t, types.CodeInvalidModification, input.handler(input.ctx, modify).Code)
+	modify2 := types.MsgBancorModify{Owner: haveCetAddress, Stock: stock, Money: money, SupplyIncrease: 200000}
+	earlierCtx := input.ctx.WithBlockTime(input.ctx.BlockHeader().Time.Add(-time.Hour))
+	require.True(t, input.handler(earlierCtx, modify2).IsOK())
+	// a new modification replaces the pending one and restarts the timelock
+	modify = types.MsgBancorModify{Owner: haveCetAddress, Stock: stock, Money: money, EarliestCancelTime: 100,
+		SupplyIncrease: 100000, ChangeOwnerFee: true, OwnerFeeRate: 50}
+	require.True(t, input.handler(input.ctx, modify).IsOK())
+
+	querier := keepers.NewQuerier(input.bik)
+	param := keepers.QueryBancorInfoParam{Symbol: symbol}
+	bz, err := querier(input.ctx, []string{keepers.QueryModification}, abci.RequestQuery{Data: input.cdc.MustMarshalJSON(param)})
+	require.Nil(t, err)
+	var mod keepers.BancorModification
+	input.cdc.MustUnmarshalJSON(bz, &mod)
+	executionTime := input.ctx.BlockHeader().Time.Unix() + types.DefaultModifyTimelock
+	require.Equal(t, keepers.NewBancorModification(modify, executionTime), mod)
+
+	// nothing changes before the timelock expires
+	bancorlite.EndBlocker(input.ctx, input.bik)
+	require.Equal(t, bi, input.bik.Load(input.ctx, symbol))
+	replacedCtx := input.ctx.WithBlockTime(time.Unix(executionTime-3600, 0))
+	require.Equal(t, 0, len(input.bik.GetDueModifications(replacedCtx, executionTime-1)))
+	bancorlite.EndBlocker(replacedCtx, input.bik)
+	require.Equal(t, bi, input.bik.Load(input.ctx, symbol))
+	require.Equal(t, 1, len(input.bik.GetDueModifications(input.ctx, executionTime)))
+
+	ownerCoins := input.akp.GetAccount(input.ctx, haveCetAddress).GetCoins()
+	ctx := input.ctx.WithBlockTime(time.Unix(executionTime, 0))
+	bancorlite.EndBlocker(ctx, input.bik)
+	require.Nil(t, input.bik.GetModification(ctx, symbol))
+	biNew := input.bik.Load(ctx, symbol)
+	require.True(t, biNew.IsConsistent())
+	require.Equal(t, int64(100), biNew.EarliestCancelTime)
+	require.Equal(t, int64(50), biNew.OwnerFeeRate)
+	require.Equal(t, bi.MaxSupply.AddRaw(100000), biNew.MaxSupply)
+	require.Equal(t, bi.StockInPool.AddRaw(100000), biNew.StockInPool)
+	require.Equal(t, bi.MoneyInPool, biNew.MoneyInPool)
+	ownerCoinsNew := input.akp.GetAccount(ctx, haveCetAddress).GetCoins()
+	require.Equal(t, int64(100000), ownerCoins.AmountOf(stock).Sub(ownerCoinsNew.AmountOf(stock)).Int64())
+	require.Equal(t, ownerCoins.AmountOf(money), ownerCoinsNew.AmountOf(money))
+
+	buy := types.MsgBancorTrade{Sender: tradeAddr, Stock: stock, Money: money, Amount: 100000, IsBuy: true}
+	require.True(t, input.handler(ctx, buy).IsOK())
+	require.True(t, input.bik.Load(ctx, symbol).CommissionInPool > 0)
+
+	// the stretched curve would need less money for the supplied stock, which belongs to the buyers
+	require.Equal(t, types.CodeInvalidModification, input.handler(ctx, modify2).Code)
+
+	// cancelling the pool drops its pending modification
+	modify = types.MsgBancorModify{Owner: haveCetAddress, Stock: stock, Money: money, EarliestCancelTime: 200}
+	require.True(t, input.handler(ctx, modify).IsOK())
+	require.NotNil(t, input.bik.GetModification(ctx, symbol))
+	require.Equal(t, 1, len(input.bik.GetTrades(ctx, symbol)))
+	require.True(t, input.handler(ctx, types.MsgBancorCancel{Owner: haveCetAddress, Stock: stock, Money: money}).IsOK())
+	require.Nil(t, input.bik.GetModification(ctx, symbol))
+	// so is the trade history
+	require.Equal(t, 0, len(input.bik.GetTrades(ctx, symbol)))
+	require.Equal(t, 0, len(input.bik.GetClosingPrices(ctx, symbol)))
+}
+
+func Test_handleMsgBancorSwap(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	symbol := stock + "/" + money
+	init := types.MsgBancorInit{Owner: haveCetAddress, Stock: stock, Money: money, InitPrice: "1",
+		MaxSupply: sdk.NewInt(10000), MaxPrice: "10", MaxMoney: sdk.ZeroInt()}
+	require.True(t, input.handler(input.ctx, init).IsOK())
+
+	// orders on the market need a full length address
+	_, _, trader := testutil.KeyPubAddr()
+	traderAcc := input.akp.NewAccountWithAddress(input.ctx, trader)
+	_ = traderAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(money, sdk.NewInt(issueAmount)),
+		sdk.NewCoin(dex.CET, sdk.NewInt(issueAmount))))
+	input.akp.SetAccount(input.ctx, traderAcc)
+
+	// without an order book, only the pool trades
+	swap := types.MsgBancorSwap{Sender: trader, Stock: stock, Money: money, Amount: 3000, IsBuy: true, Price: 1}
+	require.Equal(t, types.CodeNoSwapRoute, input.handler(input.ctx, swap).Code)
+
+	_ = input.mk.SetMarket(input.ctx, market.MarketInfo{Stock: stock, Money: money, PricePrecision: 8,
+		LastExecutedPrice: sdk.NewDec(2)})
+	ask := market.MsgCreateOrder{Sender: haveCetAddress, TradingPair: symbol, OrderType: market.LimitOrder,
+		Price: 2, Quantity: 1000, Side: market.SELL, TimeInForce: market.GTE}
+	require.True(t, market.NewHandler(input.mk)(input.ctx, ask).IsOK())
+
+	// the pool trades until its price reaches the ask's, then the ask is taken,
+	// and the pool trades again until its price reaches the limit
+	swap.Price = 3
+	bi := input.bik.Load(input.ctx, symbol)
+	route, err := input.bik.CalculateSwapRoute(input.ctx, bi, swap.Amount, swap.IsBuy, swap.LimitPrice())
+	require.Nil(t, err)
+	require.Equal(t, int64(2000), route.BancorAmount)
+	require.Equal(t, int64(1000), route.OrderAmount)
+	require.Equal(t, int64(0), route.Unrouted)
+
+	moneyBefore := input.akp.GetAccount(input.ctx, trader).GetCoins().AmountOf(money)
+	res := input.handler(input.ctx, swap)
+	require.True(t, res.IsOK(), res.Log)
+	biNew := input.bik.Load(input.ctx, symbol)
+	require.Equal(t, bi.StockInPool.SubRaw(route.BancorAmount), biNew.StockInPool)
+	require.True(t, biNew.Price.LTE(swap.LimitPrice()))
+	acc := input.akp.GetAccount(input.ctx, trader)
+	require.Equal(t, int64(2000), acc.GetCoins().AmountOf(stock).Int64())
+	trades := input.bik.GetTrades(input.ctx, symbol)
+	require.Equal(t, 1, len(trades))
+	require.Equal(t, int64(2000), trades[0].Amount)
+	require.Equal(t, route.BancorTrade.Money, trades[0].Money)
+	require.Equal(t, biNew.Price, input.bik.GetClosingPrices(input.ctx, symbol)[0].Price)
+
+	var orderID string
+	for _, e := range res.Events {
+		if e.Type != bancorlite.EventTypeKeyBancorSwap {
+			continue
+		}
+		for _, attr := range e.Attributes {
+			switch string(attr.Key) {
+			case bancorlite.AttributeBancorAmount:
+				require.Equal(t, "2000", string(attr.Value))
+			case bancorlite.AttributeOrderAmount:
+				require.Equal(t, "1000", string(attr.Value))
+			case bancorlite.AttributeOrderID:
+				orderID = string(attr.Value)
+			}
+		}
+	}
+	require.NotEmpty(t, orderID)
+	var ioc *market.Order
+	for _, order := range input.mk.GetAllOrders(input.ctx) {
+		if order.OrderID() == orderID {
+			ioc = order
+		}
+	}
+	require.NotNil(t, ioc)
+	require.Equal(t, int64(market.IOC), ioc.TimeInForce)
+	require.Equal(t, byte(market.BUY), ioc.Side)
+	require.Equal(t, int64(1000), ioc.Quantity)
+	require.Equal(t, sdk.NewDec(3), ioc.Price)
+	// money is paid to the pool, and frozen by the IOC order
+	require.Equal(t, route.BancorTrade.Money.AddRaw(ioc.Freeze), moneyBefore.Sub(acc.GetCoins().AmountOf(money)))
+}
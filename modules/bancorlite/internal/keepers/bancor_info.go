@@ -2,6 +2,8 @@ package keepers
 
 import (
 	"fmt"
+	"math"
+	"math/big"
 
 	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/types"
 
@@ -11,31 +13,64 @@ import (
 )
 
 type BancorInfo struct {
-	Owner              sdk.AccAddress `json:"sender"`
-	Stock              string         `json:"stock"`
-	Money              string         `json:"money"`
-	InitPrice          sdk.Dec        `json:"init_price"`
-	MaxSupply          sdk.Int        `json:"max_supply"`
-	StockPrecision     byte           `json:"stock_precision"`
-	MaxPrice           sdk.Dec        `json:"max_price"`
-	MaxMoney           sdk.Int        `json:"max_money"` // DEX2
-	AR                 int64          `json:"ar"`        // DEX2
-	Price              sdk.Dec        `json:"price"`
-	StockInPool        sdk.Int        `json:"stock_in_pool"`
-	MoneyInPool        sdk.Int        `json:"money_in_pool"`
-	EarliestCancelTime int64          `json:"earliest_cancel_time"`
+	Owner              sdk.AccAddress     `json:"sender"`
+	Stock              string             `json:"stock"`
+	Money              string             `json:"money"`
+	InitPrice          sdk.Dec            `json:"init_price"`
+	MaxSupply          sdk.Int            `json:"max_supply"`
+	StockPrecision     byte               `json:"stock_precision"`
+	MaxPrice           sdk.Dec            `json:"max_price"`
+	MaxMoney           sdk.Int            `json:"max_money"` // DEX2
+	AR                 int64              `json:"ar"`        // DEX2
+	Price              sdk.Dec            `json:"price"`
+	StockInPool        sdk.Int            `json:"stock_in_pool"`
+	MoneyInPool        sdk.Int            `json:"money_in_pool"`
+	EarliestCancelTime int64              `json:"earliest_cancel_time"`
+	CurveType          byte               `json:"curve_type"`
+	CurveParam         int64              `json:"curve_param"`
+	Breakpoints        []types.Breakpoint `json:"breakpoints"`
+	// shares of the owner and all the liquidity providers, zero for pools created before shares
+	// were introduced, whose owner implicitly holds MaxSupply shares
+	TotalShares int64 `json:"total_shares"`
+	// CET commission paid to the pool by traders, it belongs to the owner and the providers
+	CommissionInPool int64 `json:"commission_in_pool"`
+	// the fee charged for the pool in CET besides the commission, in 1/10000 of the trade volume
+	OwnerFeeRate int64 `json:"owner_fee_rate"`
 }
 
 func (bi *BancorInfo) GetSymbol() string {
 	return dex.GetSymbol(bi.Stock, bi.Money)
 }
 
+func (bi *BancorInfo) GetTotalShares() int64 {
+	if bi.TotalShares == 0 {
+		return bi.MaxSupply.Int64()
+	}
+	return bi.TotalShares
+}
+
+// the smallest stock amount can be traded, decided by StockPrecision
+func (bi *BancorInfo) stockUnit() sdk.Int {
+	if bi.StockPrecision != 0 && bi.StockPrecision <= 8 {
+		return sdk.NewInt(int64(math.Pow10(int(bi.StockPrecision))))
+	}
+	return sdk.OneInt()
+}
+
 func (bi *BancorInfo) UpdateStockInPool(stockInPool sdk.Int) bool {
 	if stockInPool.IsNegative() || stockInPool.GT(bi.MaxSupply) {
 		return false
 	}
 	bi.StockInPool = stockInPool
 	suppliedStock := bi.MaxSupply.Sub(bi.StockInPool)
+	switch bi.CurveType {
+	case types.CurveExponential, types.CurveLogistic:
+		bi.updateByCurveTable(suppliedStock)
+		return true
+	case types.CurvePiecewiseLinear:
+		bi.updateByBreakpoints(suppliedStock)
+		return true
+	}
 	if bi.MaxMoney.IsZero() {
 		bi.Price = bi.MaxPrice.Sub(bi.InitPrice).MulInt(suppliedStock).QuoInt(bi.MaxSupply).Add(bi.InitPrice)
 		bi.MoneyInPool = bi.Price.Add(bi.InitPrice).MulInt(suppliedStock).QuoInt64(2).RoundInt()
@@ -74,10 +109,65 @@ func (bi *BancorInfo) UpdateStockInPool(stockInPool sdk.Int) bool {
 	return true
 }
 
+func (bi *BancorInfo) updateByCurveTable(suppliedStock sdk.Int) {
+	priceTable, moneyTable := &types.ExpPriceTable, &types.ExpMoneyTable
+	if bi.CurveType == types.CurveLogistic {
+		priceTable, moneyTable = &types.LogisticPriceTable, &types.LogisticMoneyTable
+	}
+	// s = s/s_max * 1000, as of precision is 0.001
+	factoredStock := suppliedStock.MulRaw(types.SupplyRatioSamples)
+	s := factoredStock.Quo(bi.MaxSupply).Int64()
+	priceRatio := types.CurveTableLookup(priceTable, bi.CurveParam, s)
+	moneyRatio := types.CurveTableLookup(moneyTable, bi.CurveParam, s)
+	if rest := factoredStock.Sub(sdk.NewInt(s).Mul(bi.MaxSupply)); rest.IsPositive() {
+		// ratio = (ratioNear - ratio) * (stock_now / s_max * 1000 - (s)) + ratio
+		priceRatioNear := types.CurveTableLookup(priceTable, bi.CurveParam, s+1)
+		priceRatio = priceRatioNear.Sub(priceRatio).MulInt(rest).Quo(sdk.NewDecFromInt(bi.MaxSupply)).Add(priceRatio)
+		moneyRatioNear := types.CurveTableLookup(moneyTable, bi.CurveParam, s+1)
+		moneyRatio = moneyRatioNear.Sub(moneyRatio).MulInt(rest).Quo(sdk.NewDecFromInt(bi.MaxSupply)).Add(moneyRatio)
+	}
+	// m_now = price_init * s_now + (price_max - price_init) * s_max * money_ratio
+	bi.MoneyInPool = bi.MaxPrice.Sub(bi.InitPrice).MulInt(bi.MaxSupply).Mul(moneyRatio).
+		Add(bi.InitPrice.MulInt(suppliedStock)).TruncateInt()
+	// price = priceRatio * (maxPrice - initPrice) + initPrice
+	bi.Price = priceRatio.MulTruncate(bi.MaxPrice.Sub(bi.InitPrice)).Add(bi.InitPrice)
+}
+
+func (bi *BancorInfo) updateByBreakpoints(suppliedStock sdk.Int) {
+	points := append([]types.Breakpoint{{Supply: sdk.ZeroInt(), Price: bi.InitPrice}}, bi.Breakpoints...)
+	points = append(points, types.Breakpoint{Supply: bi.MaxSupply, Price: bi.MaxPrice})
+	money := sdk.ZeroDec()
+	for i := 1; i < len(points); i++ {
+		low, high := points[i-1], points[i]
+		if suppliedStock.GT(high.Supply) {
+			money = money.Add(high.Price.Add(low.Price).MulInt(high.Supply.Sub(low.Supply)).QuoInt64(2))
+			continue
+		}
+		// the same as the linear curve, within the segment where suppliedStock lies
+		segmentStock := suppliedStock.Sub(low.Supply)
+		bi.Price = high.Price.Sub(low.Price).MulInt(segmentStock).QuoInt(high.Supply.Sub(low.Supply)).Add(low.Price)
+		money = money.Add(bi.Price.Add(low.Price).MulInt(segmentStock).QuoInt64(2))
+		break
+	}
+	bi.MoneyInPool = money.RoundInt()
+}
+
 func (bi *BancorInfo) IsConsistent() bool {
 	if bi.StockInPool.IsNegative() || bi.StockInPool.GT(bi.MaxSupply) {
 		return false
 	}
+	if bi.TotalShares < 0 || bi.CommissionInPool < 0 || bi.OwnerFeeRate < 0 {
+		return false
+	}
+	if types.CheckCurve(bi.CurveType, bi.CurveParam, bi.Breakpoints,
+		bi.InitPrice, bi.MaxPrice, bi.MaxSupply, bi.MaxMoney) != nil {
+		return false
+	}
+	if bi.CurveType != types.CurvePower {
+		biNew := *bi
+		biNew.UpdateStockInPool(biNew.StockInPool)
+		return bi.AR == 0 && bi.MoneyInPool.Equal(biNew.MoneyInPool) && bi.Price.Equal(biNew.Price)
+	}
 	suppliedStock := bi.MaxSupply.Sub(bi.StockInPool)
 	if bi.InitPrice.Equal(bi.MaxPrice) {
 		if !bi.MaxMoney.Equal(bi.InitPrice.MulInt(bi.MaxSupply).TruncateInt()) || bi.AR != 0 {
@@ -104,7 +194,7 @@ func (bi *BancorInfo) IsConsistent() bool {
 		MaxMoney:  bi.MaxMoney,
 	}
 	ar, ok := types.CheckAR(biMsg, bi.InitPrice, bi.MaxPrice)
-	if ok || ar != bi.AR {
+	if !ok || ar != bi.AR {
 		return false
 	}
 	biNew := *bi
@@ -116,19 +206,25 @@ func (bi *BancorInfo) IsConsistent() bool {
 }
 
 type BancorInfoDisplay struct {
-	Owner              string `json:"owner"`
-	Stock              string `json:"stock"`
-	Money              string `json:"money"`
-	InitPrice          string `json:"init_price"`
-	MaxSupply          string `json:"max_supply"`
-	StockPrecision     string `json:"stock_precision"`
-	MaxPrice           string `json:"max_price"`
-	MaxMoney           string `json:"max_money"`
-	AR                 string `json:"ar"`
-	CurrentPrice       string `json:"current_price"`
-	StockInPool        string `json:"stock_in_pool"`
-	MoneyInPool        string `json:"money_in_pool"`
-	EarliestCancelTime int64  `json:"earliest_cancel_time"`
+	Owner              string             `json:"owner"`
+	Stock              string             `json:"stock"`
+	Money              string             `json:"money"`
+	InitPrice          string             `json:"init_price"`
+	MaxSupply          string             `json:"max_supply"`
+	StockPrecision     string             `json:"stock_precision"`
+	MaxPrice           string             `json:"max_price"`
+	MaxMoney           string             `json:"max_money"`
+	AR                 string             `json:"ar"`
+	CurrentPrice       string             `json:"current_price"`
+	StockInPool        string             `json:"stock_in_pool"`
+	MoneyInPool        string             `json:"money_in_pool"`
+	EarliestCancelTime int64              `json:"earliest_cancel_time"`
+	CurveType          string             `json:"curve_type"`
+	CurveParam         string             `json:"curve_param"`
+	Breakpoints        []types.Breakpoint `json:"breakpoints"`
+	TotalShares        string             `json:"total_shares"`
+	CommissionInPool   string             `json:"commission_in_pool"`
+	OwnerFeeRate       string             `json:"owner_fee_rate"`
 }
 
 func NewBancorInfoDisplay(bi *BancorInfo) BancorInfoDisplay {
@@ -146,5 +242,144 @@ func NewBancorInfoDisplay(bi *BancorInfo) BancorInfoDisplay {
 		StockInPool:        bi.StockInPool.String(),
 		MoneyInPool:        bi.MoneyInPool.String(),
 		EarliestCancelTime: bi.EarliestCancelTime,
+		CurveType:          types.CurveName(bi.CurveType),
+		CurveParam:         fmt.Sprintf("%d", bi.CurveParam),
+		Breakpoints:        bi.Breakpoints,
+		TotalShares:        fmt.Sprintf("%d", bi.GetTotalShares()),
+		CommissionInPool:   fmt.Sprintf("%d", bi.CommissionInPool),
+		OwnerFeeRate:       fmt.Sprintf("%d", bi.OwnerFeeRate),
+	}
+}
+
+// StockAmountForMoney returns, when buying, the largest stock amount whose cost does not exceed 'money',
+// and when selling, the smallest stock amount which brings in at least 'money'. The returned amount
+// is always a multiple of the unit decided by StockPrecision. It returns false if even selling all
+// the supplied stock can not bring in 'money'.
+func (bi *BancorInfo) StockAmountForMoney(money sdk.Int, isBuy bool) (int64, bool) {
+	unit := bi.stockUnit()
+	suppliedStock := bi.MaxSupply.Sub(bi.StockInPool)
+	moneyOf := func(n int64) (sdk.Int, bool) {
+		amount := unit.MulRaw(n)
+		stockInPool := bi.StockInPool.Add(amount)
+		if isBuy {
+			stockInPool = bi.StockInPool.Sub(amount)
+		}
+		biNew := *bi
+		if !biNew.UpdateStockInPool(stockInPool) {
+			return sdk.ZeroInt(), false
+		}
+		if isBuy {
+			return biNew.MoneyInPool.Sub(bi.MoneyInPool), true
+		}
+		return bi.MoneyInPool.Sub(biNew.MoneyInPool), true
+	}
+
+	if isBuy {
+		maxUnits := bi.StockInPool.Quo(unit).Int64()
+		fits := func(n int64) bool {
+			cost, ok := moneyOf(n)
+			return ok && cost.LTE(money)
+		}
+		if !bi.isLinear() {
+			return unit.MulRaw(lastTrue(0, maxUnits, fits)).Int64(), true
+		}
+		supplied := bi.linearSupplyForMoney(bi.MoneyInPool.Add(money))
+		guess := supplied.Sub(suppliedStock).Quo(unit)
+		return unit.MulRaw(lastTrueNear(0, maxUnits, guess, fits)).Int64(), true
+	}
+
+	maxUnits := suppliedStock.Quo(unit).Int64()
+	notReached := func(n int64) bool {
+		income, ok := moneyOf(n)
+		return !ok || income.LT(money)
+	}
+	var n int64
+	if !bi.isLinear() {
+		n = lastTrue(0, maxUnits, notReached)
+	} else {
+		if bi.MoneyInPool.LT(money) {
+			return 0, false
+		}
+		supplied := bi.linearSupplyForMoney(bi.MoneyInPool.Sub(money))
+		guess := suppliedStock.Sub(supplied).Quo(unit)
+		n = lastTrueNear(0, maxUnits, guess, notReached)
+	}
+	if n == maxUnits {
+		return 0, false
+	}
+	return unit.MulRaw(n + 1).Int64(), true
+}
+
+func (bi *BancorInfo) isLinear() bool {
+	return bi.CurveType == types.CurvePower && bi.MaxMoney.IsZero()
+}
+
+// linearSupplyForMoney inverts the linear curve, returning the supplied stock 's' which makes
+// moneyInPool = initPrice*s + (maxPrice-initPrice)*s*s/(2*maxSupply), rounded down.
+func (bi *BancorInfo) linearSupplyForMoney(moneyInPool sdk.Int) sdk.Int {
+	// Dec's underlying integers are scaled by 10^Precision, so with A and I standing for
+	// (maxPrice-initPrice) and initPrice scaled, and S for maxSupply, we solve:
+	// A*s*s + 2*S*I*s - 2*S*m*10^Precision = 0
+	a := bi.MaxPrice.Sub(bi.InitPrice).Int
+	i := bi.InitPrice.Int
+	s := bi.MaxSupply.BigInt()
+	m := new(big.Int).Mul(moneyInPool.BigInt(), precisionMultiplier)
+	if a.Sign() == 0 {
+		if i.Sign() == 0 {
+			return bi.MaxSupply
+		}
+		return sdk.NewIntFromBigInt(new(big.Int).Quo(m, i))
+	}
+	si := new(big.Int).Mul(s, i)
+	disc := new(big.Int).Mul(si, si)
+	disc.Add(disc, new(big.Int).Mul(new(big.Int).Mul(big.NewInt(2), s), new(big.Int).Mul(a, m)))
+	root := new(big.Int).Sqrt(disc)
+	return sdk.NewIntFromBigInt(root.Sub(root, si).Quo(root, a))
+}
+
+var precisionMultiplier = new(big.Int).Exp(big.NewInt(10), big.NewInt(sdk.Precision), nil)
+
+// lastTrue returns the largest n in [lo, hi] for which pred holds,
+// pred must hold for lo and must not hold again once it fails
+func lastTrue(lo, hi int64, pred func(int64) bool) int64 {
+	for lo < hi {
+		mid := lo + (hi-lo+1)/2
+		if pred(mid) {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	return lo
+}
+
+// lastTrueNear works like lastTrue, but starts searching from 'guess' with growing steps,
+// so that it only needs a few evaluations of pred when 'guess' is close to the answer
+func lastTrueNear(lo, hi int64, guess sdk.Int, pred func(int64) bool) int64 {
+	g := lo
+	if guess.GT(sdk.NewInt(hi)) {
+		g = hi
+	} else if guess.GT(sdk.NewInt(lo)) {
+		g = guess.Int64()
+	}
+	if pred(g) {
+		for step := int64(1); g+step <= hi; step *= 2 {
+			if !pred(g + step) {
+				hi = g + step - 1
+				break
+			}
+			g += step
+		}
+		lo = g
+	} else {
+		for step := int64(1); g-step >= lo; step *= 2 {
+			if pred(g - step) {
+				lo = g - step
+				break
+			}
+			g -= step
+		}
+		hi = g - 1
 	}
+	return lastTrue(lo, hi, pred)
 }
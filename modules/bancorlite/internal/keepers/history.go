@@ -0,0 +1,207 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BancorTradeRecord is a trade with a bancor pool. The recent trades are kept on chain,
+// so that the nodes without Kafka can show the activity of the pool.
+type BancorTradeRecord struct {
+	Sequence uint64 `json:"sequence"`
+	// market.BUY or market.SELL, taken by the trader
+	Side   byte    `json:"side"`
+	Amount int64   `json:"amount"`
+	Money  sdk.Int `json:"money"`
+	// the average price of the trade
+	Price  sdk.Dec `json:"price"`
+	Height int64   `json:"height"`
+}
+
+// BancorClosingPrice is the price on the curve of a bancor pool after the last trade in a block
+type BancorClosingPrice struct {
+	Height int64   `json:"height"`
+	Price  sdk.Dec `json:"price"`
+}
+
+// BancorHistory is the recent trades and closing prices of a pool, as they are kept in the genesis file
+type BancorHistory struct {
+	Symbol        string               `json:"symbol"`
+	NextSequence  uint64               `json:"next_sequence"`
+	Trades        []BancorTradeRecord  `json:"trades"`
+	ClosingPrices []BancorClosingPrice `json:"closing_prices"`
+}
+
+// the bookkeeping of the ring buffers of one pool
+type bancorHistory struct {
+	NextSequence uint64 `json:"next_sequence"`
+	TradeCount   int64  `json:"trade_count"`
+	PriceCount   int64  `json:"price_count"`
+}
+
+func getTradePrefix(symbol string) []byte {
+	key := append(append([]byte{}, BancorTradeKey...), []byte(symbol)...)
+	return append(key, 0x0)
+}
+
+func getClosingPricePrefix(symbol string) []byte {
+	key := append(append([]byte{}, BancorClosingPriceKey...), []byte(symbol)...)
+	return append(key, 0x0)
+}
+
+func getTradeKey(symbol string, sequence uint64) []byte {
+	return append(getTradePrefix(symbol), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+func getClosingPriceKey(symbol string, height int64) []byte {
+	return append(getClosingPricePrefix(symbol), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func getHistoryKey(symbol string) []byte {
+	return append(append([]byte{}, BancorHistoryKey...), []byte(symbol)...)
+}
+
+func (keeper *BancorInfoKeeper) getHistory(ctx sdk.Context, symbol string) (history bancorHistory) {
+	value := ctx.KVStore(keeper.biKey).Get(getHistoryKey(symbol))
+	if value != nil {
+		keeper.codec.MustUnmarshalBinaryBare(value, &history)
+	}
+	return
+}
+
+// AddTrade appends a trade to the pool's recent trades, and sets the pool's closing price of the
+// current block. Only the latest 'size' trades and closing prices are kept.
+func (keeper *BancorInfoKeeper) AddTrade(ctx sdk.Context, symbol string, record BancorTradeRecord, price sdk.Dec, size int64) {
+	store := ctx.KVStore(keeper.biKey)
+	history := keeper.getHistory(ctx, symbol)
+	tradePrefix := getTradePrefix(symbol)
+	pricePrefix := getClosingPricePrefix(symbol)
+	if size > 0 {
+		record.Sequence = history.NextSequence
+		history.NextSequence++
+		history.TradeCount++
+		store.Set(getTradeKey(symbol, record.Sequence), keeper.codec.MustMarshalBinaryBare(record))
+
+		priceKey := getClosingPriceKey(symbol, ctx.BlockHeight())
+		if !store.Has(priceKey) {
+			history.PriceCount++
+		}
+		closingPrice := BancorClosingPrice{Height: ctx.BlockHeight(), Price: price}
+		store.Set(priceKey, keeper.codec.MustMarshalBinaryBare(closingPrice))
+	}
+	// more than one record is removed only after the size is reduced
+	history.TradeCount -= removeOldest(store, tradePrefix, history.TradeCount-size)
+	history.PriceCount -= removeOldest(store, pricePrefix, history.PriceCount-size)
+	store.Set(getHistoryKey(symbol), keeper.codec.MustMarshalBinaryBare(history))
+}
+
+// removeOldest removes at most 'n' records at the beginning of 'prefix', and returns how many are removed
+func removeOldest(store sdk.KVStore, prefix []byte, n int64) (removed int64) {
+	if n <= 0 {
+		return 0
+	}
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	var keys [][]byte
+	for ; iter.Valid() && int64(len(keys)) < n; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return int64(len(keys))
+}
+
+// RemoveHistory removes the recent trades and closing prices of a cancelled pool, so that they are not
+// mixed into the history of a new pool with the same symbol
+func (keeper *BancorInfoKeeper) RemoveHistory(ctx sdk.Context, symbol string) {
+	store := ctx.KVStore(keeper.biKey)
+	history := keeper.getHistory(ctx, symbol)
+	removeOldest(store, getTradePrefix(symbol), history.TradeCount)
+	removeOldest(store, getClosingPricePrefix(symbol), history.PriceCount)
+	store.Delete(getHistoryKey(symbol))
+}
+
+// GetTrades returns the recent trades of a pool, the latest first
+func (keeper *BancorInfoKeeper) GetTrades(ctx sdk.Context, symbol string) []BancorTradeRecord {
+	iter := sdk.KVStoreReversePrefixIterator(ctx.KVStore(keeper.biKey), getTradePrefix(symbol))
+	defer iter.Close()
+	records := make([]BancorTradeRecord, 0, 16)
+	for ; iter.Valid(); iter.Next() {
+		var record BancorTradeRecord
+		keeper.codec.MustUnmarshalBinaryBare(iter.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// GetClosingPrices returns the recent closing prices of a pool, the latest first
+func (keeper *BancorInfoKeeper) GetClosingPrices(ctx sdk.Context, symbol string) []BancorClosingPrice {
+	iter := sdk.KVStoreReversePrefixIterator(ctx.KVStore(keeper.biKey), getClosingPricePrefix(symbol))
+	defer iter.Close()
+	prices := make([]BancorClosingPrice, 0, 16)
+	for ; iter.Valid(); iter.Next() {
+		var price BancorClosingPrice
+		keeper.codec.MustUnmarshalBinaryBare(iter.Value(), &price)
+		prices = append(prices, price)
+	}
+	return prices
+}
+
+// GetHistory returns the recent trades and closing prices of a pool for exporting, the latest first
+func (keeper *BancorInfoKeeper) GetHistory(ctx sdk.Context, symbol string) BancorHistory {
+	return BancorHistory{
+		Symbol:        symbol,
+		NextSequence:  keeper.getHistory(ctx, symbol).NextSequence,
+		Trades:        keeper.GetTrades(ctx, symbol),
+		ClosingPrices: keeper.GetClosingPrices(ctx, symbol),
+	}
+}
+
+// SetHistory imports the recent trades and closing prices of a pool, which must have none
+func (keeper *BancorInfoKeeper) SetHistory(ctx sdk.Context, h BancorHistory) {
+	store := ctx.KVStore(keeper.biKey)
+	for _, record := range h.Trades {
+		store.Set(getTradeKey(h.Symbol, record.Sequence), keeper.codec.MustMarshalBinaryBare(record))
+	}
+	for _, price := range h.ClosingPrices {
+		store.Set(getClosingPriceKey(h.Symbol, price.Height), keeper.codec.MustMarshalBinaryBare(price))
+	}
+	history := bancorHistory{
+		NextSequence: h.NextSequence,
+		TradeCount:   int64(len(h.Trades)),
+		PriceCount:   int64(len(h.ClosingPrices)),
+	}
+	store.Set(getHistoryKey(h.Symbol), keeper.codec.MustMarshalBinaryBare(history))
+}
+
+// RecordTrade keeps a trade with the pool 'bi', which is the pool after the trade
+func (keeper *Keeper) RecordTrade(ctx sdk.Context, bi *BancorInfo, side byte, amount int64, res TradeResult) {
+	record := BancorTradeRecord{
+		Side:   side,
+		Amount: amount,
+		Money:  res.Money,
+		Price:  res.TxPrice(amount),
+		Height: ctx.BlockHeight(),
+	}
+	keeper.bik.AddTrade(ctx, bi.GetSymbol(), record, bi.Price, keeper.GetParams(ctx).TradeHistorySize)
+}
+
+func (keeper *Keeper) GetTrades(ctx sdk.Context, symbol string) []BancorTradeRecord {
+	return keeper.bik.GetTrades(ctx, symbol)
+}
+
+func (keeper *Keeper) GetClosingPrices(ctx sdk.Context, symbol string) []BancorClosingPrice {
+	return keeper.bik.GetClosingPrices(ctx, symbol)
+}
+
+func (keeper *Keeper) RemoveHistory(ctx sdk.Context, symbol string) {
+	keeper.bik.RemoveHistory(ctx, symbol)
+}
+
+func (keeper *Keeper) GetHistory(ctx sdk.Context, symbol string) BancorHistory {
+	return keeper.bik.GetHistory(ctx, symbol)
+}
+
+func (keeper *Keeper) SetHistory(ctx sdk.Context, h BancorHistory) {
+	keeper.bik.SetHistory(ctx, h)
+}
@@ -1,11 +1,14 @@
 package keepers
 
 import (
+	"bytes"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 
 	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/types"
+	"github.com/coinexchain/cet-sdk/modules/market"
 	"github.com/coinexchain/cet-sdk/msgqueue"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
@@ -13,8 +16,38 @@ import (
 var (
 	BancorInfoKey    = []byte{0x10}
 	BancorInfoKeyEnd = []byte{0x11}
+	// key: BancorShareKey | symbol | 0x0 | provider
+	BancorShareKey    = []byte{0x12}
+	BancorShareKeyEnd = []byte{0x13}
+	// key: BancorModificationKey | symbol
+	BancorModificationKey    = []byte{0x14}
+	BancorModificationKeyEnd = []byte{0x15}
+	// key: BancorTradeKey | symbol | 0x0 | sequence
+	BancorTradeKey = []byte{0x16}
+	// key: BancorClosingPriceKey | symbol | 0x0 | height
+	BancorClosingPriceKey = []byte{0x17}
+	// key: BancorHistoryKey | symbol
+	BancorHistoryKey = []byte{0x18}
+	// key: BancorModificationDueKey | execution time | symbol
+	BancorModificationDueKey = []byte{0x19}
 )
 
+// BancorShare records the shares of a bancor pool held by a liquidity provider other than the owner
+type BancorShare struct {
+	Symbol   string         `json:"symbol"`
+	Provider sdk.AccAddress `json:"provider"`
+	Shares   int64          `json:"shares"`
+}
+
+func getShareKey(symbol string, provider sdk.AccAddress) []byte {
+	return append(getSharePrefix(symbol), provider...)
+}
+
+func getSharePrefix(symbol string) []byte {
+	key := append(append([]byte{}, BancorShareKey...), []byte(symbol)...)
+	return append(key, 0x0)
+}
+
 type BancorInfoKeeper struct {
 	biKey         sdk.StoreKey
 	codec         *codec.Codec
@@ -34,10 +67,33 @@ func (keeper *BancorInfoKeeper) SetParams(ctx sdk.Context, params types.Params)
 }
 
 func (keeper *BancorInfoKeeper) GetParams(ctx sdk.Context) (param types.Params) {
-	keeper.paramSubspace.GetParamSet(ctx, &param)
+	for _, pair := range param.ParamSetPairs() {
+		if !keeper.paramSubspace.Has(ctx, pair.Key) && setAddedParamDefault(&param, pair.Key) {
+			continue
+		}
+		keeper.paramSubspace.Get(ctx, pair.Key, pair.Value)
+	}
 	return
 }
 
+// The parameters added after the chain started are not in the store of the existing chains,
+// so their default values are used until they are changed by a proposal
+func setAddedParamDefault(param *types.Params, key []byte) bool {
+	switch {
+	case bytes.Equal(key, types.KeyProviderFeeRatio):
+		param.ProviderFeeRatio = types.DefaultProviderFeeRatio
+	case bytes.Equal(key, types.KeyModifyTimelock):
+		param.ModifyTimelock = types.DefaultModifyTimelock
+	case bytes.Equal(key, types.KeyMaxOwnerFeeRate):
+		param.MaxOwnerFeeRate = types.DefaultMaxOwnerFeeRate
+	case bytes.Equal(key, types.KeyTradeHistorySize):
+		param.TradeHistorySize = types.DefaultTradeHistorySize
+	default:
+		return false
+	}
+	return true
+}
+
 func (keeper *BancorInfoKeeper) Save(ctx sdk.Context, bi *BancorInfo) {
 	store := ctx.KVStore(keeper.biKey)
 	value := keeper.codec.MustMarshalBinaryBare(bi)
@@ -78,11 +134,117 @@ func (keeper *BancorInfoKeeper) Iterate(ctx sdk.Context, biProc func(bi *BancorI
 	}
 }
 
+// SetShares sets the shares held by a provider, and removes the record when they are zero
+func (keeper *BancorInfoKeeper) SetShares(ctx sdk.Context, symbol string, provider sdk.AccAddress, shares int64) {
+	store := ctx.KVStore(keeper.biKey)
+	key := getShareKey(symbol, provider)
+	if shares == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, keeper.codec.MustMarshalBinaryBare(shares))
+}
+
+func (keeper *BancorInfoKeeper) GetShares(ctx sdk.Context, symbol string, provider sdk.AccAddress) (shares int64) {
+	store := ctx.KVStore(keeper.biKey)
+	value := store.Get(getShareKey(symbol, provider))
+	if value != nil {
+		keeper.codec.MustUnmarshalBinaryBare(value, &shares)
+	}
+	return
+}
+
+// IterateShares visits the providers of the pool of 'symbol', or of all the pools when 'symbol' is empty
+func (keeper *BancorInfoKeeper) IterateShares(ctx sdk.Context, symbol string, shareProc func(share BancorShare)) {
+	store := ctx.KVStore(keeper.biKey)
+	start, end := BancorShareKey, BancorShareKeyEnd
+	if len(symbol) != 0 {
+		start = getSharePrefix(symbol)
+		end = append(start[:len(start)-1:len(start)-1], 0x1)
+	}
+	iter := store.Iterator(start, end)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[len(BancorShareKey):]
+		sep := bytes.IndexByte(key, 0x0)
+		share := BancorShare{
+			Symbol:   string(key[:sep]),
+			Provider: sdk.AccAddress(key[sep+1:]),
+		}
+		keeper.codec.MustUnmarshalBinaryBare(iter.Value(), &share.Shares)
+		shareProc(share)
+	}
+}
+
+func getModificationDueKey(executionTime int64, symbol string) []byte {
+	key := append(append([]byte{}, BancorModificationDueKey...), sdk.Uint64ToBigEndian(uint64(executionTime))...)
+	return append(key, []byte(symbol)...)
+}
+
+// the modifications are also indexed by their execution time, so that the EndBlocker only loads the due ones.
+// A pool has at most one pending modification, and a new one replaces the old one.
+func (keeper *BancorInfoKeeper) SetModification(ctx sdk.Context, mod BancorModification) {
+	keeper.RemoveModification(ctx, mod.Symbol)
+	store := ctx.KVStore(keeper.biKey)
+	key := append(append([]byte{}, BancorModificationKey...), []byte(mod.Symbol)...)
+	store.Set(key, keeper.codec.MustMarshalBinaryBare(mod))
+	store.Set(getModificationDueKey(mod.ExecutionTime, mod.Symbol), []byte{})
+}
+
+func (keeper *BancorInfoKeeper) GetModification(ctx sdk.Context, symbol string) *BancorModification {
+	store := ctx.KVStore(keeper.biKey)
+	value := store.Get(append(append([]byte{}, BancorModificationKey...), []byte(symbol)...))
+	if value == nil {
+		return nil
+	}
+	mod := &BancorModification{}
+	keeper.codec.MustUnmarshalBinaryBare(value, mod)
+	return mod
+}
+
+func (keeper *BancorInfoKeeper) RemoveModification(ctx sdk.Context, symbol string) {
+	mod := keeper.GetModification(ctx, symbol)
+	if mod == nil {
+		return
+	}
+	store := ctx.KVStore(keeper.biKey)
+	store.Delete(append(append([]byte{}, BancorModificationKey...), []byte(symbol)...))
+	store.Delete(getModificationDueKey(mod.ExecutionTime, symbol))
+}
+
+// GetDueModifications returns the modifications whose execution time is not later than currentTime,
+// in the order of their execution time
+func (keeper *BancorInfoKeeper) GetDueModifications(ctx sdk.Context, currentTime int64) []BancorModification {
+	store := ctx.KVStore(keeper.biKey)
+	iter := store.Iterator(BancorModificationDueKey, getModificationDueKey(currentTime+1, ""))
+	defer iter.Close()
+	var mods []BancorModification
+	for ; iter.Valid(); iter.Next() {
+		symbol := string(iter.Key()[len(BancorModificationDueKey)+8:])
+		if mod := keeper.GetModification(ctx, symbol); mod != nil {
+			mods = append(mods, *mod)
+		}
+	}
+	return mods
+}
+
+func (keeper *BancorInfoKeeper) IterateModifications(ctx sdk.Context, modProc func(mod BancorModification)) {
+	store := ctx.KVStore(keeper.biKey)
+	iter := store.Iterator(BancorModificationKey, BancorModificationKeyEnd)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var mod BancorModification
+		keeper.codec.MustUnmarshalBinaryBare(iter.Value(), &mod)
+		modProc(mod)
+	}
+}
+
 type Keeper struct {
 	bik         *BancorInfoKeeper
 	bxk         types.ExpectedBankxKeeper
 	ask         types.ExpectedAssetStatusKeeper
 	mk          types.ExpectedMarketKeeper
+	oc          types.ExpectedOrderCreator
 	axk         types.ExpectedAuthXKeeper
 	msgProducer msgqueue.MsgSender
 }
@@ -91,6 +253,7 @@ func NewKeeper(bik *BancorInfoKeeper,
 	bxk types.ExpectedBankxKeeper,
 	ask types.ExpectedAssetStatusKeeper,
 	mk types.ExpectedMarketKeeper,
+	oc types.ExpectedOrderCreator,
 	axk types.ExpectedAuthXKeeper,
 	mq msgqueue.MsgSender) Keeper {
 	return Keeper{
@@ -98,6 +261,7 @@ func NewKeeper(bik *BancorInfoKeeper,
 		bxk:         bxk,
 		ask:         ask,
 		mk:          mk,
+		oc:          oc,
 		axk:         axk,
 		msgProducer: mq,
 	}
@@ -127,8 +291,7 @@ func (keeper *Keeper) SetParams(ctx sdk.Context, params types.Params) {
 }
 
 func (keeper *Keeper) GetParams(ctx sdk.Context) (param types.Params) {
-	keeper.bik.paramSubspace.GetParamSet(ctx, &param)
-	return
+	return keeper.bik.GetParams(ctx)
 }
 
 func (keeper *Keeper) Save(ctx sdk.Context, bi *BancorInfo) {
@@ -147,6 +310,63 @@ func (keeper *Keeper) Iterate(ctx sdk.Context, biProc func(bi *BancorInfo)) {
 	keeper.bik.Iterate(ctx, biProc)
 }
 
+func (keeper *Keeper) SetShares(ctx sdk.Context, symbol string, provider sdk.AccAddress, shares int64) {
+	keeper.bik.SetShares(ctx, symbol, provider, shares)
+}
+
+func (keeper *Keeper) GetShares(ctx sdk.Context, symbol string, provider sdk.AccAddress) int64 {
+	return keeper.bik.GetShares(ctx, symbol, provider)
+}
+
+func (keeper *Keeper) IterateShares(ctx sdk.Context, symbol string, shareProc func(share BancorShare)) {
+	keeper.bik.IterateShares(ctx, symbol, shareProc)
+}
+
+// GetProviderCount returns the number of the liquidity providers of the pool of 'symbol'
+func (keeper *Keeper) GetProviderCount(ctx sdk.Context, symbol string) (count int) {
+	keeper.bik.IterateShares(ctx, symbol, func(share BancorShare) {
+		count++
+	})
+	return
+}
+
+// GetOwnerShares returns the shares of the owner, which are the shares not held by any provider
+func (keeper *Keeper) GetOwnerShares(ctx sdk.Context, bi *BancorInfo) int64 {
+	shares := bi.GetTotalShares()
+	keeper.IterateShares(ctx, bi.GetSymbol(), func(share BancorShare) {
+		shares -= share.Shares
+	})
+	return shares
+}
+
+// GetPosition returns the shares of 'provider' in the pool, who may also be its owner
+func (keeper *Keeper) GetPosition(ctx sdk.Context, bi *BancorInfo, provider sdk.AccAddress) int64 {
+	if bytes.Equal(bi.Owner, provider) {
+		return keeper.GetOwnerShares(ctx, bi)
+	}
+	return keeper.GetShares(ctx, bi.GetSymbol(), provider)
+}
+
+func (keeper *Keeper) SetModification(ctx sdk.Context, mod BancorModification) {
+	keeper.bik.SetModification(ctx, mod)
+}
+
+func (keeper *Keeper) GetModification(ctx sdk.Context, symbol string) *BancorModification {
+	return keeper.bik.GetModification(ctx, symbol)
+}
+
+func (keeper *Keeper) RemoveModification(ctx sdk.Context, symbol string) {
+	keeper.bik.RemoveModification(ctx, symbol)
+}
+
+func (keeper *Keeper) GetDueModifications(ctx sdk.Context, currentTime int64) []BancorModification {
+	return keeper.bik.GetDueModifications(ctx, currentTime)
+}
+
+func (keeper *Keeper) IterateModifications(ctx sdk.Context, modProc func(mod BancorModification)) {
+	keeper.bik.IterateModifications(ctx, modProc)
+}
+
 func (keeper *Keeper) SendCoins(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) sdk.Error {
 	return keeper.bxk.SendCoins(ctx, from, to, amt)
 }
@@ -172,6 +392,9 @@ func (keeper *Keeper) IsTokenIssuer(ctx sdk.Context, denom string, addr sdk.AccA
 func (keeper *Keeper) IsForbiddenByTokenIssuer(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
 	return keeper.ask.IsForbiddenByTokenIssuer(ctx, denom, addr)
 }
+func (keeper *Keeper) IsPermittedByTokenIssuer(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	return keeper.ask.IsPermittedByTokenIssuer(ctx, denom, addr)
+}
 
 func (keeper *Keeper) GetMarketVolume(ctx sdk.Context, stock, money string, stockVolume, moneyVolume sdk.Dec) sdk.Dec {
 	return keeper.mk.GetMarketVolume(ctx, stock, money, stockVolume, moneyVolume)
@@ -183,6 +406,15 @@ func (keeper *Keeper) IsMarketExist(ctx sdk.Context, symbol string) bool {
 func (keeper *Keeper) GetMarketFeeMin(ctx sdk.Context) int64 {
 	return keeper.mk.GetMarketFeeMin(ctx)
 }
+func (keeper *Keeper) GetMarketInfo(ctx sdk.Context, symbol string) (market.MarketInfo, error) {
+	return keeper.mk.GetMarketInfo(ctx, symbol)
+}
+func (keeper *Keeper) GetDepth(ctx sdk.Context, symbol string) (bids []market.DepthLevel, asks []market.DepthLevel) {
+	return keeper.mk.GetDepth(ctx, symbol)
+}
+func (keeper *Keeper) CreateOrder(ctx sdk.Context, msg market.MsgCreateOrder) (string, sdk.Error) {
+	return keeper.oc.CreateOrder(ctx, msg)
+}
 
 func (keeper *Keeper) GetRefereeAddr(ctx sdk.Context, accAddr sdk.AccAddress) sdk.AccAddress {
 	acc := keeper.axk.GetRefereeAddr(ctx, accAddr)
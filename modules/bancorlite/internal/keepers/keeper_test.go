@@ -11,8 +11,12 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 
 	"github.com/coinexchain/cet-sdk/modules/authx"
 	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/keepers"
@@ -229,6 +233,64 @@ func TestBancorInfo_IsConsistent(t *testing.T) {
 		})
 	}
 }
+func TestBancorInfo_IsConsistentWithMaxMoney(t *testing.T) {
+	bi := keepers.BancorInfo{
+		Owner:       owner,
+		Stock:       bch,
+		Money:       cet,
+		InitPrice:   sdk.NewDec(1),
+		MaxSupply:   sdk.NewInt(10000),
+		MaxPrice:    sdk.NewDec(10),
+		MaxMoney:    sdk.NewInt(30000),
+		StockInPool: sdk.NewInt(10000),
+	}
+	ar, ok := types.CheckAR(types.MsgBancorInit{MaxSupply: bi.MaxSupply, MaxMoney: bi.MaxMoney}, bi.InitPrice, bi.MaxPrice)
+	require.True(t, ok)
+	bi.AR = ar
+	require.True(t, bi.UpdateStockInPool(sdk.NewInt(4000)))
+	require.True(t, bi.IsConsistent())
+
+	bi.AR++
+	require.False(t, bi.IsConsistent())
+}
+
+func TestGetParamsWithOnlyOldKeys(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	subspace := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace).Subspace(types.DefaultParamspace)
+	keeper := keepers.NewBancorInfoKeeper(sdk.NewKVStoreKey(types.StoreKey), cdc, subspace)
+	param := types.DefaultParams()
+	param.CreateBancorFee = 100
+	param.ProviderFeeRatio = 1000
+	param.ModifyTimelock = 60
+	param.TradeHistorySize = 10
+	keeper.SetParams(ctx, param)
+	require.EqualValues(t, 1000, keeper.GetParams(ctx).ProviderFeeRatio)
+
+	// the chains started before these parameters were added only have the old keys in the store
+	paramStore := ctx.KVStore(keyParams)
+	for _, key := range [][]byte{types.KeyProviderFeeRatio, types.KeyModifyTimelock, types.KeyMaxOwnerFeeRate,
+		types.KeyTradeHistorySize} {
+		paramStore.Delete(append([]byte(types.DefaultParamspace+"/"), key...))
+	}
+	param = keeper.GetParams(ctx)
+	require.EqualValues(t, 100, param.CreateBancorFee)
+	require.EqualValues(t, types.DefaultCancelBancorFee, param.CancelBancorFee)
+	require.EqualValues(t, types.DefaultTradeFeeRate, param.TradeFeeRate)
+	require.EqualValues(t, types.DefaultProviderFeeRatio, param.ProviderFeeRatio)
+	require.EqualValues(t, types.DefaultModifyTimelock, param.ModifyTimelock)
+	require.EqualValues(t, types.DefaultMaxOwnerFeeRate, param.MaxOwnerFeeRate)
+	require.EqualValues(t, types.DefaultTradeHistorySize, param.TradeHistorySize)
+}
+
 func TestBancorInfoKeeper(t *testing.T) {
 	keeper, ctx := defaultContext()
 	bi := []keepers.BancorInfo{
@@ -276,6 +338,115 @@ func TestBancorInfoKeeper(t *testing.T) {
 	require.True(t, keeper.IsBancorExist(ctx, abc))
 }
 
+func TestBancorShares(t *testing.T) {
+	keeper, ctx := defaultContext()
+	alice, bob := sdk.AccAddress("alice"), sdk.AccAddress("bob")
+	keeper.SetShares(ctx, "abc/cet", alice, 100)
+	keeper.SetShares(ctx, "abc/cetx", alice, 200)
+	keeper.SetShares(ctx, "abc/cet", bob, 300)
+	require.Equal(t, int64(100), keeper.GetShares(ctx, "abc/cet", alice))
+	require.Equal(t, int64(0), keeper.GetShares(ctx, "bch/cet", alice))
+
+	var shares []keepers.BancorShare
+	keeper.IterateShares(ctx, "abc/cet", func(share keepers.BancorShare) {
+		shares = append(shares, share)
+	})
+	require.Equal(t, []keepers.BancorShare{{"abc/cet", alice, 100}, {"abc/cet", bob, 300}}, shares)
+
+	keeper.SetShares(ctx, "abc/cet", alice, 0)
+	shares = nil
+	keeper.IterateShares(ctx, "", func(share keepers.BancorShare) {
+		shares = append(shares, share)
+	})
+	require.Equal(t, []keepers.BancorShare{{"abc/cet", bob, 300}, {"abc/cetx", alice, 200}}, shares)
+
+	bi := &keepers.BancorInfo{Owner: owner, Stock: abc, Money: cet, MaxSupply: sdk.NewInt(1000)}
+	require.Equal(t, int64(700), keeper.GetOwnerShares(ctx, bi))
+	require.Equal(t, int64(700), keeper.GetPosition(ctx, bi, owner))
+	require.Equal(t, int64(300), keeper.GetPosition(ctx, bi, bob))
+}
+
+func TestTradeHistory(t *testing.T) {
+	keeper, ctx := defaultContext()
+	params := types.DefaultParams()
+	params.TradeHistorySize = 3
+	keeper.SetParams(ctx, params)
+	bi := &keepers.BancorInfo{Stock: abc, Money: cet, Price: sdk.NewDec(1)}
+	other := &keepers.BancorInfo{Stock: abc, Money: cet + "x", Price: sdk.NewDec(9)}
+	res := keepers.TradeResult{Money: sdk.NewInt(20)}
+	for height := int64(1); height <= 3; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		for i := 0; i < 2; i++ {
+			bi.Price = sdk.NewDec(height*10 + int64(i))
+			keeper.RecordTrade(ctx, bi, 1, 10, res)
+		}
+	}
+	keeper.RecordTrade(ctx, other, 2, 10, res)
+
+	trades := keeper.GetTrades(ctx, "abc/cet")
+	require.Equal(t, 3, len(trades))
+	require.Equal(t, keepers.BancorTradeRecord{Sequence: 5, Side: 1, Amount: 10, Money: sdk.NewInt(20),
+		Price: sdk.NewDec(2), Height: 3}, trades[0])
+	require.Equal(t, uint64(3), trades[2].Sequence)
+	require.Equal(t, []keepers.BancorClosingPrice{{Height: 3, Price: sdk.NewDec(31)}, {Height: 2, Price: sdk.NewDec(21)},
+		{Height: 1, Price: sdk.NewDec(11)}}, keeper.GetClosingPrices(ctx, "abc/cet"))
+	require.Equal(t, 1, len(keeper.GetTrades(ctx, "abc/cetx")))
+
+	// a smaller size drops the older records at the next trade
+	params.TradeHistorySize = 1
+	keeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(4)
+	keeper.RecordTrade(ctx, bi, 2, 10, res)
+	trades = keeper.GetTrades(ctx, "abc/cet")
+	require.Equal(t, 1, len(trades))
+	require.Equal(t, uint64(6), trades[0].Sequence)
+	require.Equal(t, []keepers.BancorClosingPrice{{Height: 4, Price: bi.Price}}, keeper.GetClosingPrices(ctx, "abc/cet"))
+
+	// the history of a cancelled pool is removed, and a new pool starts from the sequence zero
+	keeper.RemoveHistory(ctx, "abc/cet")
+	require.Equal(t, 0, len(keeper.GetTrades(ctx, "abc/cet")))
+	require.Equal(t, 0, len(keeper.GetClosingPrices(ctx, "abc/cet")))
+	require.Equal(t, 1, len(keeper.GetTrades(ctx, "abc/cetx")))
+	keeper.RecordTrade(ctx, bi, 2, 10, res)
+	require.Equal(t, uint64(0), keeper.GetTrades(ctx, "abc/cet")[0].Sequence)
+}
+
+func TestCalculateDepositAndWithdraw(t *testing.T) {
+	bp := func(supply, price int64) types.Breakpoint {
+		return types.Breakpoint{Supply: sdk.NewInt(supply), Price: sdk.NewDec(price)}
+	}
+	for _, curve := range []keepers.BancorInfo{
+		{CurveType: types.CurveExponential, CurveParam: 30},
+		{CurveType: types.CurvePiecewiseLinear, Breakpoints: []types.Breakpoint{bp(300000, 2), bp(600000, 5)}},
+	} {
+		bi := curve
+		bi.Owner, bi.Stock, bi.Money = owner, bch, cet
+		bi.InitPrice, bi.MaxPrice = sdk.NewDec(1), sdk.NewDec(10)
+		bi.MaxSupply, bi.MaxMoney, bi.TotalShares = sdk.NewInt(1000000), sdk.ZeroInt(), 1000000
+		require.True(t, bi.UpdateStockInPool(sdk.NewInt(400000)))
+
+		res, err := keepers.CalculateDeposit(&bi, 200000)
+		require.Nil(t, err)
+		biNew := res.NewBancorInfo
+		require.True(t, biNew.IsConsistent())
+		require.Equal(t, int64(500000), res.Shares)
+		require.Equal(t, int64(1500000), biNew.TotalShares)
+		require.Equal(t, int64(1500000), biNew.MaxSupply.Int64())
+		require.True(t, biNew.Price.Sub(bi.Price).Abs().LT(sdk.NewDecWithPrec(1, 3)), biNew.Price.String())
+		require.Equal(t, biNew.MoneyInPool.Sub(bi.MoneyInPool), res.MoneyAmount)
+
+		res, err = keepers.CalculateWithdraw(&biNew, res.Shares)
+		require.Nil(t, err)
+		require.True(t, res.NewBancorInfo.IsConsistent())
+		require.Equal(t, bi.StockInPool, res.NewBancorInfo.StockInPool)
+		require.Equal(t, bi.MaxSupply, res.NewBancorInfo.MaxSupply)
+		require.Equal(t, int64(200000), res.StockAmount.Int64())
+
+		_, err = keepers.CalculateWithdraw(&biNew, biNew.TotalShares)
+		require.Equal(t, types.CodeInsufficientShares, err.Code())
+	}
+}
+
 func TestKeeper_GetRebate(t *testing.T) {
 	app := testapp.NewTestApp()
 	referee := sdk.AccAddress("referee")
@@ -288,3 +459,153 @@ func TestKeeper_GetRebate(t *testing.T) {
 	require.Equal(t, int64(80000), balance.Int64())
 	require.Equal(t, exist, true)
 }
+
+func TestBancorInfo_StockAmountForMoney(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	moneyOf := func(bi keepers.BancorInfo, amount int64, isBuy bool) (sdk.Int, bool) {
+		biNew := bi
+		if isBuy {
+			ok := biNew.UpdateStockInPool(bi.StockInPool.SubRaw(amount))
+			return biNew.MoneyInPool.Sub(bi.MoneyInPool), ok
+		}
+		ok := biNew.UpdateStockInPool(bi.StockInPool.AddRaw(amount))
+		return bi.MoneyInPool.Sub(biNew.MoneyInPool), ok
+	}
+	for i := 0; i < 400; i++ {
+		precision := byte(r.Intn(4))
+		unit := int64(math.Pow10(int(precision)))
+		maxSupply := sdk.NewInt((r.Int63n(1e9) + 1000) * unit)
+		initPrice := sdk.NewDecWithPrec(r.Int63n(1000), 2)
+		maxPrice := initPrice.Add(sdk.NewDecWithPrec(r.Int63n(100000)+1, 2))
+		bi := keepers.BancorInfo{
+			InitPrice:      initPrice,
+			MaxSupply:      maxSupply,
+			StockPrecision: precision,
+			MaxPrice:       maxPrice,
+			MaxMoney:       sdk.ZeroInt(),
+		}
+		if i%2 == 1 {
+			// between initPrice*maxSupply and maxPrice*maxSupply, so that AR is positive
+			low := initPrice.MulInt(maxSupply)
+			high := maxPrice.MulInt(maxSupply)
+			bi.MaxMoney = high.Sub(low).MulInt64(r.Int63n(80) + 10).QuoInt64(100).Add(low).TruncateInt()
+			msg := types.MsgBancorInit{MaxSupply: bi.MaxSupply, MaxMoney: bi.MaxMoney}
+			bi.AR = types.CalculateAR(msg, initPrice, maxPrice)
+			if bi.AR <= 0 || bi.AR > types.MaxAR {
+				continue
+			}
+		}
+		require.True(t, bi.UpdateStockInPool(maxSupply))
+		require.True(t, bi.UpdateStockInPool(maxSupply.Sub(maxSupply.QuoRaw(unit).MulRaw(r.Int63n(100)).QuoRaw(100).MulRaw(unit))))
+
+		isBuy := r.Intn(2) == 0
+		var money sdk.Int
+		if isBuy {
+			money = sdk.NewInt(r.Int63n(maxPrice.MulInt(bi.StockInPool).TruncateInt64()/10 + 1))
+		} else {
+			money = bi.MoneyInPool.MulRaw(r.Int63n(120)).QuoRaw(100)
+		}
+		if !money.IsPositive() {
+			continue
+		}
+		amount, ok := bi.StockAmountForMoney(money, isBuy)
+		require.Zero(t, amount%unit)
+		if isBuy {
+			require.True(t, ok)
+			cost, ok := moneyOf(bi, amount, true)
+			require.True(t, ok)
+			require.True(t, cost.LTE(money), "cost %s should not exceed %s", cost, money)
+			if next, ok := moneyOf(bi, amount+unit, true); ok {
+				require.True(t, next.GT(money), "%d more stock still costs %s within %s", unit, next, money)
+			}
+			continue
+		}
+		all, _ := moneyOf(bi, bi.MaxSupply.Sub(bi.StockInPool).Int64(), false)
+		if !ok {
+			require.True(t, all.LT(money))
+			continue
+		}
+		income, ok := moneyOf(bi, amount, false)
+		require.True(t, ok)
+		require.True(t, income.GTE(money), "income %s should reach %s", income, money)
+		prev, _ := moneyOf(bi, amount-unit, false)
+		require.True(t, prev.LT(money), "%d less stock still brings in %s for %s", unit, prev, money)
+	}
+}
+
+func TestBancorInfo_Curves(t *testing.T) {
+	maxSupply := sdk.NewInt(1e12)
+	newBancorInfo := func(curveType byte, curveParam int64, breakpoints []types.Breakpoint) keepers.BancorInfo {
+		bi := keepers.BancorInfo{
+			InitPrice:   sdk.NewDec(1),
+			MaxSupply:   maxSupply,
+			MaxPrice:    sdk.NewDec(10),
+			MaxMoney:    sdk.ZeroInt(),
+			CurveType:   curveType,
+			CurveParam:  curveParam,
+			Breakpoints: breakpoints,
+		}
+		require.True(t, bi.UpdateStockInPool(maxSupply))
+		return bi
+	}
+	k := 3.0
+	sigmoid := func(z float64) float64 { return 1 / (1 + math.Exp(-z)) }
+	tests := []struct {
+		name string
+		bi   keepers.BancorInfo
+		// the money to buy all the stock, divided by maxSupply
+		wantMaxMoney float64
+	}{
+		{"exponential", newBancorInfo(types.CurveExponential, 30, nil),
+			1 + 9*((math.Exp(k)-1)/k-1)/(math.Exp(k)-1)},
+		{"logistic", newBancorInfo(types.CurveLogistic, 30, nil),
+			1 + 9*((math.Log(1+math.Exp(k/2))-math.Log(1+math.Exp(-k/2)))/k-sigmoid(-k/2))/(sigmoid(k/2)-sigmoid(-k/2))},
+		{"piecewise-linear", newBancorInfo(types.CurvePiecewiseLinear, 0, []types.Breakpoint{
+			{Supply: sdk.NewInt(2e11), Price: sdk.NewDec(1)},
+			{Supply: sdk.NewInt(6e11), Price: sdk.NewDec(9)}}),
+			(0.2*2 + 0.4*10 + 0.4*19) / 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bi := tt.bi
+			require.True(t, bi.IsConsistent())
+			lastPrice, lastMoney := bi.Price, bi.MoneyInPool
+			for i := int64(1); i <= 100; i++ {
+				stockInPool := maxSupply.MulRaw(100 - i).QuoRaw(100)
+				if i < 100 {
+					stockInPool = stockInPool.AddRaw(i % 3)
+				}
+				require.True(t, bi.UpdateStockInPool(stockInPool))
+				require.True(t, bi.IsConsistent())
+				require.True(t, bi.Price.GTE(lastPrice) && bi.MoneyInPool.GTE(lastMoney))
+				lastPrice, lastMoney = bi.Price, bi.MoneyInPool
+			}
+			require.True(t, bi.UpdateStockInPool(sdk.ZeroInt()))
+			require.True(t, sdk.NewDec(10).Sub(bi.Price).LT(sdk.NewDecWithPrec(1, 6)), bi.Price.String())
+			maxMoney := float64(bi.MoneyInPool.Int64()) / float64(maxSupply.Int64())
+			require.InEpsilon(t, tt.wantMaxMoney, maxMoney, 1e-6)
+
+			bi.MoneyInPool = bi.MoneyInPool.AddRaw(1)
+			require.False(t, bi.IsConsistent())
+		})
+	}
+
+	// piecewise-linear curve without breakpoints is just the linear curve
+	linear := newBancorInfo(types.CurvePower, 0, nil)
+	piecewise := newBancorInfo(types.CurvePiecewiseLinear, 0, nil)
+	for _, stockInPool := range []int64{1e12, 7e11 + 3, 333333, 0} {
+		require.True(t, linear.UpdateStockInPool(sdk.NewInt(stockInPool)))
+		require.True(t, piecewise.UpdateStockInPool(sdk.NewInt(stockInPool)))
+		require.Equal(t, linear.Price, piecewise.Price)
+		require.Equal(t, linear.MoneyInPool, piecewise.MoneyInPool)
+	}
+
+	logistic := newBancorInfo(types.CurveLogistic, 80, nil)
+	amount, ok := logistic.StockAmountForMoney(sdk.NewInt(1e12), true)
+	require.True(t, ok)
+	biNew := logistic
+	require.True(t, biNew.UpdateStockInPool(logistic.StockInPool.SubRaw(amount)))
+	require.True(t, biNew.MoneyInPool.LTE(sdk.NewInt(1e12)))
+	require.True(t, biNew.UpdateStockInPool(logistic.StockInPool.SubRaw(amount+1)))
+	require.True(t, biNew.MoneyInPool.GT(sdk.NewInt(1e12)))
+}
@@ -0,0 +1,163 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/types"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+// LiquidityResult is what a deposit to or a withdrawal from a bancor pool would move,
+// it is calculated without touching any account
+type LiquidityResult struct {
+	NewBancorInfo BancorInfo
+	// shares minted by a deposit, or burnt by a withdrawal
+	Shares      int64
+	StockAmount sdk.Int
+	MoneyAmount sdk.Int
+	Commission  int64
+}
+
+// Coins are the stock, money and CET commission paid to the pool by a deposit,
+// or paid to the provider by a withdrawal
+func (lr LiquidityResult) Coins(bi *BancorInfo) sdk.Coins {
+	coins := sdk.NewCoins(sdk.NewCoin(bi.Stock, lr.StockAmount))
+	coins = coins.Add(sdk.NewCoins(sdk.NewCoin(bi.Money, lr.MoneyAmount)))
+	return coins.Add(sdk.NewCoins(sdk.NewInt64Coin(dex.CET, lr.Commission)))
+}
+
+// CoinsOfShares returns the part of the pool's stock, money and CET commission owned by 'shares'
+func (bi *BancorInfo) CoinsOfShares(shares int64) sdk.Coins {
+	totalShares := bi.GetTotalShares()
+	lr := LiquidityResult{
+		StockAmount: bi.StockInPool.MulRaw(shares).QuoRaw(totalShares),
+		MoneyAmount: bi.MoneyInPool.MulRaw(shares).QuoRaw(totalShares),
+		Commission:  sdk.NewInt(bi.CommissionInPool).MulRaw(shares).QuoRaw(totalShares).Int64(),
+	}
+	return lr.Coins(bi)
+}
+
+// CalculateDeposit enlarges the pool by 'amount' stocks in pool. The supplied stock grows in
+// the same ratio, so the pool stays at the same point of its curve, and the depositor pays the
+// money and commission the enlarged pool needs.
+func CalculateDeposit(bi *BancorInfo, amount int64) (LiquidityResult, sdk.Error) {
+	if !types.CheckStockPrecision(sdk.NewInt(amount), bi.StockPrecision) {
+		return LiquidityResult{}, types.ErrStockAmountPrecisionNotMatch()
+	}
+	if !bi.StockInPool.IsPositive() {
+		return LiquidityResult{}, types.ErrPoolNotResizable()
+	}
+	unit := bi.stockUnit()
+	stockInPool := bi.StockInPool.AddRaw(amount)
+	suppliedStock := bi.MaxSupply.Sub(bi.StockInPool).Mul(stockInPool).Quo(bi.StockInPool).Quo(unit).Mul(unit)
+	newBi, err := bi.resize(stockInPool, suppliedStock)
+	if err != nil {
+		return LiquidityResult{}, err
+	}
+	totalShares := sdk.NewInt(bi.GetTotalShares())
+	shares := totalShares.MulRaw(amount).Quo(bi.StockInPool)
+	if !shares.IsPositive() {
+		return LiquidityResult{}, types.ErrPoolNotResizable()
+	}
+	if !totalShares.Add(shares).IsInt64() {
+		return LiquidityResult{}, types.ErrTradeAmountIsTooLarge()
+	}
+	money := newBi.MoneyInPool.Sub(bi.MoneyInPool)
+	if money.IsNegative() {
+		return LiquidityResult{}, types.ErrPoolNotResizable()
+	}
+	// rounded up, so that the depositor never dilutes the commission of others
+	commission := sdk.NewInt(bi.CommissionInPool).MulRaw(amount).
+		Add(bi.StockInPool).SubRaw(1).Quo(bi.StockInPool).Int64()
+	newBi.TotalShares = totalShares.Add(shares).Int64()
+	newBi.CommissionInPool = bi.CommissionInPool + commission
+	return LiquidityResult{
+		NewBancorInfo: newBi,
+		Shares:        shares.Int64(),
+		StockAmount:   sdk.NewInt(amount),
+		MoneyAmount:   money,
+		Commission:    commission,
+	}, nil
+}
+
+// CalculateWithdraw shrinks the pool by the ratio of 'shares' to all the shares, and pays
+// the stock, money and commission taken out of the pool to the provider
+func CalculateWithdraw(bi *BancorInfo, shares int64) (LiquidityResult, sdk.Error) {
+	totalShares := bi.GetTotalShares()
+	if shares >= totalShares {
+		return LiquidityResult{}, types.ErrInsufficientShares()
+	}
+	rest := totalShares - shares
+	// rounded up, so that the provider never takes more than its part
+	ceilScale := func(x sdk.Int, unit sdk.Int) sdk.Int {
+		unitsTotal := x.Quo(unit)
+		units := unitsTotal.MulRaw(rest).AddRaw(totalShares - 1).QuoRaw(totalShares)
+		return units.Mul(unit).Add(x.Sub(unitsTotal.Mul(unit)))
+	}
+	unit := bi.stockUnit()
+	stockInPool := ceilScale(bi.StockInPool, unit)
+	suppliedStock := ceilScale(bi.MaxSupply.Sub(bi.StockInPool), unit)
+	newBi, err := bi.resize(stockInPool, suppliedStock)
+	if err != nil {
+		return LiquidityResult{}, err
+	}
+	money := bi.MoneyInPool.Sub(newBi.MoneyInPool)
+	if money.IsNegative() {
+		return LiquidityResult{}, types.ErrPoolNotResizable()
+	}
+	commission := sdk.NewInt(bi.CommissionInPool).MulRaw(shares).QuoRaw(totalShares).Int64()
+	newBi.TotalShares = rest
+	newBi.CommissionInPool = bi.CommissionInPool - commission
+	return LiquidityResult{
+		NewBancorInfo: newBi,
+		Shares:        shares,
+		StockAmount:   bi.StockInPool.Sub(stockInPool),
+		MoneyAmount:   money,
+		Commission:    commission,
+	}, nil
+}
+
+// resize returns a pool with the same curve shape, which is scaled to the new max supply, and is
+// at the point where 'suppliedStock' has been supplied
+func (bi *BancorInfo) resize(stockInPool, suppliedStock sdk.Int) (BancorInfo, sdk.Error) {
+	maxSupply := stockInPool.Add(suppliedStock)
+	maxMoney := bi.MaxMoney.Mul(maxSupply).Quo(bi.MaxSupply)
+	if bi.CurveType == types.CurvePower && bi.InitPrice.Equal(bi.MaxPrice) {
+		maxMoney = bi.InitPrice.MulInt(maxSupply).TruncateInt()
+	}
+	var breakpoints []types.Breakpoint
+	for _, bp := range bi.Breakpoints {
+		breakpoints = append(breakpoints, types.Breakpoint{
+			Supply: bp.Supply.Mul(maxSupply).Quo(bi.MaxSupply),
+			Price:  bp.Price,
+		})
+	}
+	msg := types.MsgBancorInit{
+		Owner:              bi.Owner,
+		Stock:              bi.Stock,
+		Money:              bi.Money,
+		InitPrice:          bi.InitPrice.String(),
+		MaxSupply:          maxSupply,
+		MaxPrice:           bi.MaxPrice.String(),
+		MaxMoney:           maxMoney,
+		StockPrecision:     bi.StockPrecision,
+		EarliestCancelTime: bi.EarliestCancelTime,
+		CurveType:          bi.CurveType,
+		CurveParam:         bi.CurveParam,
+		Breakpoints:        breakpoints,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return BancorInfo{}, types.ErrPoolNotResizable()
+	}
+	newBi := *bi
+	newBi.MaxSupply = maxSupply
+	newBi.MaxMoney = maxMoney
+	newBi.Breakpoints = breakpoints
+	if bi.CurveType == types.CurvePower && types.CalculateAR(msg, bi.InitPrice, bi.MaxPrice) != bi.AR {
+		return BancorInfo{}, types.ErrPoolNotResizable()
+	}
+	if !newBi.UpdateStockInPool(stockInPool) || !newBi.IsConsistent() {
+		return BancorInfo{}, types.ErrPoolNotResizable()
+	}
+	return newBi, nil
+}
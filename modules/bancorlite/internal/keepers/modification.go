@@ -0,0 +1,93 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/types"
+)
+
+// BancorModification is a change to a bancor pool proposed by its owner, which takes effect at ExecutionTime
+type BancorModification struct {
+	Symbol             string `json:"symbol"`
+	EarliestCancelTime int64  `json:"earliest_cancel_time"`
+	SupplyIncrease     int64  `json:"supply_increase"`
+	ChangeOwnerFee     bool   `json:"change_owner_fee"`
+	OwnerFeeRate       int64  `json:"owner_fee_rate"`
+	ExecutionTime      int64  `json:"execution_time"`
+}
+
+func NewBancorModification(msg types.MsgBancorModify, executionTime int64) BancorModification {
+	return BancorModification{
+		Symbol:             msg.GetSymbol(),
+		EarliestCancelTime: msg.EarliestCancelTime,
+		SupplyIncrease:     msg.SupplyIncrease,
+		ChangeOwnerFee:     msg.ChangeOwnerFee,
+		OwnerFeeRate:       msg.OwnerFeeRate,
+		ExecutionTime:      executionTime,
+	}
+}
+
+// CheckModification returns the pool after the modification, which must keep it consistent.
+// The pool in store is not modified.
+func (keeper *Keeper) CheckModification(ctx sdk.Context, bi *BancorInfo, mod BancorModification) (BancorInfo, sdk.Error) {
+	newBi := *bi
+	if mod.EarliestCancelTime != 0 {
+		if mod.EarliestCancelTime <= bi.EarliestCancelTime {
+			return BancorInfo{}, types.ErrInvalidModification("earliest cancel time can only be extended")
+		}
+		newBi.EarliestCancelTime = mod.EarliestCancelTime
+	}
+	if mod.SupplyIncrease != 0 {
+		if !types.CheckStockPrecision(sdk.NewInt(mod.SupplyIncrease), bi.StockPrecision) {
+			return BancorInfo{}, types.ErrStockSupplyPrecisionNotMatch()
+		}
+		// the shares of the providers can not be kept when the owner changes the curve
+		if keeper.GetOwnerShares(ctx, bi) != bi.GetTotalShares() {
+			return BancorInfo{}, types.ErrInvalidModification("the pool has liquidity providers")
+		}
+		suppliedStock := bi.MaxSupply.Sub(bi.StockInPool)
+		var err sdk.Error
+		if newBi, err = newBi.resize(bi.StockInPool.AddRaw(mod.SupplyIncrease), suppliedStock); err != nil {
+			return BancorInfo{}, err
+		}
+		// the money in pool is paid by the buyers, and the owner can not take it away by stretching the curve
+		if newBi.MoneyInPool.LT(bi.MoneyInPool) {
+			return BancorInfo{}, types.ErrInvalidModification("the money in pool would be decreased")
+		}
+	}
+	if mod.ChangeOwnerFee {
+		if mod.OwnerFeeRate > keeper.GetParams(ctx).MaxOwnerFeeRate {
+			return BancorInfo{}, types.ErrInvalidModification("owner fee rate is too high")
+		}
+		newBi.OwnerFeeRate = mod.OwnerFeeRate
+	}
+	if !newBi.IsConsistent() {
+		return BancorInfo{}, types.ErrInvalidModification("the pool would be inconsistent")
+	}
+	return newBi, nil
+}
+
+// ExecuteModification applies a pending modification to its pool. The added stock is frozen, and so is the
+// money the new curve adds to the pool.
+func (keeper *Keeper) ExecuteModification(ctx sdk.Context, mod BancorModification) (*BancorInfo, sdk.Error) {
+	bi := keeper.Load(ctx, mod.Symbol)
+	if bi == nil {
+		return nil, types.ErrNoBancorExists()
+	}
+	newBi, err := keeper.CheckModification(ctx, bi, mod)
+	if err != nil {
+		return nil, err
+	}
+	if mod.SupplyIncrease != 0 {
+		if err := keeper.FreezeCoins(ctx, bi.Owner, sdk.NewCoins(sdk.NewInt64Coin(bi.Stock, mod.SupplyIncrease))); err != nil {
+			return nil, err
+		}
+	}
+	if moneyDiff := newBi.MoneyInPool.Sub(bi.MoneyInPool); moneyDiff.IsPositive() {
+		if err := keeper.FreezeCoins(ctx, bi.Owner, sdk.NewCoins(sdk.NewCoin(bi.Money, moneyDiff))); err != nil {
+			return nil, err
+		}
+	}
+	keeper.Save(ctx, &newBi)
+	return &newBi, nil
+}
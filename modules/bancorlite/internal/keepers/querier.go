@@ -1,6 +1,9 @@
 package keepers
 
 import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -12,6 +15,17 @@ const (
 	QueryBancorInfo = "bancor-info"
 	QueryParameters = "parameters"
 	QueryBancors    = "bancor-list"
+	QueryTradeQuote = "bancor-trade-quote"
+	QueryPosition   = "bancor-position"
+	QueryPositions  = "bancor-positions"
+	// pending modifications, of the pool specified by QueryBancorInfoParam or of all the pools
+	QueryModification  = "bancor-modification"
+	QueryModifications = "bancor-modifications"
+	// recent trades and closing prices of a pool, paginated by QueryHistoryParam
+	QueryTrades        = "bancor-trades"
+	QueryClosingPrices = "bancor-closing-prices"
+
+	DefaultHistoryLimit = 20
 )
 
 // creates a querier for asset REST endpoints
@@ -24,6 +38,20 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryBancorInfo(ctx, req, keeper)
 		case QueryBancors:
 			return queryBancorList(ctx, req, keeper)
+		case QueryTradeQuote:
+			return queryTradeQuote(ctx, req, keeper)
+		case QueryPosition:
+			return queryPosition(ctx, req, keeper)
+		case QueryPositions:
+			return queryPositions(ctx, req, keeper)
+		case QueryModification:
+			return queryModification(ctx, req, keeper)
+		case QueryModifications:
+			return queryModifications(ctx, keeper)
+		case QueryTrades:
+			return queryTrades(ctx, req, keeper)
+		case QueryClosingPrices:
+			return queryClosingPrices(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("query symbol : " + path[0])
 		}
@@ -65,6 +93,224 @@ func queryBancorList(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 	return bz, nil
 }
 
+type QueryTradeQuoteParam struct {
+	Symbol string `json:"symbol"`
+	Amount int64  `json:"amount"`
+	IsBuy  bool   `json:"is_buy"`
+	// when positive, Amount is ignored and decided by this money amount, as MsgBancorTrade does
+	MoneyAmount int64 `json:"money_amount"`
+}
+
+type TradeQuoteDisplay struct {
+	Symbol         string `json:"symbol"`
+	Side           string `json:"side"`
+	Amount         int64  `json:"amount"`
+	Money          string `json:"money"`
+	Commission     string `json:"commission"`
+	TxPrice        string `json:"transaction_price"`
+	NewPrice       string `json:"new_price"`
+	NewStockInPool string `json:"new_stock_in_pool"`
+	NewMoneyInPool string `json:"new_money_in_pool"`
+	OwnerFee       string `json:"owner_fee"`
+}
+
+func queryTradeQuote(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var param QueryTradeQuoteParam
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &param); err != nil {
+		return nil, sdk.NewError(types.CodeSpaceBancorlite, types.CodeUnMarshalFailed, "failed to parse param")
+	}
+	amount := param.Amount
+	if param.MoneyAmount > 0 {
+		amount = 0
+	}
+	if err := types.CheckTradeAmount(amount, param.MoneyAmount); err != nil {
+		return nil, err
+	}
+	bi := keeper.Load(ctx, param.Symbol)
+	if bi == nil {
+		return nil, types.ErrNoBancorExists()
+	}
+	if param.MoneyAmount > 0 {
+		var err sdk.Error
+		if amount, err = keeper.GetStockAmountForMoney(bi, param.MoneyAmount, param.IsBuy); err != nil {
+			return nil, err
+		}
+	}
+	res, err := keeper.CalculateTrade(ctx, bi, amount, param.IsBuy)
+	if err != nil {
+		return nil, err
+	}
+	side := "sell"
+	if param.IsBuy {
+		side = "buy"
+	}
+	quote := TradeQuoteDisplay{
+		Symbol:         param.Symbol,
+		Side:           side,
+		Amount:         amount,
+		Money:          res.Money.String(),
+		Commission:     res.Commission.String(),
+		TxPrice:        res.TxPrice(amount).String(),
+		NewPrice:       res.NewBancorInfo.Price.String(),
+		NewStockInPool: res.NewBancorInfo.StockInPool.String(),
+		NewMoneyInPool: res.NewBancorInfo.MoneyInPool.String(),
+		OwnerFee:       res.OwnerFee.String(),
+	}
+	bz, e := codec.MarshalJSONIndent(types.ModuleCdc, quote)
+	if e != nil {
+		return nil, types.ErrMarshalFailed()
+	}
+	return bz, nil
+}
+
+type QueryPositionParam struct {
+	// when empty, the positions in all the pools are returned
+	Symbol   string         `json:"symbol"`
+	Provider sdk.AccAddress `json:"provider"`
+}
+
+type PositionDisplay struct {
+	Symbol      string `json:"symbol"`
+	Provider    string `json:"provider"`
+	IsOwner     bool   `json:"is_owner"`
+	Shares      string `json:"shares"`
+	TotalShares string `json:"total_shares"`
+	Stock       string `json:"stock"`
+	Money       string `json:"money"`
+	Commission  string `json:"commission"`
+}
+
+func newPositionDisplay(bi *BancorInfo, provider sdk.AccAddress, shares int64) PositionDisplay {
+	coins := bi.CoinsOfShares(shares)
+	return PositionDisplay{
+		Symbol:      bi.GetSymbol(),
+		Provider:    provider.String(),
+		IsOwner:     bi.Owner.Equals(provider),
+		Shares:      fmt.Sprintf("%d", shares),
+		TotalShares: fmt.Sprintf("%d", bi.GetTotalShares()),
+		Stock:       coins.AmountOf(bi.Stock).String(),
+		Money:       coins.AmountOf(bi.Money).String(),
+		Commission:  sdk.NewInt(bi.CommissionInPool).MulRaw(shares).QuoRaw(bi.GetTotalShares()).String(),
+	}
+}
+
+func queryPosition(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var param QueryPositionParam
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &param); err != nil {
+		return nil, sdk.NewError(types.CodeSpaceBancorlite, types.CodeUnMarshalFailed, "failed to parse param")
+	}
+	bi := keeper.Load(ctx, param.Symbol)
+	if bi == nil {
+		return nil, types.ErrNoBancorExists()
+	}
+	position := newPositionDisplay(bi, param.Provider, keeper.GetPosition(ctx, bi, param.Provider))
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, position)
+	if err != nil {
+		return nil, types.ErrMarshalFailed()
+	}
+	return bz, nil
+}
+
+func queryPositions(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var param QueryPositionParam
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &param); err != nil {
+		return nil, sdk.NewError(types.CodeSpaceBancorlite, types.CodeUnMarshalFailed, "failed to parse param")
+	}
+	positions := make([]PositionDisplay, 0)
+	keeper.Iterate(ctx, func(bi *BancorInfo) {
+		if bi.Owner.Equals(param.Provider) {
+			positions = append(positions, newPositionDisplay(bi, param.Provider, keeper.GetOwnerShares(ctx, bi)))
+		}
+	})
+	keeper.IterateShares(ctx, "", func(share BancorShare) {
+		if !share.Provider.Equals(param.Provider) {
+			return
+		}
+		if bi := keeper.Load(ctx, share.Symbol); bi != nil {
+			positions = append(positions, newPositionDisplay(bi, param.Provider, share.Shares))
+		}
+	})
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, positions)
+	if err != nil {
+		return nil, types.ErrMarshalFailed()
+	}
+	return bz, nil
+}
+
+func queryModification(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var param QueryBancorInfoParam
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &param); err != nil {
+		return nil, sdk.NewError(types.CodeSpaceBancorlite, types.CodeUnMarshalFailed, "failed to parse param")
+	}
+	mod := keeper.GetModification(ctx, param.Symbol)
+	if mod == nil {
+		return nil, types.ErrInvalidModification("no pending modification")
+	}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, mod)
+	if err != nil {
+		return nil, types.ErrMarshalFailed()
+	}
+	return bz, nil
+}
+
+func queryModifications(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	mods := make([]BancorModification, 0)
+	keeper.IterateModifications(ctx, func(mod BancorModification) {
+		mods = append(mods, mod)
+	})
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, mods)
+	if err != nil {
+		return nil, types.ErrMarshalFailed()
+	}
+	return bz, nil
+}
+
+// QueryHistoryParam selects a page of the recent trades or closing prices of a pool, the latest first.
+// Page starts from 1, and a zero Limit means DefaultHistoryLimit.
+type QueryHistoryParam struct {
+	Symbol string `json:"symbol"`
+	Page   int    `json:"page"`
+	Limit  int    `json:"limit"`
+}
+
+func queryTrades(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var param QueryHistoryParam
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &param); err != nil {
+		return nil, sdk.NewError(types.CodeSpaceBancorlite, types.CodeUnMarshalFailed, "failed to parse param")
+	}
+	trades := keeper.GetTrades(ctx, param.Symbol)
+	start, end := client.Paginate(len(trades), param.Page, param.Limit, DefaultHistoryLimit)
+	if start < 0 || end < 0 {
+		trades = []BancorTradeRecord{}
+	} else {
+		trades = trades[start:end]
+	}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, trades)
+	if err != nil {
+		return nil, types.ErrMarshalFailed()
+	}
+	return bz, nil
+}
+
+func queryClosingPrices(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var param QueryHistoryParam
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &param); err != nil {
+		return nil, sdk.NewError(types.CodeSpaceBancorlite, types.CodeUnMarshalFailed, "failed to parse param")
+	}
+	prices := keeper.GetClosingPrices(ctx, param.Symbol)
+	start, end := client.Paginate(len(prices), param.Page, param.Limit, DefaultHistoryLimit)
+	if start < 0 || end < 0 {
+		prices = []BancorClosingPrice{}
+	} else {
+		prices = prices[start:end]
+	}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, prices)
+	if err != nil {
+		return nil, types.ErrMarshalFailed()
+	}
+	return bz, nil
+}
+
 func queryParameters(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
 	params := k.GetParams(ctx)
 
@@ -62,3 +62,89 @@ func TestQueryBancorInfo(t *testing.T) {
 	require.Equal(t, "foo", bid.Stock)
 	require.Equal(t, "bar", bid.Money)
 }
+
+func TestQueryPositions(t *testing.T) {
+	testApp := testapp.NewTestApp()
+	ctx := testApp.NewCtx()
+
+	_, _, owner := testutil.KeyPubAddr()
+	_, _, provider := testutil.KeyPubAddr()
+	bi := keepers.BancorInfo{
+		Owner:            owner,
+		Stock:            "foo",
+		Money:            "bar",
+		InitPrice:        sdk.NewDec(10),
+		MaxSupply:        sdk.NewInt(1e10),
+		MaxPrice:         sdk.NewDec(10000),
+		Price:            sdk.NewDec(10),
+		StockInPool:      sdk.NewInt(10000),
+		MoneyInPool:      sdk.NewInt(10000),
+		TotalShares:      4000,
+		CommissionInPool: 400,
+	}
+	testApp.BancorKeeper.Save(ctx, &bi)
+	testApp.BancorKeeper.SetShares(ctx, "foo/bar", provider, 1000)
+
+	querier := keepers.NewQuerier(testApp.BancorKeeper)
+	reqData := testApp.Cdc.MustMarshalJSON(keepers.QueryPositionParam{Symbol: "foo/bar", Provider: provider})
+	res, err := querier(ctx, []string{keepers.QueryPosition}, abci.RequestQuery{Data: reqData})
+	require.NoError(t, err)
+	var position keepers.PositionDisplay
+	testApp.Cdc.MustUnmarshalJSON(res, &position)
+	require.Equal(t, keepers.PositionDisplay{
+		Symbol:      "foo/bar",
+		Provider:    provider.String(),
+		Shares:      "1000",
+		TotalShares: "4000",
+		Stock:       "2500",
+		Money:       "2500",
+		Commission:  "100",
+	}, position)
+
+	reqData = testApp.Cdc.MustMarshalJSON(keepers.QueryPositionParam{Provider: owner})
+	res, err = querier(ctx, []string{keepers.QueryPositions}, abci.RequestQuery{Data: reqData})
+	require.NoError(t, err)
+	var positions []keepers.PositionDisplay
+	testApp.Cdc.MustUnmarshalJSON(res, &positions)
+	require.Equal(t, 1, len(positions))
+	require.True(t, positions[0].IsOwner)
+	require.Equal(t, "3000", positions[0].Shares)
+
+	reqData = testApp.Cdc.MustMarshalJSON(keepers.QueryPositionParam{Symbol: "bar/foo", Provider: owner})
+	_, err = querier(ctx, []string{keepers.QueryPosition}, abci.RequestQuery{Data: reqData})
+	require.Equal(t, types.CodeNoBancorExists, err.Code())
+}
+
+func TestQueryTrades(t *testing.T) {
+	testApp := testapp.NewTestApp()
+	ctx := testApp.NewCtx()
+	testApp.BancorKeeper.SetParams(ctx, types.DefaultParams())
+	bi := &keepers.BancorInfo{Stock: "foo", Money: "bar", Price: sdk.NewDec(10)}
+	for i := int64(1); i <= 5; i++ {
+		testApp.BancorKeeper.RecordTrade(ctx.WithBlockHeight(i), bi, 1, i, keepers.TradeResult{Money: sdk.NewInt(i * 10)})
+	}
+
+	querier := keepers.NewQuerier(testApp.BancorKeeper)
+	reqData := testApp.Cdc.MustMarshalJSON(keepers.QueryHistoryParam{Symbol: "foo/bar", Page: 2, Limit: 2})
+	res, err := querier(ctx, []string{keepers.QueryTrades}, abci.RequestQuery{Data: reqData})
+	require.NoError(t, err)
+	var trades []keepers.BancorTradeRecord
+	testApp.Cdc.MustUnmarshalJSON(res, &trades)
+	require.Equal(t, 2, len(trades))
+	require.Equal(t, int64(3), trades[0].Amount)
+	require.Equal(t, int64(2), trades[1].Amount)
+
+	reqData = testApp.Cdc.MustMarshalJSON(keepers.QueryHistoryParam{Symbol: "foo/bar", Page: 1})
+	res, err = querier(ctx, []string{keepers.QueryClosingPrices}, abci.RequestQuery{Data: reqData})
+	require.NoError(t, err)
+	var prices []keepers.BancorClosingPrice
+	testApp.Cdc.MustUnmarshalJSON(res, &prices)
+	require.Equal(t, 5, len(prices))
+	require.Equal(t, int64(5), prices[0].Height)
+
+	reqData = testApp.Cdc.MustMarshalJSON(keepers.QueryHistoryParam{Symbol: "foo/bar", Page: 4, Limit: 2})
+	res, err = querier(ctx, []string{keepers.QueryTrades}, abci.RequestQuery{Data: reqData})
+	require.NoError(t, err)
+	testApp.Cdc.MustUnmarshalJSON(res, &trades)
+	require.Equal(t, 0, len(trades))
+}
@@ -0,0 +1,125 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/types"
+	"github.com/coinexchain/cet-sdk/modules/market"
+)
+
+// SwapRoute is how a swap is split between the bancor pool and the order book,
+// it is calculated without touching any account
+type SwapRoute struct {
+	// stock traded with the bancor pool right away, and the trade's result
+	BancorAmount int64
+	BancorTrade  TradeResult
+	// stock sent to the order book as an IOC order, which is matched at the end of the block
+	OrderAmount int64
+	// stock left out, because it is less than the granularity of the market's orders
+	Unrouted int64
+}
+
+// CalculateSwapRoute walks the price levels of the order book no worse than 'limit', and before
+// taking each level, trades with the bancor pool until its price reaches the level's. What is left
+// after the book is traded with the pool as long as its price stays within 'limit'. All the stock
+// not traded with the pool goes to the order book, where an IOC order can only deal at prices no
+// worse than 'limit', and may also meet the orders placed later in the same block.
+func (keeper *Keeper) CalculateSwapRoute(ctx sdk.Context, bi *BancorInfo, amount int64, isBuy bool, limit sdk.Dec) (SwapRoute, sdk.Error) {
+	var levels []market.DepthLevel
+	marketInfo, err := keeper.GetMarketInfo(ctx, bi.GetSymbol())
+	hasMarket := err == nil
+	if hasMarket {
+		bids, asks := keeper.GetDepth(ctx, bi.GetSymbol())
+		levels = bids
+		if isBuy {
+			levels = asks
+		}
+	}
+
+	cur := *bi
+	remaining := amount
+	var bancorAmount int64
+	tradeWithPool := func(price sdk.Dec) {
+		n := cur.AmountToPrice(price, remaining, isBuy)
+		if n == 0 {
+			return
+		}
+		stockInPool := cur.StockInPool.AddRaw(n)
+		if isBuy {
+			stockInPool = cur.StockInPool.SubRaw(n)
+		}
+		cur.UpdateStockInPool(stockInPool)
+		bancorAmount += n
+		remaining -= n
+	}
+	for _, level := range levels {
+		if remaining == 0 || worseThan(level.Price, limit, isBuy) {
+			break
+		}
+		tradeWithPool(level.Price)
+		if level.Amount >= remaining {
+			remaining = 0
+		} else {
+			remaining -= level.Amount
+		}
+	}
+	if remaining != 0 {
+		tradeWithPool(limit)
+	}
+
+	route := SwapRoute{BancorAmount: bancorAmount}
+	if bancorAmount != 0 {
+		res, err := keeper.CalculateTrade(ctx, bi, bancorAmount, isBuy)
+		if err != nil {
+			return SwapRoute{}, err
+		}
+		route.BancorTrade = res
+	}
+	rest := amount - bancorAmount
+	if hasMarket {
+		granularity := market.GetGranularityOfOrder(marketInfo.OrderPrecision)
+		route.OrderAmount = rest / granularity * granularity
+	}
+	route.Unrouted = rest - route.OrderAmount
+	if route.BancorAmount == 0 && route.OrderAmount == 0 {
+		return SwapRoute{}, types.ErrNoSwapRoute()
+	}
+	return route, nil
+}
+
+func worseThan(price, limit sdk.Dec, isBuy bool) bool {
+	if isBuy {
+		return price.GT(limit)
+	}
+	return price.LT(limit)
+}
+
+// AmountToPrice returns the largest stock amount, no more than 'max', which can be bought from
+// (or sold to) the pool before its price rises above (or falls below) 'price'.
+// The returned amount is always a multiple of the unit decided by StockPrecision.
+func (bi *BancorInfo) AmountToPrice(price sdk.Dec, max int64, isBuy bool) int64 {
+	unit := bi.stockUnit()
+	maxUnits := sdk.NewInt(max).Quo(unit)
+	if isBuy && bi.StockInPool.Quo(unit).LT(maxUnits) {
+		maxUnits = bi.StockInPool.Quo(unit)
+	}
+	if supplied := bi.MaxSupply.Sub(bi.StockInPool).Quo(unit); !isBuy && supplied.LT(maxUnits) {
+		maxUnits = supplied
+	}
+	reaches := func(n int64) bool {
+		amount := unit.MulRaw(n)
+		stockInPool := bi.StockInPool.Add(amount)
+		if isBuy {
+			stockInPool = bi.StockInPool.Sub(amount)
+		}
+		biNew := *bi
+		if !biNew.UpdateStockInPool(stockInPool) {
+			return false
+		}
+		return !worseThan(biNew.Price, price, isBuy)
+	}
+	if !reaches(0) {
+		return 0
+	}
+	return unit.MulRaw(lastTrue(0, maxUnits.Int64(), reaches)).Int64()
+}
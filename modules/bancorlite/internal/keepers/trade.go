@@ -0,0 +1,91 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/types"
+)
+
+// TradeResult is what a trade of some stock with a bancor pool would cost or earn,
+// it is calculated without touching any account
+type TradeResult struct {
+	NewBancorInfo BancorInfo
+	// money paid to the pool when buying, or received from the pool when selling
+	Money      sdk.Int
+	Commission sdk.Int
+	// the fee charged for the pool in CET, decided by its OwnerFeeRate
+	OwnerFee      sdk.Int
+	CoinsFromPool sdk.Coins
+	CoinsToPool   sdk.Coins
+}
+
+func (tr TradeResult) TxPrice(amount int64) sdk.Dec {
+	return sdk.NewDecFromInt(tr.Money).QuoInt64(amount)
+}
+
+// CalculateTrade moves the pool along its curve by 'amount' stocks and returns the money involved,
+// the commission the trader must pay and the pool's new state. The pool in store is not modified.
+func (keeper *Keeper) CalculateTrade(ctx sdk.Context, bi *BancorInfo, amount int64, isBuy bool) (TradeResult, sdk.Error) {
+	if !types.CheckStockPrecision(sdk.NewInt(amount), bi.StockPrecision) {
+		return TradeResult{}, types.ErrStockAmountPrecisionNotMatch()
+	}
+	stockInPool := bi.StockInPool.AddRaw(amount)
+	if isBuy {
+		stockInPool = bi.StockInPool.SubRaw(amount)
+	}
+	res := TradeResult{NewBancorInfo: *bi}
+	if ok := res.NewBancorInfo.UpdateStockInPool(stockInPool); !ok {
+		return TradeResult{}, types.ErrStockInPoolOutofBound()
+	}
+
+	res.Money = bi.MoneyInPool.Sub(res.NewBancorInfo.MoneyInPool)
+	if isBuy {
+		res.Money = res.Money.Neg()
+	}
+	if !res.Money.IsPositive() {
+		return TradeResult{}, types.ErrTradeMoneyNotPositive()
+	}
+	stockCoins := sdk.Coins{sdk.NewCoin(bi.Stock, sdk.NewInt(amount))}
+	moneyCoins := sdk.Coins{sdk.NewCoin(bi.Money, res.Money)}
+	if isBuy {
+		res.CoinsFromPool, res.CoinsToPool = stockCoins, moneyCoins
+	} else {
+		res.CoinsFromPool, res.CoinsToPool = moneyCoins, stockCoins
+	}
+	res.Commission = keeper.GetTradeFee(ctx, bi.Stock, bi.Money, amount, res.Money)
+	res.OwnerFee = keeper.GetOwnerFee(ctx, bi, amount, res.Money)
+	return res, nil
+}
+
+// GetStockAmountForMoney decides the stock amount of a trade specified by the money it pays or earns
+func (keeper *Keeper) GetStockAmountForMoney(bi *BancorInfo, moneyAmount int64, isBuy bool) (int64, sdk.Error) {
+	amount, ok := bi.StockAmountForMoney(sdk.NewInt(moneyAmount), isBuy)
+	if !ok {
+		return 0, types.ErrStockInPoolOutofBound()
+	}
+	if amount == 0 {
+		return 0, types.ErrMoneyAmountTooSmall()
+	}
+	return amount, nil
+}
+
+func (keeper *Keeper) GetTradeFee(ctx sdk.Context, stock, money string, amount int64, amountOfMoney sdk.Int) sdk.Int {
+	volume := keeper.GetMarketVolume(ctx, stock, money, sdk.NewDec(amount), sdk.NewDecFromInt(amountOfMoney))
+	commission := volume.
+		Mul(sdk.NewDec(keeper.GetParams(ctx).TradeFeeRate)).
+		QuoInt64(10000).TruncateInt64()
+
+	min := keeper.GetMarketFeeMin(ctx)
+	if commission < min {
+		return sdk.NewInt(min)
+	}
+	return sdk.NewInt(commission)
+}
+
+func (keeper *Keeper) GetOwnerFee(ctx sdk.Context, bi *BancorInfo, amount int64, amountOfMoney sdk.Int) sdk.Int {
+	if bi.OwnerFeeRate == 0 {
+		return sdk.ZeroInt()
+	}
+	volume := keeper.GetMarketVolume(ctx, bi.Stock, bi.Money, sdk.NewDec(amount), sdk.NewDecFromInt(amountOfMoney))
+	return volume.MulInt64(bi.OwnerFeeRate).QuoInt64(10000).TruncateInt()
+}
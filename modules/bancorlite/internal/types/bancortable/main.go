@@ -10,6 +10,18 @@ func main() {
 	buildTable()
 }
 
+const (
+	maxCurveParam     = 200
+	curveParamSamples = 10
+	curveTableHeader  = `
+const MaxCurveParam = 200
+const CurveParamSamples = 10
+func CurveTableLookup(table *[MaxCurveParam+1][SupplyRatioSamples+1]int32, x,y int64) sdk.Dec {
+	return sdk.NewDec(int64(table[int(x)][int(y)])).Quo(sdk.NewDec(int64(math.MaxInt32)))
+}
+`
+)
+
 /*
 # y = x^(A)，x = [0, 1], A = [0, 5]
 # [51][1001]string: A[0, 5] map to [0, 500], x[0, 1] map to [0, 1000]; y -> string
@@ -55,5 +67,62 @@ func TableLookup(x,y int64) sdk.Dec {
 			f.WriteString(s)
 		}
 	}
-	f.WriteString("}")
+	f.WriteString("}\n")
+	buildCurveTables(f)
+}
+
+/*
+# price ratio y = f(x) and money ratio y = F(x) = integral of f from 0 to x, x = [0, 1], k = (0, 20]
+# exponential: f(x) = (e^(kx) - 1) / (e^k - 1)
+# logistic: f(x) = (sigmoid(k(x-0.5)) - sigmoid(-k/2)) / (sigmoid(k/2) - sigmoid(-k/2))
+# [201][1001]int32: k(0, 20] map to [1, 200], x[0, 1] map to [0, 1000]; y -> y * MaxInt32
+*/
+func buildCurveTables(f *os.File) {
+	sigmoid := func(z float64) float64 {
+		return 1 / (1 + math.Exp(-z))
+	}
+	tables := []struct {
+		name string
+		fn   func(k, x float64) float64
+	}{
+		{"ExpPriceTable", func(k, x float64) float64 {
+			return (math.Exp(k*x) - 1) / (math.Exp(k) - 1)
+		}},
+		{"ExpMoneyTable", func(k, x float64) float64 {
+			return ((math.Exp(k*x)-1)/k - x) / (math.Exp(k) - 1)
+		}},
+		{"LogisticPriceTable", func(k, x float64) float64 {
+			low, high := sigmoid(-k/2), sigmoid(k/2)
+			return (sigmoid(k*(x-0.5)) - low) / (high - low)
+		}},
+		{"LogisticMoneyTable", func(k, x float64) float64 {
+			low, high := sigmoid(-k/2), sigmoid(k/2)
+			integral := (math.Log(1+math.Exp(k*(x-0.5))) - math.Log(1+math.Exp(-k/2))) / k
+			return (integral - low*x) / (high - low)
+		}},
+	}
+	f.WriteString(curveTableHeader)
+	for _, table := range tables {
+		f.WriteString(fmt.Sprintf("var %s = [%d][1001]int32", table.name, maxCurveParam+1))
+		f.WriteString("{\n")
+		for i := 0; i <= maxCurveParam; i++ {
+			f.WriteString("{\n")
+			for j := 0; j <= 1000; j++ {
+				var v int32
+				if i != 0 {
+					k := float64(i) / curveParamSamples
+					x := 0.001 * float64(j)
+					v = int32(math.Min(table.fn(k, x), 1) * float64(math.MaxInt32))
+				}
+				s := fmt.Sprintf("%d,", v)
+				if j == 1000 {
+					s = fmt.Sprintf("%d},\n", v)
+				} else if j%10 == 9 {
+					s = fmt.Sprintf("%d,\n", v)
+				}
+				f.WriteString(s)
+			}
+		}
+		f.WriteString("}\n")
+	}
 }
@@ -16,4 +16,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgBancorInit{}, "bancorlite/MsgBancorInit", nil)
 	cdc.RegisterConcrete(MsgBancorTrade{}, "bancorlite/MsgBancorTrade", nil)
 	cdc.RegisterConcrete(MsgBancorCancel{}, "bancorlite/MsgBancorCancel", nil)
+	cdc.RegisterConcrete(MsgBancorDeposit{}, "bancorlite/MsgBancorDeposit", nil)
+	cdc.RegisterConcrete(MsgBancorWithdraw{}, "bancorlite/MsgBancorWithdraw", nil)
+	cdc.RegisterConcrete(MsgBancorModify{}, "bancorlite/MsgBancorModify", nil)
+	cdc.RegisterConcrete(MsgBancorSwap{}, "bancorlite/MsgBancorSwap", nil)
 }
@@ -0,0 +1,142 @@
+package types
+
+import (
+	"errors"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The shapes of bonding curve, which decide how the price goes from InitPrice to MaxPrice
+// when the supplied stock goes from zero to MaxSupply
+const (
+	// price = InitPrice + (MaxPrice - InitPrice) * (s/s_max)^AR, it is linear when MaxMoney is zero
+	CurvePower byte = 0
+	// price = InitPrice + (MaxPrice - InitPrice) * (e^(k*s/s_max) - 1) / (e^k - 1)
+	CurveExponential byte = 1
+	// price follows an S-shaped sigmoid centered at s_max/2, rescaled to [InitPrice, MaxPrice]
+	CurveLogistic byte = 2
+	// price is linearly interpolated between the owner-defined breakpoints
+	CurvePiecewiseLinear byte = 3
+
+	MaxBreakpoints = 16
+)
+
+var curveNames = map[byte]string{
+	CurvePower:           "power",
+	CurveExponential:     "exponential",
+	CurveLogistic:        "logistic",
+	CurvePiecewiseLinear: "piecewise-linear",
+}
+
+func CurveName(curveType byte) string {
+	if name, ok := curveNames[curveType]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+func ParseCurveType(name string) (byte, bool) {
+	for curveType, n := range curveNames {
+		if n == name {
+			return curveType, true
+		}
+	}
+	return 0, false
+}
+
+// Breakpoint sets the price when the supplied stock reaches Supply
+type Breakpoint struct {
+	Supply sdk.Int `json:"supply"`
+	Price  sdk.Dec `json:"price"`
+}
+
+// ParseBreakpoints parses breakpoints in the format of "supply1:price1,supply2:price2"
+func ParseBreakpoints(s string) ([]Breakpoint, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+	var res []Breakpoint
+	for _, pair := range strings.Split(s, ",") {
+		fields := strings.Split(pair, ":")
+		if len(fields) != 2 {
+			return nil, errors.New("invalid breakpoint: " + pair)
+		}
+		supply, ok := sdk.NewIntFromString(fields[0])
+		if !ok {
+			return nil, errors.New("invalid supply in breakpoint: " + pair)
+		}
+		price, err := sdk.NewDecFromStr(fields[1])
+		if err != nil {
+			return nil, errors.New("invalid price in breakpoint: " + pair)
+		}
+		res = append(res, Breakpoint{Supply: supply, Price: price})
+	}
+	return res, nil
+}
+
+// CheckCurve validates the curve type and the parameters it needs
+func CheckCurve(curveType byte, curveParam int64, breakpoints []Breakpoint,
+	initPrice, maxPrice sdk.Dec, maxSupply, maxMoney sdk.Int) sdk.Error {
+	if curveType == CurvePower {
+		if curveParam != 0 || len(breakpoints) != 0 {
+			return ErrInvalidCurve("power curve has no curve param or breakpoints")
+		}
+		return nil
+	}
+	if _, ok := curveNames[curveType]; !ok {
+		return ErrInvalidCurve("unknown curve type")
+	}
+	if !maxMoney.IsZero() {
+		return ErrInvalidCurve("max money is decided by the curve")
+	}
+	if !initPrice.LT(maxPrice) {
+		return ErrPriceConfiguration()
+	}
+	if err := checkMaxMoney(maxPrice, maxSupply); err != nil {
+		return err
+	}
+
+	if curveType != CurvePiecewiseLinear {
+		if curveParam <= 0 || curveParam > MaxCurveParam {
+			return ErrInvalidCurve("curve param is out of range")
+		}
+		if len(breakpoints) != 0 {
+			return ErrInvalidCurve("only piecewise-linear curve has breakpoints")
+		}
+		return nil
+	}
+	if curveParam != 0 {
+		return ErrInvalidCurve("piecewise-linear curve has no curve param")
+	}
+	if len(breakpoints) > MaxBreakpoints {
+		return ErrInvalidCurve("too many breakpoints")
+	}
+	lastSupply, lastPrice := sdk.ZeroInt(), initPrice
+	for _, bp := range breakpoints {
+		if bp.Supply == (sdk.Int{}) || bp.Price.IsNil() {
+			return ErrInvalidCurve("breakpoint is incomplete")
+		}
+		if !bp.Supply.GT(lastSupply) || !bp.Supply.LT(maxSupply) {
+			return ErrInvalidCurve("breakpoints' supplies must be increasing and less than max supply")
+		}
+		if bp.Price.LT(lastPrice) || bp.Price.GT(maxPrice) {
+			return ErrInvalidCurve("breakpoints' prices must be non-decreasing and within init price and max price")
+		}
+		lastSupply, lastPrice = bp.Supply, bp.Price
+	}
+	return nil
+}
+
+// all the curves other than the power curve have money in pool less than maxPrice * maxSupply
+func checkMaxMoney(maxPrice sdk.Dec, maxSupply sdk.Int) (err sdk.Error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrPriceTooBig()
+		}
+	}()
+	if maxPrice.MulInt(maxSupply).GT(sdk.NewDec(MaxTradeAmount)) {
+		return ErrPriceTooBig()
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParseBreakpoints(t *testing.T) {
+	bps, err := ParseBreakpoints("")
+	require.Nil(t, err)
+	require.Nil(t, bps)
+
+	bps, err = ParseBreakpoints("100:1.5,200:3")
+	require.Nil(t, err)
+	require.Equal(t, []Breakpoint{
+		{Supply: sdk.NewInt(100), Price: sdk.NewDecWithPrec(15, 1)},
+		{Supply: sdk.NewInt(200), Price: sdk.NewDec(3)},
+	}, bps)
+
+	for _, s := range []string{"100", "100:1:2", "abc:1", "100:abc"} {
+		_, err = ParseBreakpoints(s)
+		require.NotNil(t, err, s)
+	}
+}
+
+func TestCheckCurve(t *testing.T) {
+	initPrice, maxPrice := sdk.NewDec(1), sdk.NewDec(10)
+	maxSupply := sdk.NewInt(1000)
+	bp := func(supply, price int64) Breakpoint {
+		return Breakpoint{Supply: sdk.NewInt(supply), Price: sdk.NewDec(price)}
+	}
+	tests := []struct {
+		name        string
+		curveType   byte
+		curveParam  int64
+		breakpoints []Breakpoint
+		initPrice   sdk.Dec
+		maxMoney    sdk.Int
+		want        sdk.CodeType
+	}{
+		{"power", CurvePower, 0, nil, initPrice, sdk.NewInt(3000), sdk.CodeOK},
+		{"power with param", CurvePower, 10, nil, initPrice, sdk.ZeroInt(), CodeInvalidCurve},
+		{"unknown", 4, 10, nil, initPrice, sdk.ZeroInt(), CodeInvalidCurve},
+		{"exponential", CurveExponential, 10, nil, initPrice, sdk.ZeroInt(), sdk.CodeOK},
+		{"exponential with max money", CurveExponential, 10, nil, initPrice, sdk.NewInt(3000), CodeInvalidCurve},
+		{"exponential with flat price", CurveExponential, 10, nil, maxPrice, sdk.ZeroInt(), CodeInitPriceBigThanMaxPrice},
+		{"logistic", CurveLogistic, MaxCurveParam, nil, initPrice, sdk.ZeroInt(), sdk.CodeOK},
+		{"logistic param too big", CurveLogistic, MaxCurveParam + 1, nil, initPrice, sdk.ZeroInt(), CodeInvalidCurve},
+		{"logistic without param", CurveLogistic, 0, nil, initPrice, sdk.ZeroInt(), CodeInvalidCurve},
+		{"logistic with breakpoints", CurveLogistic, 10, []Breakpoint{bp(10, 2)}, initPrice, sdk.ZeroInt(), CodeInvalidCurve},
+		{"piecewise-linear", CurvePiecewiseLinear, 0, []Breakpoint{bp(10, 2), bp(500, 2), bp(900, 8)}, initPrice, sdk.ZeroInt(), sdk.CodeOK},
+		{"piecewise-linear without breakpoints", CurvePiecewiseLinear, 0, nil, initPrice, sdk.ZeroInt(), sdk.CodeOK},
+		{"piecewise-linear with param", CurvePiecewiseLinear, 10, nil, initPrice, sdk.ZeroInt(), CodeInvalidCurve},
+		{"supply not increasing", CurvePiecewiseLinear, 0, []Breakpoint{bp(10, 2), bp(10, 3)}, initPrice, sdk.ZeroInt(), CodeInvalidCurve},
+		{"supply reaches max", CurvePiecewiseLinear, 0, []Breakpoint{bp(1000, 2)}, initPrice, sdk.ZeroInt(), CodeInvalidCurve},
+		{"price decreasing", CurvePiecewiseLinear, 0, []Breakpoint{bp(10, 3), bp(20, 2)}, initPrice, sdk.ZeroInt(), CodeInvalidCurve},
+		{"price beyond max", CurvePiecewiseLinear, 0, []Breakpoint{bp(10, 11)}, initPrice, sdk.ZeroInt(), CodeInvalidCurve},
+		{"incomplete breakpoint", CurvePiecewiseLinear, 0, []Breakpoint{{Price: sdk.NewDec(2)}}, initPrice, sdk.ZeroInt(), CodeInvalidCurve},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckCurve(tt.curveType, tt.curveParam, tt.breakpoints, tt.initPrice, maxPrice, maxSupply, tt.maxMoney)
+			if tt.want == sdk.CodeOK {
+				require.Nil(t, err)
+			} else {
+				require.Equal(t, tt.want, err.Code())
+			}
+		})
+	}
+}
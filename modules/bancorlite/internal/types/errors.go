@@ -38,6 +38,15 @@ const (
 	CodeAlphaBreakLimit              sdk.CodeType = 1030
 	CodeMaxMoneyTooBig               sdk.CodeType = 1031
 	CodeNegativeMaxMoney             sdk.CodeType = 1032
+	CodeAmountAndMoneyAmountBothSet  sdk.CodeType = 1033
+	CodeMoneyAmountTooSmall          sdk.CodeType = 1034
+	CodeInvalidCurve                 sdk.CodeType = 1035
+	CodeInsufficientShares           sdk.CodeType = 1036
+	CodePoolNotResizable             sdk.CodeType = 1037
+	CodeInvalidModification          sdk.CodeType = 1038
+	CodeNoSwapRoute                  sdk.CodeType = 1039
+	CodeAddressNotPermitted          sdk.CodeType = 1040
+	CodeTooManyProviders             sdk.CodeType = 1041
 )
 
 func ErrInvalidSymbol() sdk.Error {
@@ -152,6 +161,42 @@ func ErrMaxMoneyTooBIg() sdk.Error {
 	return sdk.NewError(CodeSpaceBancorlite, CodeMaxMoneyTooBig, "max money is too big")
 }
 
+func ErrAmountAndMoneyAmountBothSet() sdk.Error {
+	return sdk.NewError(CodeSpaceBancorlite, CodeAmountAndMoneyAmountBothSet, "stock amount and money amount can not be both specified")
+}
+
+func ErrMoneyAmountTooSmall() sdk.Error {
+	return sdk.NewError(CodeSpaceBancorlite, CodeMoneyAmountTooSmall, "The money amount is too small to trade any stock")
+}
+
+func ErrInvalidCurve(reason string) sdk.Error {
+	return sdk.NewError(CodeSpaceBancorlite, CodeInvalidCurve, "Invalid bonding curve: "+reason)
+}
+
+func ErrInsufficientShares() sdk.Error {
+	return sdk.NewError(CodeSpaceBancorlite, CodeInsufficientShares, "The provider does not have enough shares of the Bancor pool")
+}
+
+func ErrPoolNotResizable() sdk.Error {
+	return sdk.NewError(CodeSpaceBancorlite, CodePoolNotResizable, "The Bancor pool can not be resized by this amount")
+}
+
+func ErrInvalidModification(reason string) sdk.Error {
+	return sdk.NewError(CodeSpaceBancorlite, CodeInvalidModification, "Invalid modification of the Bancor pool: "+reason)
+}
+
+func ErrNoSwapRoute() sdk.Error {
+	return sdk.NewError(CodeSpaceBancorlite, CodeNoSwapRoute, "Neither the Bancor pool nor the order book can trade at this price")
+}
+
+func ErrAddressNotPermitted(denom string, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(CodeSpaceBancorlite, CodeAddressNotPermitted, "%s is not permitted by the issuer of permissioned token %s", addr, denom)
+}
+
+func ErrTooManyProviders() sdk.Error {
+	return sdk.NewError(CodeSpaceBancorlite, CodeTooManyProviders, "The Bancor pool already has %d liquidity providers", MaxProvidersPerPool)
+}
+
 func ErrMarshalFailed() sdk.Error {
 	return sdk.NewError(CodeSpaceBancorlite, CodeMarshalFailed, "could not marshal result to JSON")
 }
@@ -23,5 +23,9 @@ func TestErrMsg(t *testing.T) {
 	require.Equal(t, CodeCancelEnableTimeNegative, err.Code())
 	err = ErrEarliestCancelTimeNotArrive()
 	require.Equal(t, CodeCancelTimeNotArrived, err.Code())
+	err = ErrAddressNotPermitted("abc", nil)
+	require.Equal(t, CodeAddressNotPermitted, err.Code())
+	err = ErrTooManyProviders()
+	require.Equal(t, CodeTooManyProviders, err.Code())
 
 }
@@ -2,6 +2,8 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/market"
 )
 
 // Bankx Keeper will implement the interface
@@ -18,6 +20,7 @@ type ExpectedAssetStatusKeeper interface {
 	IsTokenExists(ctx sdk.Context, denom string) bool // check whether there is a coin named "denom"
 	IsTokenIssuer(ctx sdk.Context, denom string, addr sdk.AccAddress) bool
 	IsForbiddenByTokenIssuer(ctx sdk.Context, denom string, addr sdk.AccAddress) bool
+	IsPermittedByTokenIssuer(ctx sdk.Context, denom string, addr sdk.AccAddress) bool
 }
 
 // market keeper will implement the interface
@@ -25,6 +28,13 @@ type ExpectedMarketKeeper interface {
 	IsMarketExist(ctx sdk.Context, symbol string) bool
 	GetMarketFeeMin(ctx sdk.Context) int64
 	GetMarketVolume(ctx sdk.Context, stock, money string, stockVolume, moneyVolume sdk.Dec) sdk.Dec
+	GetMarketInfo(ctx sdk.Context, symbol string) (market.MarketInfo, error)
+	GetDepth(ctx sdk.Context, symbol string) (bids []market.DepthLevel, asks []market.DepthLevel)
+}
+
+// market module will implement the interface, to place the order book part of a routed swap
+type ExpectedOrderCreator interface {
+	CreateOrder(ctx sdk.Context, msg market.MsgCreateOrder) (string, sdk.Error)
 }
 
 type ExpectedAuthXKeeper interface {
@@ -16,6 +16,10 @@ const MaxTradeAmount = int64(10000) * int64(10000) * int64(10000) * int64(10000)
 var _ sdk.Msg = MsgBancorInit{}
 var _ sdk.Msg = MsgBancorTrade{}
 var _ sdk.Msg = MsgBancorCancel{}
+var _ sdk.Msg = MsgBancorDeposit{}
+var _ sdk.Msg = MsgBancorWithdraw{}
+var _ sdk.Msg = MsgBancorModify{}
+var _ sdk.Msg = MsgBancorSwap{}
 
 type MsgBancorInit struct {
 	Owner              sdk.AccAddress `json:"owner"`
@@ -27,6 +31,10 @@ type MsgBancorInit struct {
 	MaxMoney           sdk.Int        `json:"max_money"`
 	StockPrecision     byte           `json:"stock_precision"`
 	EarliestCancelTime int64          `json:"earliest_cancel_time"`
+	CurveType          byte           `json:"curve_type,omitempty"`
+	// steepness of the exponential and logistic curves, multiplied by CurveParamSamples
+	CurveParam  int64        `json:"curve_param,omitempty"`
+	Breakpoints []Breakpoint `json:"breakpoints,omitempty"`
 }
 
 type MsgBancorCancel struct {
@@ -44,6 +52,58 @@ type MsgBancorTrade struct {
 	IsBuy  bool  `json:"is_buy"`
 	//money up limit
 	MoneyLimit int64 `json:"money_limit"`
+	//when positive, Amount must be zero and the stock amount is decided by this money amount:
+	//buy as much stock as it can pay for, or sell as little stock as it brings in
+	MoneyAmount int64 `json:"money_amount,omitempty"`
+}
+
+// MsgBancorDeposit provides liquidity to a bancor pool, the sender deposits stock and money
+// in the same ratio as they are in the pool, and gets pool shares in return
+type MsgBancorDeposit struct {
+	Sender sdk.AccAddress `json:"sender"`
+	Stock  string         `json:"stock"`
+	Money  string         `json:"money"`
+	//stock amount
+	Amount int64 `json:"amount"`
+	//money up limit
+	MoneyLimit int64 `json:"money_limit"`
+}
+
+// MsgBancorWithdraw takes back a provider's stock, money and commission from a bancor pool, pro-rata to the shares
+type MsgBancorWithdraw struct {
+	Sender sdk.AccAddress `json:"sender"`
+	Stock  string         `json:"stock"`
+	Money  string         `json:"money"`
+	Shares int64          `json:"shares"`
+}
+
+// MsgBancorModify proposes changes to a bancor pool, which take effect after a timelock,
+// so that the traders have time to react. No new proposal is accepted while one is pending.
+type MsgBancorModify struct {
+	Owner sdk.AccAddress `json:"owner"`
+	Stock string         `json:"stock"`
+	Money string         `json:"money"`
+	// the new earliest cancel time, which can only be extended, zero means unchanged
+	EarliestCancelTime int64 `json:"earliest_cancel_time"`
+	// the stock amount frozen to raise MaxSupply, the pool keeps its curve shape
+	SupplyIncrease int64 `json:"supply_increase"`
+	ChangeOwnerFee bool  `json:"change_owner_fee"`
+	// the fee charged for the pool in CET, in 1/10000 of the trade volume
+	OwnerFeeRate int64 `json:"owner_fee_rate"`
+}
+
+// MsgBancorSwap trades stock at a price no worse than the limit, splitting the amount between the
+// bancor pool and an IOC order on the order book of the same pair, for the best average price
+type MsgBancorSwap struct {
+	Sender sdk.AccAddress `json:"sender"`
+	Stock  string         `json:"stock"`
+	Money  string         `json:"money"`
+	//stock amount
+	Amount int64 `json:"amount"`
+	IsBuy  bool  `json:"is_buy"`
+	//the limit price is Price/10^PricePrecision, as in the orders of the market
+	Price          int64 `json:"price"`
+	PricePrecision byte  `json:"price_precision"`
 }
 
 func (msg MsgBancorInit) GetSymbol() string {
@@ -55,6 +115,23 @@ func (msg MsgBancorCancel) GetSymbol() string {
 func (msg MsgBancorTrade) GetSymbol() string {
 	return dex.GetSymbol(msg.Stock, msg.Money)
 }
+func (msg MsgBancorDeposit) GetSymbol() string {
+	return dex.GetSymbol(msg.Stock, msg.Money)
+}
+func (msg MsgBancorWithdraw) GetSymbol() string {
+	return dex.GetSymbol(msg.Stock, msg.Money)
+}
+func (msg MsgBancorModify) GetSymbol() string {
+	return dex.GetSymbol(msg.Stock, msg.Money)
+}
+func (msg MsgBancorSwap) GetSymbol() string {
+	return dex.GetSymbol(msg.Stock, msg.Money)
+}
+
+// LimitPrice is the worst price the sender accepts
+func (msg MsgBancorSwap) LimitPrice() sdk.Dec {
+	return sdk.NewDecWithPrec(msg.Price, int64(msg.PricePrecision))
+}
 
 // --------------------------------------------------------
 // sdk.Msg Implementation
@@ -100,13 +177,19 @@ func (msg MsgBancorInit) ValidateBasic() (err sdk.Error) {
 		return ErrNegativePrice()
 	}
 
-	ar, ok := CheckAR(msg, initPrice, maxPrice)
-	if ar > MaxAR || ar < 0 || !ok {
-		return ErrAlphaBreakLimit()
+	if err := CheckCurve(msg.CurveType, msg.CurveParam, msg.Breakpoints,
+		initPrice, maxPrice, msg.MaxSupply, msg.MaxMoney); err != nil {
+		return err
 	}
-	if ar == 0 {
-		if err := checkMaxPrice(initPrice, maxPrice, msg.MaxSupply); err != nil {
-			return err
+	if msg.CurveType == CurvePower {
+		ar, ok := CheckAR(msg, initPrice, maxPrice)
+		if ar > MaxAR || ar < 0 || !ok {
+			return ErrAlphaBreakLimit()
+		}
+		if ar == 0 {
+			if err := checkMaxPrice(initPrice, maxPrice, msg.MaxSupply); err != nil {
+				return err
+			}
 		}
 	}
 	if !CheckStockPrecision(msg.MaxSupply, msg.StockPrecision) {
@@ -222,10 +305,27 @@ func (msg MsgBancorTrade) ValidateBasic() sdk.Error {
 	if !market.IsValidTradingPair([]string{msg.Stock, msg.Money}) {
 		return ErrInvalidSymbol()
 	}
-	if msg.Amount <= 0 {
+	return CheckTradeAmount(msg.Amount, msg.MoneyAmount)
+}
+
+// CheckTradeAmount makes sure exactly one of stock amount and money amount is specified
+func CheckTradeAmount(amount, moneyAmount int64) sdk.Error {
+	if moneyAmount < 0 {
 		return ErrNonPositiveAmount()
 	}
-	if msg.Amount > MaxTradeAmount {
+	if moneyAmount > 0 {
+		if amount != 0 {
+			return ErrAmountAndMoneyAmountBothSet()
+		}
+		if moneyAmount > MaxTradeAmount {
+			return ErrTradeAmountIsTooLarge()
+		}
+		return nil
+	}
+	if amount <= 0 {
+		return ErrNonPositiveAmount()
+	}
+	if amount > MaxTradeAmount {
 		return ErrTradeAmountIsTooLarge()
 	}
 	return nil
@@ -239,6 +339,136 @@ func (msg MsgBancorTrade) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Sender}
 }
 
+func (msg MsgBancorDeposit) Route() string { return RouterKey }
+
+func (msg MsgBancorDeposit) Type() string { return "bancor_deposit" }
+
+func (msg MsgBancorDeposit) ValidateBasic() sdk.Error {
+	if len(msg.Sender) == 0 {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if len(msg.Stock) == 0 || len(msg.Money) == 0 {
+		return ErrInvalidSymbol()
+	}
+	if !market.IsValidTradingPair([]string{msg.Stock, msg.Money}) {
+		return ErrInvalidSymbol()
+	}
+	if msg.Amount <= 0 {
+		return ErrNonPositiveAmount()
+	}
+	if msg.Amount > MaxTradeAmount {
+		return ErrTradeAmountIsTooLarge()
+	}
+	return nil
+}
+
+func (msg MsgBancorDeposit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgBancorDeposit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgBancorWithdraw) Route() string { return RouterKey }
+
+func (msg MsgBancorWithdraw) Type() string { return "bancor_withdraw" }
+
+func (msg MsgBancorWithdraw) ValidateBasic() sdk.Error {
+	if len(msg.Sender) == 0 {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if len(msg.Stock) == 0 || len(msg.Money) == 0 {
+		return ErrInvalidSymbol()
+	}
+	if msg.Shares <= 0 {
+		return ErrNonPositiveAmount()
+	}
+	return nil
+}
+
+func (msg MsgBancorWithdraw) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgBancorWithdraw) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgBancorModify) Route() string { return RouterKey }
+
+func (msg MsgBancorModify) Type() string { return "bancor_modify" }
+
+func (msg MsgBancorModify) ValidateBasic() sdk.Error {
+	if len(msg.Owner) == 0 {
+		return sdk.ErrInvalidAddress("missing owner address")
+	}
+	if len(msg.Stock) == 0 || len(msg.Money) == 0 {
+		return ErrInvalidSymbol()
+	}
+	if msg.EarliestCancelTime == 0 && msg.SupplyIncrease == 0 && !msg.ChangeOwnerFee {
+		return ErrInvalidModification("nothing to modify")
+	}
+	if msg.EarliestCancelTime < 0 {
+		return ErrEarliestCancelTimeIsNegative()
+	}
+	if msg.SupplyIncrease < 0 {
+		return ErrNonPositiveSupply()
+	}
+	if msg.SupplyIncrease > MaxTradeAmount {
+		return ErrMaxSupplyTooBig()
+	}
+	if msg.OwnerFeeRate < 0 || (!msg.ChangeOwnerFee && msg.OwnerFeeRate != 0) {
+		return ErrInvalidModification("invalid owner fee rate")
+	}
+	return nil
+}
+
+func (msg MsgBancorModify) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgBancorModify) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+func (msg MsgBancorSwap) Route() string { return RouterKey }
+
+func (msg MsgBancorSwap) Type() string { return "bancor_swap" }
+
+func (msg MsgBancorSwap) ValidateBasic() sdk.Error {
+	if len(msg.Sender) == 0 {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if len(msg.Stock) == 0 || len(msg.Money) == 0 || msg.Stock == "cet" {
+		return ErrInvalidSymbol()
+	}
+	if !market.IsValidTradingPair([]string{msg.Stock, msg.Money}) {
+		return ErrInvalidSymbol()
+	}
+	if msg.Amount <= 0 {
+		return ErrNonPositiveAmount()
+	}
+	if msg.Amount > MaxTradeAmount {
+		return ErrTradeAmountIsTooLarge()
+	}
+	if msg.Price <= 0 {
+		return ErrNonPositivePrice()
+	}
+	if msg.PricePrecision > market.MaxTokenPricePrecision {
+		return ErrPriceFmt()
+	}
+	return nil
+}
+
+func (msg MsgBancorSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgBancorSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
 // --------------------------------------------------------
 // SetAccAddress
 
@@ -251,3 +481,15 @@ func (msg *MsgBancorTrade) SetAccAddress(addr sdk.AccAddress) {
 func (msg *MsgBancorCancel) SetAccAddress(addr sdk.AccAddress) {
 	msg.Owner = addr
 }
+func (msg *MsgBancorDeposit) SetAccAddress(addr sdk.AccAddress) {
+	msg.Sender = addr
+}
+func (msg *MsgBancorWithdraw) SetAccAddress(addr sdk.AccAddress) {
+	msg.Sender = addr
+}
+func (msg *MsgBancorModify) SetAccAddress(addr sdk.AccAddress) {
+	msg.Owner = addr
+}
+func (msg *MsgBancorSwap) SetAccAddress(addr sdk.AccAddress) {
+	msg.Sender = addr
+}
@@ -50,3 +50,29 @@ type MsgBancorCancelForKafka struct {
 	Money       string         `json:"money"`
 	BlockHeight int64          `json:"block_height"`
 }
+
+type MsgBancorLiquidityForKafka struct {
+	Provider    sdk.AccAddress `json:"provider"`
+	Stock       string         `json:"stock"`
+	Money       string         `json:"money"`
+	Shares      int64          `json:"shares"`
+	StockAmount sdk.Int        `json:"stock_amount"`
+	MoneyAmount sdk.Int        `json:"money_amount"`
+	Commission  int64          `json:"commission"`
+	BlockHeight int64          `json:"block_height"`
+}
+
+type MsgBancorModifyForKafka struct {
+	Owner              sdk.AccAddress `json:"owner"`
+	Stock              string         `json:"stock"`
+	Money              string         `json:"money"`
+	EarliestCancelTime int64          `json:"earliest_cancel_time"`
+	SupplyIncrease     int64          `json:"supply_increase"`
+	ChangeOwnerFee     bool           `json:"change_owner_fee"`
+	OwnerFeeRate       int64          `json:"owner_fee_rate"`
+	ExecutionTime      int64          `json:"execution_time"`
+	// false when it is proposed, true when it has been executed or has failed
+	Done        bool   `json:"done"`
+	Error       string `json:"error,omitempty"`
+	BlockHeight int64  `json:"block_height"`
+}
@@ -145,12 +145,13 @@ func TestCheckAR(t *testing.T) {
 
 func TestMsgBancorTrade_ValidateBasic(t *testing.T) {
 	type fields struct {
-		Sender     sdk.AccAddress
-		Stock      string
-		Money      string
-		Amount     int64
-		IsBuy      bool
-		MoneyLimit int64
+		Sender      sdk.AccAddress
+		Stock       string
+		Money       string
+		Amount      int64
+		IsBuy       bool
+		MoneyLimit  int64
+		MoneyAmount int64
 	}
 	tests := []struct {
 		name   string
@@ -217,16 +218,62 @@ func TestMsgBancorTrade_ValidateBasic(t *testing.T) {
 			},
 			want: ErrTradeAmountIsTooLarge(),
 		},
+		{
+			name: "positive money amount",
+			fields: fields{
+				Sender:      addrUser,
+				Stock:       "abc",
+				Money:       "cet",
+				IsBuy:       true,
+				MoneyAmount: 100,
+			},
+			want: nil,
+		},
+		{
+			name: "negative money amount",
+			fields: fields{
+				Sender:      addrUser,
+				Stock:       "abc",
+				Money:       "cet",
+				IsBuy:       false,
+				MoneyAmount: -1,
+			},
+			want: ErrNonPositiveAmount(),
+		},
+		{
+			name: "both amount and money amount",
+			fields: fields{
+				Sender:      addrUser,
+				Stock:       "abc",
+				Money:       "cet",
+				Amount:      10,
+				IsBuy:       true,
+				MoneyAmount: 100,
+			},
+			want: ErrAmountAndMoneyAmountBothSet(),
+		},
+		{
+			name: "money amount exceed max",
+			fields: fields{
+				Sender:      addrUser,
+				Stock:       "abc",
+				Money:       "cet",
+				IsBuy:       true,
+				MoneyAmount: MaxTradeAmount + 1,
+			},
+			want: ErrTradeAmountIsTooLarge(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			msg := MsgBancorTrade{
-				Sender:     tt.fields.Sender,
-				Stock:      tt.fields.Stock,
-				Money:      tt.fields.Money,
-				Amount:     tt.fields.Amount,
-				IsBuy:      tt.fields.IsBuy,
-				MoneyLimit: tt.fields.MoneyLimit,
+				Sender:      tt.fields.Sender,
+				Stock:       tt.fields.Stock,
+				Money:       tt.fields.Money,
+				Amount:      tt.fields.Amount,
+				IsBuy:       tt.fields.IsBuy,
+				MoneyLimit:  tt.fields.MoneyLimit,
+				MoneyAmount: tt.fields.MoneyAmount,
 			}
 			if got := msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("MsgBancorTrade.ValidateBasic() = %v, want %v", got, tt.want)
@@ -289,6 +336,87 @@ func TestMsgBancorCancel_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgBancorDepositAndWithdraw_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  sdk.Msg
+		want sdk.Error
+	}{
+		{"deposit", MsgBancorDeposit{Sender: addrUser, Stock: "abc", Money: "cet", Amount: 100, MoneyLimit: 10}, nil},
+		{"deposit without sender", MsgBancorDeposit{Stock: "abc", Money: "cet", Amount: 100},
+			sdk.ErrInvalidAddress("missing sender address")},
+		{"deposit invalid symbol", MsgBancorDeposit{Sender: addrUser, Money: "cet", Amount: 100}, ErrInvalidSymbol()},
+		{"deposit zero amount", MsgBancorDeposit{Sender: addrUser, Stock: "abc", Money: "cet"}, ErrNonPositiveAmount()},
+		{"deposit too much", MsgBancorDeposit{Sender: addrUser, Stock: "abc", Money: "cet", Amount: MaxTradeAmount + 1},
+			ErrTradeAmountIsTooLarge()},
+		{"withdraw", MsgBancorWithdraw{Sender: addrUser, Stock: "abc", Money: "cet", Shares: 100}, nil},
+		{"withdraw without sender", MsgBancorWithdraw{Stock: "abc", Money: "cet", Shares: 100},
+			sdk.ErrInvalidAddress("missing sender address")},
+		{"withdraw negative shares", MsgBancorWithdraw{Sender: addrUser, Stock: "abc", Money: "cet", Shares: -1},
+			ErrNonPositiveAmount()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgBancorModify_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgBancorModify
+		want sdk.Error
+	}{
+		{"positive", MsgBancorModify{Owner: addrUser, Stock: "abc", Money: "cet", EarliestCancelTime: 100}, nil},
+		{"set owner fee to zero", MsgBancorModify{Owner: addrUser, Stock: "abc", Money: "cet", ChangeOwnerFee: true}, nil},
+		{"nil owner", MsgBancorModify{Stock: "abc", Money: "cet", SupplyIncrease: 100},
+			sdk.ErrInvalidAddress("missing owner address")},
+		{"nothing to modify", MsgBancorModify{Owner: addrUser, Stock: "abc", Money: "cet"},
+			ErrInvalidModification("nothing to modify")},
+		{"negative supply increase", MsgBancorModify{Owner: addrUser, Stock: "abc", Money: "cet", SupplyIncrease: -1},
+			ErrNonPositiveSupply()},
+		{"owner fee without change", MsgBancorModify{Owner: addrUser, Stock: "abc", Money: "cet", SupplyIncrease: 1, OwnerFeeRate: 10},
+			ErrInvalidModification("invalid owner fee rate")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MsgBancorModify.ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgBancorSwap_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgBancorSwap
+		want sdk.Error
+	}{
+		{"positive", MsgBancorSwap{Sender: addrUser, Stock: "abc", Money: "cet", Amount: 100, IsBuy: true, Price: 12, PricePrecision: 1}, nil},
+		{"nil sender", MsgBancorSwap{Stock: "abc", Money: "cet", Amount: 100, Price: 12},
+			sdk.ErrInvalidAddress("missing sender address")},
+		{"stock is cet", MsgBancorSwap{Sender: addrUser, Stock: "cet", Money: "abc", Amount: 100, Price: 12},
+			ErrInvalidSymbol()},
+		{"zero amount", MsgBancorSwap{Sender: addrUser, Stock: "abc", Money: "cet", Price: 12},
+			ErrNonPositiveAmount()},
+		{"zero price", MsgBancorSwap{Sender: addrUser, Stock: "abc", Money: "cet", Amount: 100},
+			ErrNonPositivePrice()},
+		{"price precision too large", MsgBancorSwap{Sender: addrUser, Stock: "abc", Money: "cet", Amount: 100, Price: 12, PricePrecision: 19},
+			ErrPriceFmt()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MsgBancorSwap.ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestCheckStockPrecision(t *testing.T) {
 	amount := sdk.NewInt(110000)
 	var precision byte = 4
@@ -13,18 +13,38 @@ const (
 	DefaultCancelBancorFee = 1e10 // 100 * 10 ^8
 	TradeFeeRatePrecision  = 4
 	DefaultTradeFeeRate    = 10
+	// the ratio of trade commission paid to the pool's liquidity providers, in 1/10000
+	DefaultProviderFeeRatio = 5000
+	ProviderFeeRatioBase    = 10000
+	// the most liquidity providers a bancor pool can have, which bounds the work of cancelling it
+	MaxProvidersPerPool = 100
+	// the seconds a bancor pool's owner must wait before a modification takes effect
+	DefaultModifyTimelock = 3 * 24 * 3600
+	// the upper bound of the fee rate a bancor pool's owner can set, in 1/10000
+	DefaultMaxOwnerFeeRate = 100
+	// the number of recent trades and closing prices kept for each bancor pool, zero keeps none
+	DefaultTradeHistorySize = 100
+	MaxTradeHistorySize     = 10000
 )
 
 var (
-	KeyCreateBancorFee = []byte("CreateBancorFee")
-	KeyCancelBancorFee = []byte("CancelBancorFee")
-	KeyTradeFeeRate    = []byte("TradeFeeRate")
+	KeyCreateBancorFee  = []byte("CreateBancorFee")
+	KeyCancelBancorFee  = []byte("CancelBancorFee")
+	KeyTradeFeeRate     = []byte("TradeFeeRate")
+	KeyProviderFeeRatio = []byte("ProviderFeeRatio")
+	KeyModifyTimelock   = []byte("ModifyTimelock")
+	KeyMaxOwnerFeeRate  = []byte("MaxOwnerFeeRate")
+	KeyTradeHistorySize = []byte("TradeHistorySize")
 )
 
 type Params struct {
-	CreateBancorFee int64 `json:"create_bancor_fee"`
-	CancelBancorFee int64 `json:"cancel_bancor_fee"`
-	TradeFeeRate    int64 `json:"trade_fee_rate"`
+	CreateBancorFee  int64 `json:"create_bancor_fee"`
+	CancelBancorFee  int64 `json:"cancel_bancor_fee"`
+	TradeFeeRate     int64 `json:"trade_fee_rate"`
+	ProviderFeeRatio int64 `json:"provider_fee_ratio"`
+	ModifyTimelock   int64 `json:"modify_timelock"`
+	MaxOwnerFeeRate  int64 `json:"max_owner_fee_rate"`
+	TradeHistorySize int64 `json:"trade_history_size"`
 }
 
 // ParamKeyTable for bancorlite module
@@ -38,6 +58,10 @@ func DefaultParams() Params {
 		DefaultCreateBancorFee,
 		DefaultCancelBancorFee,
 		DefaultTradeFeeRate,
+		DefaultProviderFeeRatio,
+		DefaultModifyTimelock,
+		DefaultMaxOwnerFeeRate,
+		DefaultTradeHistorySize,
 	}
 }
 
@@ -49,6 +73,10 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyCreateBancorFee, Value: &p.CreateBancorFee},
 		{Key: KeyCancelBancorFee, Value: &p.CancelBancorFee},
 		{Key: KeyTradeFeeRate, Value: &p.TradeFeeRate},
+		{Key: KeyProviderFeeRatio, Value: &p.ProviderFeeRatio},
+		{Key: KeyModifyTimelock, Value: &p.ModifyTimelock},
+		{Key: KeyMaxOwnerFeeRate, Value: &p.MaxOwnerFeeRate},
+		{Key: KeyTradeHistorySize, Value: &p.TradeHistorySize},
 	}
 }
 
@@ -59,6 +87,18 @@ func (p *Params) ValidateGenesis() error {
 	if p.TradeFeeRate < 0 || p.TradeFeeRate >= int64(math.Pow10(TradeFeeRatePrecision)) {
 		return fmt.Errorf("TradeFeeRate is invalid")
 	}
+	if p.ProviderFeeRatio < 0 || p.ProviderFeeRatio > ProviderFeeRatioBase {
+		return fmt.Errorf("ProviderFeeRatio is invalid")
+	}
+	if p.ModifyTimelock < 0 {
+		return fmt.Errorf("ModifyTimelock is invalid")
+	}
+	if p.MaxOwnerFeeRate < 0 || p.MaxOwnerFeeRate >= int64(math.Pow10(TradeFeeRatePrecision)) {
+		return fmt.Errorf("MaxOwnerFeeRate is invalid")
+	}
+	if p.TradeHistorySize < 0 || p.TradeHistorySize > MaxTradeHistorySize {
+		return fmt.Errorf("TradeHistorySize is invalid")
+	}
 	return nil
 }
 
@@ -73,8 +113,16 @@ func (p Params) String() string {
 	return fmt.Sprintf(`BancorLite Params:
   CreateBancorFee: %d
   CancelBancorFee: %d
-  TradeFeeRate:    %d`,
+  TradeFeeRate:    %d
+  ProviderFeeRatio: %d
+  ModifyTimelock:  %d
+  MaxOwnerFeeRate: %d
+  TradeHistorySize: %d`,
 		p.CreateBancorFee,
 		p.CancelBancorFee,
-		p.TradeFeeRate)
+		p.TradeFeeRate,
+		p.ProviderFeeRatio,
+		p.ModifyTimelock,
+		p.MaxOwnerFeeRate,
+		p.TradeHistorySize)
 }
@@ -32,6 +32,15 @@ var (
 	NewMsgSend                         = types.NewMsgSend
 	NewMsgSetTransferMemoRequired      = types.NewMsgSetTransferMemoRequired
 	NewMsgMultiSend                    = types.NewMsgMultiSend
+	NewMsgCreateHTLC                   = types.NewMsgCreateHTLC
+	NewMsgClaimHTLC                    = types.NewMsgClaimHTLC
+	NewMsgRefundHTLC                   = types.NewMsgRefundHTLC
+	NewMsgCreateStream                 = types.NewMsgCreateStream
+	NewMsgWithdrawStream               = types.NewMsgWithdrawStream
+	NewMsgCancelStream                 = types.NewMsgCancelStream
+	NewMsgVestingSend                  = types.NewMsgVestingSend
+	NewMsgLockedMultiSend              = types.NewMsgLockedMultiSend
+	NewLockedOutput                    = types.NewLockedOutput
 	ErrMemoMissing                     = types.ErrMemoMissing
 	ErrInsufficientCETForActivatingFee = types.ErrInsufficientCETForActivatingFee
 
@@ -40,12 +49,24 @@ var (
 	ModuleCdc                           = types.ModuleCdc
 	CodeMemoMissing                     = types.CodeMemoMissing
 	CodeInsufficientCETForActivatingFee = types.CodeInsufficientCETForActivationFee
+	CodeTokenFrozenByOwner              = types.CodeTokenFrozenByOwner
 )
 
 type (
 	Keeper             = keeper.Keeper
+	BankxHooks         = types.BankxHooks
 	MsgSend            = types.MsgSend
 	MsgSetMemoRequired = types.MsgSetMemoRequired
 	MsgMultiSend       = types.MsgMultiSend
 	MsgSupervisedSend  = types.MsgSupervisedSend
+	MsgCreateHTLC      = types.MsgCreateHTLC
+	MsgClaimHTLC       = types.MsgClaimHTLC
+	MsgRefundHTLC      = types.MsgRefundHTLC
+	MsgCreateStream    = types.MsgCreateStream
+	MsgWithdrawStream  = types.MsgWithdrawStream
+	MsgCancelStream    = types.MsgCancelStream
+	MsgVestingSend     = types.MsgVestingSend
+	MsgLockedMultiSend = types.MsgLockedMultiSend
+	LockedOutput       = types.LockedOutput
+	StreamInfo         = types.StreamInfo
 )
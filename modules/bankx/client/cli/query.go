@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -23,6 +25,10 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	aliasQueryCmd.AddCommand(client.GetCommands(
 		QueryParamsCmd(cdc),
 		QueryBalancesCmd(cdc),
+		QueryHTLCCmd(cdc),
+		QueryHTLCsCmd(cdc),
+		QueryStreamCmd(cdc),
+		QueryStreamsCmd(cdc),
 	)...)
 	return aliasQueryCmd
 }
@@ -55,3 +61,75 @@ func QueryBalancesCmd(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func QueryHTLCCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "htlc [sender] [hash_lock]",
+		Short: "Query the hash time-locked transfer by its sender and hex-encoded hash lock",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keeper.QueryHTLC)
+			sender, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			hashLock, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+			param := keeper.NewQueryHTLCParam(sender, hashLock)
+			return cliutil.CliQuery(cdc, route, &param)
+		},
+	}
+}
+
+func QueryHTLCsCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "htlcs [address]",
+		Short: "Query the hash time-locked transfers sent or received by an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keeper.QueryHTLCs)
+			acc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			param := keeper.NewQueryAddrHTLCs(acc)
+			return cliutil.CliQuery(cdc, route, &param)
+		},
+	}
+}
+
+func QueryStreamCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "stream [stream_id]",
+		Short: "Query the streaming payment and its withdrawable amount",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keeper.QueryStream)
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			param := keeper.NewQueryStreamParam(id)
+			return cliutil.CliQuery(cdc, route, &param)
+		},
+	}
+}
+
+func QueryStreamsCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "streams [address]",
+		Short: "Query the streaming payments sent or received by an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keeper.QueryStreams)
+			acc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			param := keeper.NewQueryAddrStreams(acc)
+			return cliutil.CliQuery(cdc, route, &param)
+		},
+	}
+}
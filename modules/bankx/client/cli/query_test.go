@@ -61,3 +61,55 @@ func TestQueryParams(t *testing.T) {
 	assert.Equal(t, nil, err)
 	assert.Equal(t, "custom/bankx/parameters", ResultPath)
 }
+
+func TestQueryHTLC(t *testing.T) {
+	var resultParam interface{}
+	cliutil.CliQuery = func(cdc *codec.Codec, path string, param interface{}) error {
+		resultParam = param
+		ResultPath = path
+		return nil
+	}
+
+	sdk.GetConfig().SetBech32PrefixForAccount("coinex", "coinexpub")
+	cmd := GetQueryCmd(nil)
+	hashLock := []byte{0xab, 0xcd}
+	sender, _ := sdk.AccAddressFromBech32("coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a")
+	cmd.SetArgs([]string{"htlc", "coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a", "abcd"})
+	err := cmd.Execute()
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "custom/bankx/htlc", ResultPath)
+	param := keeper.NewQueryHTLCParam(sender, hashLock)
+	assert.Equal(t, &param, resultParam)
+
+	cmd.SetArgs([]string{"htlcs", "coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a"})
+	err = cmd.Execute()
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "custom/bankx/htlcs", ResultPath)
+	addr, _ := sdk.AccAddressFromBech32("coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a")
+	assert.Equal(t, &keeper.QueryAddrHTLCs{Addr: addr}, resultParam)
+}
+
+func TestQueryStream(t *testing.T) {
+	var resultParam interface{}
+	cliutil.CliQuery = func(cdc *codec.Codec, path string, param interface{}) error {
+		resultParam = param
+		ResultPath = path
+		return nil
+	}
+
+	sdk.GetConfig().SetBech32PrefixForAccount("coinex", "coinexpub")
+	cmd := GetQueryCmd(nil)
+	cmd.SetArgs([]string{"stream", "3"})
+	err := cmd.Execute()
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "custom/bankx/stream", ResultPath)
+	param := keeper.NewQueryStreamParam(3)
+	assert.Equal(t, &param, resultParam)
+
+	cmd.SetArgs([]string{"streams", "coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a"})
+	err = cmd.Execute()
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "custom/bankx/streams", ResultPath)
+	addr, _ := sdk.AccAddressFromBech32("coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a")
+	assert.Equal(t, &keeper.QueryAddrStreams{Addr: addr}, resultParam)
+}
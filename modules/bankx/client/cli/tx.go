@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"strconv"
 	"time"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/coinexchain/cet-sdk/modules/authx"
 	"github.com/coinexchain/cet-sdk/modules/bankx/internal/types"
 	"github.com/coinexchain/cosmos-utils/client/cliutil"
 )
@@ -22,6 +25,11 @@ const (
 	FlagSupervisor = "supervisor"
 	FlagReward     = "reward"
 	FlagOperation  = "operation"
+	FlagExpireTime = "expire-time"
+	FlagStartTime  = "start-time"
+	FlagEndTime    = "end-time"
+	FlagCliffTime  = "cliff-time"
+	FlagPeriod     = "period"
 )
 
 // SendTxCmd will create a send tx and sign it with the given key.
@@ -65,11 +73,116 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	cmd.AddCommand(client.PostCommands(
 		SendSupervisedTxCmd(cdc),
+		SendVestingTxCmd(cdc),
+		SendLockedMultiTxCmd(cdc),
 	)...)
 
 	return cmd
 }
 
+func SendVestingTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting-tx [to_address] [amount]",
+		Short: "Create and sign a tx sending coins which are released by a vesting schedule",
+		Long: `Create and sign a tx sending coins which are released pro rata to the time elapsed since the
+start time: nothing before the cliff time, then the vested part at the cliff time and every period
+after it, and the rest at the end time.
+
+Example:
+    cetcli tx send vesting-tx coinex1ke3qq22zvzlcdh3j8nenlrjxmvnrna7z426n0x 1000000000cet \
+        --start-time=1600000000 \
+        --cliff-time=1607776000 \
+        --end-time=1631104000 \
+        --period=2592000 \
+        --from=sender_user
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			schedule := authx.NewVestingSchedule(viper.GetInt64(FlagStartTime), viper.GetInt64(FlagCliffTime),
+				viper.GetInt64(FlagEndTime), viper.GetInt64(FlagPeriod))
+			if err := schedule.Validate(); err != nil {
+				return err
+			}
+			if schedule.EndTime < time.Now().Unix() {
+				return fmt.Errorf("end time should be later than the current time")
+			}
+
+			msg := types.NewMsgVestingSend(nil, to, coins, schedule)
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartTime, 0, "The unix timestamp from which the coins start vesting")
+	cmd.Flags().Int64(FlagCliffTime, 0, "The unix timestamp before which no coins are released")
+	cmd.Flags().Int64(FlagEndTime, 0, "The unix timestamp at which all the coins are released")
+	cmd.Flags().Int64(FlagPeriod, 0, "The seconds between two releases after the cliff time")
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+	_ = cmd.MarkFlagRequired(FlagStartTime)
+	_ = cmd.MarkFlagRequired(FlagCliffTime)
+	_ = cmd.MarkFlagRequired(FlagEndTime)
+	_ = cmd.MarkFlagRequired(FlagPeriod)
+
+	return cmd
+}
+
+func SendLockedMultiTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "locked-multi-tx [outputs_file]",
+		Short: "Create and sign a tx sending coins to several outputs, each of which may be locked and supervised",
+		Long: `Create and sign a tx sending coins to the outputs in a JSON file. The coins of an output are
+locked until its unlock time if it is set, and can be unlocked earlier or returned by its supervisor
+if it is set. The lock fee is charged for each locked output.
+
+Example:
+    cetcli tx send locked-multi-tx outputs.json --from=sender_user
+
+outputs.json:
+    [
+        {"address": "coinex1ke3qq22zvzlcdh3j8nenlrjxmvnrna7z426n0x",
+         "coins": [{"denom": "cet", "amount": "1000000000"}], "unlock_time": "1600000000"},
+        {"address": "coinex1hckjvduhckfaxq2tuythfd270cex94c0hv5hs7",
+         "coins": [{"denom": "cet", "amount": "1000000000"}], "unlock_time": "1600000000",
+         "supervisor": "coinex1qga320mdvfhr62hcjn78n6pjl3z3vsvgtz2w8t", "reward": "100000000"}
+    ]
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var outputs []types.LockedOutput
+			if err := types.ModuleCdc.UnmarshalJSON(bz, &outputs); err != nil {
+				return err
+			}
+
+			currentTime := time.Now().Unix()
+			for _, out := range outputs {
+				if out.IsLocked() && out.UnlockTime < currentTime {
+					return fmt.Errorf("unlock time should be later than the current time")
+				}
+			}
+
+			msg := types.NewMsgLockedMultiSend(nil, outputs)
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+
+	return cmd
+}
+
 func RequireMemoCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "require-memo <bool>",
@@ -169,3 +282,220 @@ Example:
 
 	return cmd
 }
+
+// HTLCTxCmd groups the commands of the hash time-locked transfers
+func HTLCTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "htlc",
+		Short: "Hash time-locked transfer subcommands",
+	}
+	cmd.AddCommand(client.PostCommands(
+		CreateHTLCCmd(cdc),
+		ClaimHTLCCmd(cdc),
+		RefundHTLCCmd(cdc),
+	)...)
+	return cmd
+}
+
+func CreateHTLCCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [to_address] [amount] [hash_lock]",
+		Short: "Lock coins under a hash lock, which can be claimed by the recipient before the expire time",
+		Long: `Lock coins under a hex-encoded sha256 hash lock. The recipient gets the coins when anyone reveals
+the secret of the hash lock before the expire time, otherwise the coins can be refunded to the sender.
+The htlc is identified by its sender and hash lock.
+
+Example:
+    cetcli tx send htlc create coinex1ke3qq22zvzlcdh3j8nenlrjxmvnrna7z426n0x 1000000000cet \
+        2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b \
+        --expire-time=1600000000 --from=sender_user
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			hashLock, err := hex.DecodeString(args[2])
+			if err != nil {
+				return err
+			}
+
+			expireTime := viper.GetInt64(FlagExpireTime)
+			if expireTime <= time.Now().Unix() {
+				return fmt.Errorf("expire time should be later than the current time")
+			}
+
+			msg := types.NewMsgCreateHTLC(nil, to, coins, hashLock, expireTime)
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagExpireTime, 0, "The unix timestamp after which the coins can only be refunded")
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+	_ = cmd.MarkFlagRequired(FlagExpireTime)
+
+	return cmd
+}
+
+func ClaimHTLCCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim [sender] [hash_lock] [secret]",
+		Short: "Reveal the hex-encoded secret to release the locked coins of the htlc sent by sender to the recipient",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			htlcSender, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			hashLock, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+			secret, err := hex.DecodeString(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimHTLC(nil, htlcSender, hashLock, secret)
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+
+	return cmd
+}
+
+func RefundHTLCCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund [sender] [hash_lock]",
+		Short: "Return the locked coins of an expired htlc to its sender",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			htlcSender, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			hashLock, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRefundHTLC(nil, htlcSender, hashLock)
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+
+	return cmd
+}
+
+// StreamTxCmd groups the commands of the streaming payments
+func StreamTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stream",
+		Short: "Streaming payment subcommands",
+	}
+	cmd.AddCommand(client.PostCommands(
+		CreateStreamCmd(cdc),
+		WithdrawStreamCmd(cdc),
+		CancelStreamCmd(cdc),
+	)...)
+	return cmd
+}
+
+func CreateStreamCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [to_address] [amount]",
+		Short: "Lock coins in a stream, which are vested to the recipient linearly from the start time to the end time",
+		Long: `Lock coins in a stream. The recipient can withdraw the vested part at any time, and the sender can
+cancel the stream to get back the unvested part.
+
+Example:
+    cetcli tx send stream create coinex1ke3qq22zvzlcdh3j8nenlrjxmvnrna7z426n0x 1000000000cet \
+        --start-time=1600000000 --end-time=1602592000 --from=sender_user
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			startTime := viper.GetInt64(FlagStartTime)
+			endTime := viper.GetInt64(FlagEndTime)
+			if startTime < time.Now().Unix() {
+				return fmt.Errorf("start time should not be earlier than the current time")
+			}
+			if endTime <= startTime {
+				return fmt.Errorf("end time should be later than the start time")
+			}
+
+			msg := types.NewMsgCreateStream(nil, to, coin, startTime, endTime)
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartTime, 0, "The unix timestamp from which the coins start vesting")
+	cmd.Flags().Int64(FlagEndTime, 0, "The unix timestamp at which all the coins are vested")
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+	_ = cmd.MarkFlagRequired(FlagStartTime)
+	_ = cmd.MarkFlagRequired(FlagEndTime)
+
+	return cmd
+}
+
+func WithdrawStreamCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw [stream_id]",
+		Short: "Withdraw the vested coins of a stream to its recipient",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawStream(nil, id)
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+
+	return cmd
+}
+
+func CancelStreamCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [stream_id]",
+		Short: "Pay the vested coins of a stream to its recipient and return the rest to its sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelStream(nil, id)
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+
+	return cmd
+}
@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -8,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/coinexchain/cet-sdk/modules/authx"
 	"github.com/coinexchain/cet-sdk/modules/bankx/internal/types"
 	dex "github.com/coinexchain/cet-sdk/types"
 	"github.com/coinexchain/cosmos-utils/client/cliutil"
@@ -87,3 +91,233 @@ func TestRequireMemoCmd(t *testing.T) {
 	}
 	assert.Equal(t, msg, resultMsg)
 }
+
+func TestHTLCTxCmd(t *testing.T) {
+	var resultMsg cliutil.MsgWithAccAddress
+	cliutil.CliRunCommand = func(cdc *codec.Codec, msg cliutil.MsgWithAccAddress) error {
+		cliCtx := context.NewCLIContext().WithCodec(cdc)
+		msg.SetAccAddress(cliCtx.GetFromAddress())
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		resultMsg = msg
+		return nil
+	}
+
+	sdk.GetConfig().SetBech32PrefixForAccount("coinex", "coinexpub")
+	addr, _ := sdk.AccAddressFromHex("01234567890123456789012345678901234abcde")
+	addr1, _ := sdk.AccAddressFromBech32("coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a")
+	secret := []byte("secret")
+	hashLock := hex.EncodeToString(authx.GetHashLock(secret))
+
+	args := []string{
+		"create",
+		"coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a",
+		"1000000000cet",
+		hashLock,
+		"--expire-time=4000000000",
+		"--from=" + addr.String(),
+		"--generate-only",
+	}
+	cmd := HTLCTxCmd(nil)
+	cmd.SetArgs(args)
+	cliutil.SetViperWithArgs(args)
+	err := cmd.Execute()
+	assert.Equal(t, nil, err)
+	createMsg := types.NewMsgCreateHTLC(addr, addr1, dex.NewCetCoins(1000000000), authx.GetHashLock(secret), 4000000000)
+	assert.Equal(t, &createMsg, resultMsg)
+
+	args = []string{
+		"claim",
+		addr1.String(),
+		hashLock,
+		hex.EncodeToString(secret),
+		"--from=" + addr.String(),
+		"--generate-only",
+	}
+	cmd = HTLCTxCmd(nil)
+	cmd.SetArgs(args)
+	cliutil.SetViperWithArgs(args)
+	err = cmd.Execute()
+	assert.Equal(t, nil, err)
+	claimMsg := types.NewMsgClaimHTLC(addr, addr1, authx.GetHashLock(secret), secret)
+	assert.Equal(t, &claimMsg, resultMsg)
+
+	args = []string{
+		"refund",
+		addr1.String(),
+		hashLock,
+		"--from=" + addr.String(),
+		"--generate-only",
+	}
+	cmd = HTLCTxCmd(nil)
+	cmd.SetArgs(args)
+	cliutil.SetViperWithArgs(args)
+	err = cmd.Execute()
+	assert.Equal(t, nil, err)
+	refundMsg := types.NewMsgRefundHTLC(addr, addr1, authx.GetHashLock(secret))
+	assert.Equal(t, &refundMsg, resultMsg)
+}
+
+func TestStreamTxCmd(t *testing.T) {
+	var resultMsg cliutil.MsgWithAccAddress
+	cliutil.CliRunCommand = func(cdc *codec.Codec, msg cliutil.MsgWithAccAddress) error {
+		cliCtx := context.NewCLIContext().WithCodec(cdc)
+		msg.SetAccAddress(cliCtx.GetFromAddress())
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		resultMsg = msg
+		return nil
+	}
+
+	sdk.GetConfig().SetBech32PrefixForAccount("coinex", "coinexpub")
+	addr, _ := sdk.AccAddressFromHex("01234567890123456789012345678901234abcde")
+	addr1, _ := sdk.AccAddressFromBech32("coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a")
+
+	args := []string{
+		"create",
+		"coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a",
+		"1000000000cet",
+		"--start-time=4000000000",
+		"--end-time=4000086400",
+		"--from=" + addr.String(),
+		"--generate-only",
+	}
+	cmd := StreamTxCmd(nil)
+	cmd.SetArgs(args)
+	cliutil.SetViperWithArgs(args)
+	err := cmd.Execute()
+	assert.Equal(t, nil, err)
+	createMsg := types.NewMsgCreateStream(addr, addr1, dex.NewCetCoin(1000000000), 4000000000, 4000086400)
+	assert.Equal(t, &createMsg, resultMsg)
+
+	args = []string{
+		"withdraw",
+		"1",
+		"--from=" + addr.String(),
+		"--generate-only",
+	}
+	cmd = StreamTxCmd(nil)
+	cmd.SetArgs(args)
+	cliutil.SetViperWithArgs(args)
+	err = cmd.Execute()
+	assert.Equal(t, nil, err)
+	withdrawMsg := types.NewMsgWithdrawStream(addr, 1)
+	assert.Equal(t, &withdrawMsg, resultMsg)
+
+	args = []string{
+		"cancel",
+		"1",
+		"--from=" + addr.String(),
+		"--generate-only",
+	}
+	cmd = StreamTxCmd(nil)
+	cmd.SetArgs(args)
+	cliutil.SetViperWithArgs(args)
+	err = cmd.Execute()
+	assert.Equal(t, nil, err)
+	cancelMsg := types.NewMsgCancelStream(addr, 1)
+	assert.Equal(t, &cancelMsg, resultMsg)
+}
+
+func TestSendVestingTxCmd(t *testing.T) {
+	var resultMsg cliutil.MsgWithAccAddress
+	cliutil.CliRunCommand = func(cdc *codec.Codec, msg cliutil.MsgWithAccAddress) error {
+		cliCtx := context.NewCLIContext().WithCodec(cdc)
+		msg.SetAccAddress(cliCtx.GetFromAddress())
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		resultMsg = msg
+		return nil
+	}
+
+	sdk.GetConfig().SetBech32PrefixForAccount("coinex", "coinexpub")
+	addr, _ := sdk.AccAddressFromHex("01234567890123456789012345678901234abcde")
+	addr1, _ := sdk.AccAddressFromBech32("coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a")
+
+	args := []string{
+		"vesting-tx",
+		"coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a",
+		"1000000000cet",
+		"--start-time=4000000000",
+		"--cliff-time=4000100000",
+		"--end-time=4000400000",
+		"--period=100000",
+		"--from=" + addr.String(),
+		"--generate-only",
+	}
+	cmd := SendTxCmd(nil)
+	cmd.SetArgs(args)
+	cliutil.SetViperWithArgs(args)
+	err := cmd.Execute()
+	assert.Equal(t, nil, err)
+	schedule := authx.NewVestingSchedule(4000000000, 4000100000, 4000400000, 100000)
+	msg := types.NewMsgVestingSend(addr, addr1, dex.NewCetCoins(1000000000), schedule)
+	assert.Equal(t, &msg, resultMsg)
+
+	args = []string{
+		"vesting-tx",
+		"coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a",
+		"1000000000cet",
+		"--start-time=4000000000",
+		"--cliff-time=4000100000",
+		"--end-time=4000400000",
+		"--period=0",
+		"--from=" + addr.String(),
+		"--generate-only",
+	}
+	cmd = SendTxCmd(nil)
+	cmd.SetArgs(args)
+	cliutil.SetViperWithArgs(args)
+	err = cmd.Execute()
+	assert.Error(t, err)
+}
+
+func TestSendLockedMultiTxCmd(t *testing.T) {
+	var resultMsg cliutil.MsgWithAccAddress
+	cliutil.CliRunCommand = func(cdc *codec.Codec, msg cliutil.MsgWithAccAddress) error {
+		cliCtx := context.NewCLIContext().WithCodec(cdc)
+		msg.SetAccAddress(cliCtx.GetFromAddress())
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		resultMsg = msg
+		return nil
+	}
+
+	sdk.GetConfig().SetBech32PrefixForAccount("coinex", "coinexpub")
+	addr, _ := sdk.AccAddressFromHex("01234567890123456789012345678901234abcde")
+	addr1, _ := sdk.AccAddressFromBech32("coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a")
+
+	file, err := ioutil.TempFile("", "outputs*.json")
+	assert.Nil(t, err)
+	defer os.Remove(file.Name())
+	_, err = file.WriteString(`[
+		{"address": "coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a",
+		 "coins": [{"denom": "cet", "amount": "1000000000"}]},
+		{"address": "coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a",
+		 "coins": [{"denom": "cet", "amount": "1000000000"}], "unlock_time": "4000000000",
+		 "supervisor": "` + addr.String() + `", "reward": "100000000"}
+	]`)
+	assert.Nil(t, err)
+	assert.Nil(t, file.Close())
+
+	args := []string{
+		"locked-multi-tx",
+		file.Name(),
+		"--from=" + addr.String(),
+		"--generate-only",
+	}
+	cmd := SendTxCmd(nil)
+	cmd.SetArgs(args)
+	cliutil.SetViperWithArgs(args)
+	err = cmd.Execute()
+	assert.Equal(t, nil, err)
+	msg := types.NewMsgLockedMultiSend(addr, []types.LockedOutput{
+		types.NewLockedOutput(addr1, dex.NewCetCoins(1000000000), 0, nil, 0),
+		types.NewLockedOutput(addr1, dex.NewCetCoins(1000000000), 4000000000, addr, 100000000),
+	})
+	assert.Equal(t, &msg, resultMsg)
+}
@@ -1,8 +1,10 @@
 package rest
 
 import (
+	"encoding/hex"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
@@ -38,3 +40,60 @@ func QueryBalancesRequestHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec)
 		restutil.RestQuery(cdc, cliCtx, w, r, route, &params, nil)
 	}
 }
+
+func queryHTLCHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keeper.QueryHTLC)
+		sender, err := sdk.AccAddressFromBech32(mux.Vars(r)["sender"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		hashLock, err := hex.DecodeString(mux.Vars(r)["hash_lock"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := keeper.NewQueryHTLCParam(sender, hashLock)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, &params, nil)
+	}
+}
+
+func queryHTLCsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keeper.QueryHTLCs)
+		acc, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := keeper.NewQueryAddrHTLCs(acc)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, &params, nil)
+	}
+}
+
+func queryStreamHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keeper.QueryStream)
+		id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := keeper.NewQueryStreamParam(id)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, &params, nil)
+	}
+}
+
+func queryStreamsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keeper.QueryStreams)
+		acc, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := keeper.NewQueryAddrStreams(acc)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, &params, nil)
+	}
+}
@@ -11,7 +11,19 @@ import (
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
 	r.HandleFunc("/bank/accounts/{address}/transfers", sendTxRequestHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
 	r.HandleFunc("/bank/accounts/{address}/supervised_transfers", sendSupervisedTxRequestHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/accounts/{address}/vesting_transfers", sendVestingTxRequestHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/locked_multi_transfers", sendLockedMultiTxRequestHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
 	r.HandleFunc("/bank/accounts/memo", sendRequestHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
 	r.HandleFunc("/bank/balances/{address}", QueryBalancesRequestHandlerFn(cliCtx, cdc)).Methods("GET")
 	r.HandleFunc("/bank/parameters", queryParamsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/accounts/{address}/htlcs", createHTLCHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/accounts/{address}/htlcs", queryHTLCsHandlerFn(cliCtx, cdc)).Methods("GET")
+	r.HandleFunc("/bank/htlcs/{sender}/{hash_lock}/claim", claimHTLCHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/htlcs/{sender}/{hash_lock}/refund", refundHTLCHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/htlcs/{sender}/{hash_lock}", queryHTLCHandlerFn(cliCtx, cdc)).Methods("GET")
+	r.HandleFunc("/bank/accounts/{address}/streams", createStreamHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/accounts/{address}/streams", queryStreamsHandlerFn(cliCtx, cdc)).Methods("GET")
+	r.HandleFunc("/bank/streams/{id}/withdraw", withdrawStreamHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/streams/{id}/cancel", cancelStreamHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/streams/{id}", queryStreamHandlerFn(cliCtx, cdc)).Methods("GET")
 }
@@ -46,3 +46,66 @@ func sendSupervisedTxRequestHandlerFn(cdc *codec.Codec, cliCtx context.CLIContex
 	}
 	return restutil.NewRestHandlerBuilder(cdc, cliCtx, new(sendSupervisedReq)).Build(checker)
 }
+
+func sendLockedMultiTxRequestHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	checker := func(cdc *codec.Codec, cliCtx context.CLIContext, req restutil.RestReq) error {
+		currentTime := time.Now().Unix()
+		for _, out := range req.(*lockedMultiSendReq).Outputs {
+			if out.IsLocked() && out.UnlockTime < currentTime {
+				return fmt.Errorf("unlock time should be later than the current time")
+			}
+		}
+		return nil
+	}
+	return restutil.NewRestHandlerBuilder(cdc, cliCtx, new(lockedMultiSendReq)).Build(checker)
+}
+
+func sendVestingTxRequestHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	checker := func(cdc *codec.Codec, cliCtx context.CLIContext, req restutil.RestReq) error {
+		if req.(*vestingSendReq).EndTime < time.Now().Unix() {
+			return fmt.Errorf("end time should be later than the current time")
+		}
+		return nil
+	}
+	return restutil.NewRestHandlerBuilder(cdc, cliCtx, new(vestingSendReq)).Build(checker)
+}
+
+func createHTLCHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	checker := func(cdc *codec.Codec, cliCtx context.CLIContext, req restutil.RestReq) error {
+		if req.(*createHTLCReq).ExpireTime <= time.Now().Unix() {
+			return fmt.Errorf("expire time should be later than the current time")
+		}
+		return nil
+	}
+	return restutil.NewRestHandlerBuilder(cdc, cliCtx, new(createHTLCReq)).Build(checker)
+}
+
+func claimHTLCHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(claimHTLCReq))
+}
+
+func refundHTLCHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(refundHTLCReq))
+}
+
+func createStreamHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	checker := func(cdc *codec.Codec, cliCtx context.CLIContext, req restutil.RestReq) error {
+		streamReq := req.(*createStreamReq)
+		if streamReq.StartTime < time.Now().Unix() {
+			return fmt.Errorf("start time should not be earlier than the current time")
+		}
+		if streamReq.EndTime <= streamReq.StartTime {
+			return fmt.Errorf("end time should be later than the start time")
+		}
+		return nil
+	}
+	return restutil.NewRestHandlerBuilder(cdc, cliCtx, new(createStreamReq)).Build(checker)
+}
+
+func withdrawStreamHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(withdrawStreamReq))
+}
+
+func cancelStreamHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(cancelStreamReq))
+}
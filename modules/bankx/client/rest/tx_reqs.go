@@ -1,13 +1,16 @@
 package rest
 
 import (
+	"encoding/hex"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 
+	"github.com/coinexchain/cet-sdk/modules/authx"
 	"github.com/coinexchain/cet-sdk/modules/bankx/internal/types"
 	"github.com/coinexchain/cosmos-utils/client/restutil"
 )
@@ -33,6 +36,43 @@ type (
 		Reward     int64        `json:"reward,omitempty"`
 		Operation  byte         `json:"operation"`
 	}
+	lockedMultiSendReq struct {
+		BaseReq rest.BaseReq         `json:"base_req"`
+		Outputs []types.LockedOutput `json:"outputs"`
+	}
+	vestingSendReq struct {
+		BaseReq   rest.BaseReq `json:"base_req"`
+		Amount    sdk.Coins    `json:"amount"`
+		StartTime int64        `json:"start_time"`
+		CliffTime int64        `json:"cliff_time"`
+		EndTime   int64        `json:"end_time"`
+		Period    int64        `json:"period"`
+	}
+	createHTLCReq struct {
+		BaseReq    rest.BaseReq `json:"base_req"`
+		Amount     sdk.Coins    `json:"amount"`
+		HashLock   string       `json:"hash_lock"`
+		ExpireTime int64        `json:"expire_time"`
+	}
+	claimHTLCReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+		Secret  string       `json:"secret"`
+	}
+	refundHTLCReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+	}
+	createStreamReq struct {
+		BaseReq   rest.BaseReq `json:"base_req"`
+		Amount    sdk.Coin     `json:"amount"`
+		StartTime int64        `json:"start_time"`
+		EndTime   int64        `json:"end_time"`
+	}
+	withdrawStreamReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+	}
+	cancelStreamReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+	}
 )
 
 func (req *sendReq) New() restutil.RestReq {
@@ -89,6 +129,143 @@ func (req *sendSupervisedReq) GetMsg(r *http.Request, addr sdk.AccAddress) (sdk.
 		req.Reward, req.Operation), nil
 }
 
+func (req *lockedMultiSendReq) New() restutil.RestReq {
+	return new(lockedMultiSendReq)
+}
+
+func (req *lockedMultiSendReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+
+func (req *lockedMultiSendReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	return types.NewMsgLockedMultiSend(sender, req.Outputs), nil
+}
+
+func (req *vestingSendReq) New() restutil.RestReq {
+	return new(vestingSendReq)
+}
+
+func (req *vestingSendReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+
+func (req *vestingSendReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	schedule := authx.NewVestingSchedule(req.StartTime, req.CliffTime, req.EndTime, req.Period)
+	return types.NewMsgVestingSend(sender, getAddr(r), req.Amount, schedule), nil
+}
+
+func (req *createHTLCReq) New() restutil.RestReq {
+	return new(createHTLCReq)
+}
+
+func (req *createHTLCReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+
+func (req *createHTLCReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	hashLock, err := hex.DecodeString(req.HashLock)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewMsgCreateHTLC(sender, getAddr(r), req.Amount, hashLock, req.ExpireTime), nil
+}
+
+func (req *claimHTLCReq) New() restutil.RestReq {
+	return new(claimHTLCReq)
+}
+
+func (req *claimHTLCReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+
+func (req *claimHTLCReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	htlcSender, err := sdk.AccAddressFromBech32(mux.Vars(r)["sender"])
+	if err != nil {
+		return nil, err
+	}
+	hashLock, err := getHashLock(r)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := hex.DecodeString(req.Secret)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewMsgClaimHTLC(sender, htlcSender, hashLock, secret), nil
+}
+
+func (req *refundHTLCReq) New() restutil.RestReq {
+	return new(refundHTLCReq)
+}
+
+func (req *refundHTLCReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+
+func (req *refundHTLCReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	htlcSender, err := sdk.AccAddressFromBech32(mux.Vars(r)["sender"])
+	if err != nil {
+		return nil, err
+	}
+	hashLock, err := getHashLock(r)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewMsgRefundHTLC(sender, htlcSender, hashLock), nil
+}
+
+func (req *createStreamReq) New() restutil.RestReq {
+	return new(createStreamReq)
+}
+
+func (req *createStreamReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+
+func (req *createStreamReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	return types.NewMsgCreateStream(sender, getAddr(r), req.Amount, req.StartTime, req.EndTime), nil
+}
+
+func (req *withdrawStreamReq) New() restutil.RestReq {
+	return new(withdrawStreamReq)
+}
+
+func (req *withdrawStreamReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+
+func (req *withdrawStreamReq) GetMsg(r *http.Request, recipient sdk.AccAddress) (sdk.Msg, error) {
+	id, err := getStreamID(r)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewMsgWithdrawStream(recipient, id), nil
+}
+
+func (req *cancelStreamReq) New() restutil.RestReq {
+	return new(cancelStreamReq)
+}
+
+func (req *cancelStreamReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+
+func (req *cancelStreamReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	id, err := getStreamID(r)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewMsgCancelStream(sender, id), nil
+}
+
+func getStreamID(r *http.Request) (uint64, error) {
+	return strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+}
+
+func getHashLock(r *http.Request) ([]byte, error) {
+	return hex.DecodeString(mux.Vars(r)["hash_lock"])
+}
+
 func getAddr(r *http.Request) sdk.AccAddress {
 	vars := mux.Vars(r)
 	addr, err := sdk.AccAddressFromBech32(vars["address"])
@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"encoding/hex"
 	"net/http"
 	"testing"
 
@@ -9,6 +10,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/coinexchain/cet-sdk/modules/authx"
 	"github.com/coinexchain/cet-sdk/modules/bankx/internal/types"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
@@ -39,3 +41,98 @@ func TestCmd(t *testing.T) {
 		Required: true,
 	}, msg)
 }
+
+func TestHTLCReqs(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32("coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a")
+	secret := []byte("secret")
+	hashLock := authx.GetHashLock(secret)
+	req := &http.Request{Method: "POST", URL: nil}
+	req = mux.SetURLVars(req, map[string]string{
+		"address":   "coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a",
+		"sender":    "coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a",
+		"hash_lock": hex.EncodeToString(hashLock),
+	})
+
+	createReq := createHTLCReq{
+		Amount:     dex.NewCetCoins(100000000),
+		HashLock:   hex.EncodeToString(hashLock),
+		ExpireTime: 4000000000,
+	}
+	msg, err := createReq.GetMsg(req, addr)
+	assert.NoError(t, err)
+	assert.Equal(t, types.NewMsgCreateHTLC(addr, addr, dex.NewCetCoins(100000000), hashLock, 4000000000), msg)
+
+	claimReq := claimHTLCReq{Secret: hex.EncodeToString(secret)}
+	msg, err = claimReq.GetMsg(req, addr)
+	assert.NoError(t, err)
+	assert.Equal(t, types.NewMsgClaimHTLC(addr, addr, hashLock, secret), msg)
+
+	refundReq := refundHTLCReq{}
+	msg, err = refundReq.GetMsg(req, addr)
+	assert.NoError(t, err)
+	assert.Equal(t, types.NewMsgRefundHTLC(addr, addr, hashLock), msg)
+}
+
+func TestStreamReqs(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32("coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a")
+	req := &http.Request{Method: "POST", URL: nil}
+	req = mux.SetURLVars(req, map[string]string{
+		"address": "coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a",
+		"id":      "7",
+	})
+
+	createReq := createStreamReq{
+		Amount:    dex.NewCetCoin(100000000),
+		StartTime: 4000000000,
+		EndTime:   4000086400,
+	}
+	msg, err := createReq.GetMsg(req, addr)
+	assert.NoError(t, err)
+	assert.Equal(t, types.NewMsgCreateStream(addr, addr, dex.NewCetCoin(100000000), 4000000000, 4000086400), msg)
+
+	withdrawReq := withdrawStreamReq{}
+	msg, err = withdrawReq.GetMsg(req, addr)
+	assert.NoError(t, err)
+	assert.Equal(t, types.NewMsgWithdrawStream(addr, 7), msg)
+
+	cancelReq := cancelStreamReq{}
+	msg, err = cancelReq.GetMsg(req, addr)
+	assert.NoError(t, err)
+	assert.Equal(t, types.NewMsgCancelStream(addr, 7), msg)
+
+	req = mux.SetURLVars(req, map[string]string{"id": "x"})
+	_, err = cancelReq.GetMsg(req, addr)
+	assert.Error(t, err)
+}
+
+func TestVestingSendReq(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32("coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a")
+	req := &http.Request{Method: "POST", URL: nil}
+	req = mux.SetURLVars(req, map[string]string{"address": "coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a"})
+
+	vestingReq := vestingSendReq{
+		Amount:    dex.NewCetCoins(100000000),
+		StartTime: 4000000000,
+		CliffTime: 4000100000,
+		EndTime:   4000400000,
+		Period:    100000,
+	}
+	msg, err := vestingReq.GetMsg(req, addr)
+	assert.NoError(t, err)
+	schedule := authx.NewVestingSchedule(4000000000, 4000100000, 4000400000, 100000)
+	assert.Equal(t, types.NewMsgVestingSend(addr, addr, dex.NewCetCoins(100000000), schedule), msg)
+}
+
+func TestLockedMultiSendReq(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32("coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a")
+	req := &http.Request{Method: "POST", URL: nil}
+
+	outputs := []types.LockedOutput{
+		types.NewLockedOutput(addr, dex.NewCetCoins(100000000), 0, nil, 0),
+		types.NewLockedOutput(addr, dex.NewCetCoins(100000000), 4000000000, addr, 100),
+	}
+	multiReq := lockedMultiSendReq{Outputs: outputs}
+	msg, err := multiReq.GetMsg(req, addr)
+	assert.NoError(t, err)
+	assert.Equal(t, types.NewMsgLockedMultiSend(addr, outputs), msg)
+}
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 
+	"github.com/coinexchain/cet-sdk/modules/authx"
 	"github.com/coinexchain/cet-sdk/modules/bankx/internal/types"
 	"github.com/coinexchain/cet-sdk/msgqueue"
 	dex "github.com/coinexchain/cet-sdk/types"
@@ -24,6 +25,22 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgMultiSend(ctx, k, msg)
 		case types.MsgSupervisedSend:
 			return handleMsgSupervisedSend(ctx, k, msg)
+		case types.MsgCreateHTLC:
+			return handleMsgCreateHTLC(ctx, k, msg)
+		case types.MsgClaimHTLC:
+			return handleMsgClaimHTLC(ctx, k, msg)
+		case types.MsgRefundHTLC:
+			return handleMsgRefundHTLC(ctx, k, msg)
+		case types.MsgLockedMultiSend:
+			return handleMsgLockedMultiSend(ctx, k, msg)
+		case types.MsgVestingSend:
+			return handleMsgVestingSend(ctx, k, msg)
+		case types.MsgCreateStream:
+			return handleMsgCreateStream(ctx, k, msg)
+		case types.MsgWithdrawStream:
+			return handleMsgWithdrawStream(ctx, k, msg)
+		case types.MsgCancelStream:
+			return handleMsgCancelStream(ctx, k, msg)
 		default:
 			return dex.ErrUnknownRequest(ModuleName, msg)
 		}
@@ -50,6 +67,29 @@ func handleMsgMultiSend(ctx sdk.Context, k Keeper, msg types.MsgMultiSend) sdk.R
 		}
 	}
 
+	// only the whitelisted addresses could send or receive permissioned tokens
+	for _, input := range msg.Inputs {
+		if denom, ok := k.IsPermittedByIssuer(ctx, input.Coins, input.Address); !ok {
+			return types.ErrSenderNotPermitted(denom, input.Address).Result()
+		}
+	}
+	for _, out := range msg.Outputs {
+		if denom, ok := k.IsPermittedByIssuer(ctx, out.Coins, out.Address); !ok {
+			return types.ErrReceiverNotPermitted(denom, out.Address).Result()
+		}
+	}
+
+	// an address may appear in several inputs, so its coins are summed up before checking
+	inputCoins := make(map[string]sdk.Coins)
+	for _, input := range msg.Inputs {
+		inputCoins[input.Address.String()] = inputCoins[input.Address.String()].Add(input.Coins)
+	}
+	for _, input := range msg.Inputs {
+		if k.IsSendFrozenByIssuer(ctx, inputCoins[input.Address.String()], input.Address) {
+			return types.ErrTokenFrozenByOwner().Result()
+		}
+	}
+
 	addrs := k.PreCheckFreshAccounts(ctx, msg.Outputs)
 
 	if err := k.InputOutputCoins(ctx, msg.Inputs, msg.Outputs); err != nil {
@@ -74,31 +114,125 @@ func handleMsgMultiSend(ctx sdk.Context, k Keeper, msg types.MsgMultiSend) sdk.R
 
 }
 
-func handleMsgSend(ctx sdk.Context, k Keeper, msg types.MsgSend) sdk.Result {
+func handleMsgLockedMultiSend(ctx sdk.Context, k Keeper, msg types.MsgLockedMultiSend) sdk.Result {
 	if enabled := k.GetSendEnabled(ctx); !enabled {
 		return bank.ErrSendDisabled(types.CodeSpaceBankx).Result()
 	}
 
-	if k.BlacklistedAddr(msg.ToAddress) {
-		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", msg.ToAddress)).Result()
+	currentTime := ctx.BlockHeader().Time.Unix()
+	for _, out := range msg.Outputs {
+		if err := checkReceive(ctx, k, out.Address, out.Coins); err != nil {
+			return err.Result()
+		}
+		if out.IsLocked() && out.UnlockTime < currentTime {
+			return types.ErrUnlockTime("Invalid Unlock Time:" + fmt.Sprintf("%d < %d", out.UnlockTime, currentTime)).Result()
+		}
+		if out.IsSupervised() {
+			if k.GetAccount(ctx, out.Supervisor) == nil {
+				return sdk.ErrUnknownAddress(fmt.Sprintf("account %s does not exist", out.Supervisor)).Result()
+			}
+			// the supervisor may receive a part of the amount as its reward
+			if err := checkReceive(ctx, k, out.Supervisor, out.Coins); err != nil {
+				return err.Result()
+			}
+		}
+	}
+	if err := checkSendFrom(ctx, k, msg.FromAddress, msg.GetTotalCoins()); err != nil {
+		return err.Result()
 	}
 
-	if k.IsSendForbidden(ctx, msg.Amount, msg.FromAddress) {
-		if denom, exist := k.IsTokensExist(ctx, msg.Amount); !exist {
-			return types.ErrInvalidTokenSymbol(denom).Result()
+	outputs := make([]bank.Output, len(msg.Outputs))
+	for i, out := range msg.Outputs {
+		outputs[i] = bank.NewOutput(out.Address, out.Coins)
+	}
+	addrs := k.PreCheckFreshAccounts(ctx, outputs)
+	feePayers := getActivationFeePayers(ctx, k, msg.Outputs, addrs)
+
+	for i, out := range msg.Outputs {
+		amt := out.Coins
+		if !out.IsLocked() {
+			if err := k.SendCoins(ctx, msg.FromAddress, out.Address, amt); err != nil {
+				return err.Result()
+			}
+			continue
+		}
+
+		// a fresh account only receiving locked coins pays its activation fee out of them
+		if feePayers[i] {
+			activationFee := dex.NewCetCoins(k.GetParams(ctx).ActivationFee)
+			if err := k.SendCoins(ctx, msg.FromAddress, out.Address, activationFee); err != nil {
+				return err.Result()
+			}
+			amt = amt.Sub(activationFee)
+			if amt.Empty() {
+				continue
+			}
+			if out.IsSupervised() && sdk.NewInt(out.Reward).GT(amt[0].Amount) {
+				return types.ErrRewardExceedsAmount().Result()
+			}
+		}
+
+		if err := k.SendLockedCoins(ctx, msg.FromAddress, out.Address, out.Supervisor, amt, out.UnlockTime,
+			out.Reward, out.IsSupervised()); err != nil {
+			return err.Result()
+		}
+		if out.IsSupervised() {
+			fillMsgQueue(ctx, k, "send_lock_coins", types.NewSupervisedSendMsg(msg.FromAddress, out.Address,
+				out.Supervisor, amt[0], out.UnlockTime, out.Reward))
+		} else {
+			fillMsgQueue(ctx, k, "send_lock_coins", types.NewLockedSendMsg(msg.FromAddress, out.Address, amt, out.UnlockTime))
 		}
-		return types.ErrTokenForbiddenByOwner().Result()
 	}
 
-	//TODO: add codes to check whether fromAccount & toAccount is moduleAccount
+	if err := k.DeductActivationFeeForFreshAccounts(ctx, addrs); err != nil {
+		return err.Result()
+	}
 
-	amt := msg.Amount
-	if !k.HasCoins(ctx, msg.FromAddress, amt) {
-		return sdk.ErrInsufficientCoins("sender has insufficient coins for the transfer").Result()
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// getActivationFeePayers marks, for each fresh account whose unlocked outputs can not cover its activation fee,
+// the first locked output carrying enough CET to pay it
+func getActivationFeePayers(ctx sdk.Context, k Keeper, outputs []types.LockedOutput, addrs []sdk.AccAddress) map[int]bool {
+	activationFee := dex.NewCetCoins(k.GetParams(ctx).ActivationFee)
+	payers := make(map[int]bool)
+	for _, addr := range addrs {
+		unlocked := sdk.Coins{}
+		for _, out := range outputs {
+			if out.Address.Equals(addr) && !out.IsLocked() {
+				unlocked = unlocked.Add(out.Coins)
+			}
+		}
+		if unlocked.IsAllGTE(activationFee) {
+			continue
+		}
+		for i, out := range outputs {
+			if out.Address.Equals(addr) && out.IsLocked() && out.Coins.IsAllGTE(activationFee) {
+				payers[i] = true
+				break
+			}
+		}
 	}
+	return payers
+}
+
+func handleMsgSend(ctx sdk.Context, k Keeper, msg types.MsgSend) sdk.Result {
+	if err := checkSend(ctx, k, msg.FromAddress, msg.ToAddress, msg.Amount); err != nil {
+		return err.Result()
+	}
+
+	//TODO: add codes to check whether fromAccount & toAccount is moduleAccount
 
 	//check whether toAccount exist
-	amt, err := k.DeductActivationFee(ctx, msg.FromAddress, msg.ToAddress, amt)
+	amt, err := k.DeductActivationFee(ctx, msg.FromAddress, msg.ToAddress, msg.Amount)
 	if err != nil {
 		return err.Result()
 	}
@@ -116,6 +250,52 @@ func handleMsgSend(ctx sdk.Context, k Keeper, msg types.MsgSend) sdk.Result {
 	return normalSend(ctx, k, msg.FromAddress, msg.ToAddress, amt)
 }
 
+// checkSend runs the checks shared by all the transfers of amt from one address to another: sending must be
+// enabled, the receiver must not be blacklisted, the tokens must exist and not be forbidden for the sender,
+// both addresses must be permitted to hold the permissioned tokens, and the sender must have enough coins
+// which are not frozen by the issuers
+func checkSend(ctx sdk.Context, k Keeper, from, to sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if enabled := k.GetSendEnabled(ctx); !enabled {
+		return bank.ErrSendDisabled(types.CodeSpaceBankx)
+	}
+	if err := checkReceive(ctx, k, to, amt); err != nil {
+		return err
+	}
+	return checkSendFrom(ctx, k, from, amt)
+}
+
+// checkReceive checks that to may receive amt
+func checkReceive(ctx sdk.Context, k Keeper, to sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if k.BlacklistedAddr(to) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", to))
+	}
+	// only the whitelisted addresses could send or receive permissioned tokens
+	if denom, ok := k.IsPermittedByIssuer(ctx, amt, to); !ok {
+		return types.ErrReceiverNotPermitted(denom, to)
+	}
+	return nil
+}
+
+// checkSendFrom checks that from may send amt, which may be paid to several receivers
+func checkSendFrom(ctx sdk.Context, k Keeper, from sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if denom, exist := k.IsTokensExist(ctx, amt); !exist {
+		return types.ErrInvalidTokenSymbol(denom)
+	}
+	if k.IsSendForbidden(ctx, amt, from) {
+		return types.ErrTokenForbiddenByOwner()
+	}
+	if denom, ok := k.IsPermittedByIssuer(ctx, amt, from); !ok {
+		return types.ErrSenderNotPermitted(denom, from)
+	}
+	if k.IsSendFrozenByIssuer(ctx, amt, from) {
+		return types.ErrTokenFrozenByOwner()
+	}
+	if !k.HasCoins(ctx, from, amt) {
+		return sdk.ErrInsufficientCoins("sender has insufficient coins for the transfer")
+	}
+	return nil
+}
+
 func lockedSend(ctx sdk.Context, k Keeper, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins, unlockTime int64) sdk.Result {
 	if unlockTime < ctx.BlockHeader().Time.Unix() {
 		return types.ErrUnlockTime("Invalid Unlock Time:" +
@@ -145,6 +325,56 @@ func lockedSend(ctx sdk.Context, k Keeper, fromAddr, toAddr sdk.AccAddress, amt
 	}
 }
 
+func handleMsgVestingSend(ctx sdk.Context, k Keeper, msg types.MsgVestingSend) sdk.Result {
+	if err := checkSend(ctx, k, msg.FromAddress, msg.ToAddress, msg.Amount); err != nil {
+		return err.Result()
+	}
+	if msg.Schedule.EndTime < ctx.BlockHeader().Time.Unix() {
+		return types.ErrUnlockTime("Invalid End Time:" +
+			fmt.Sprintf("%d < %d", msg.Schedule.EndTime, ctx.BlockHeader().Time.Unix())).Result()
+	}
+
+	amt, err := k.DeductActivationFee(ctx, msg.FromAddress, msg.ToAddress, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	if err := k.SendVestingCoins(ctx, msg.FromAddress, msg.ToAddress, amt, msg.Schedule); err != nil {
+		return err.Result()
+	}
+
+	// each tranche is pushed as a locked send, so that the consumers need not know the schedule
+	tranches := msg.Schedule.GetTranches(amt)
+	for _, unlockTime := range msg.Schedule.GetUnlockTimes() {
+		coins := sdk.Coins{}
+		for _, tranche := range tranches {
+			if tranche.UnlockTime == unlockTime {
+				coins = coins.Add(sdk.Coins{tranche.Coin})
+			}
+		}
+		if !coins.Empty() {
+			fillMsgQueue(ctx, k, "send_lock_coins", types.NewLockedSendMsg(msg.FromAddress, msg.ToAddress, coins, unlockTime))
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(types.AttributeKeySender, msg.FromAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.ToAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amt.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
 func normalSend(ctx sdk.Context, k Keeper, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Result {
 	err := k.SendCoins(ctx, fromAddr, toAddr, amt)
 	if err != nil {
@@ -225,6 +455,21 @@ func handleMsgSupervisedSend(ctx sdk.Context, k Keeper, msg types.MsgSupervisedS
 		if !k.HasCoins(ctx, msg.FromAddress, amt) {
 			return sdk.ErrInsufficientCoins("sender has insufficient coin for the transfer").Result()
 		}
+		if denom, ok := k.IsPermittedByIssuer(ctx, amt, msg.FromAddress); !ok {
+			return types.ErrSenderNotPermitted(denom, msg.FromAddress).Result()
+		}
+		if denom, ok := k.IsPermittedByIssuer(ctx, amt, msg.ToAddress); !ok {
+			return types.ErrReceiverNotPermitted(denom, msg.ToAddress).Result()
+		}
+		// the supervisor may receive a part of the amount as its reward
+		if !msg.Supervisor.Empty() {
+			if denom, ok := k.IsPermittedByIssuer(ctx, amt, msg.Supervisor); !ok {
+				return types.ErrReceiverNotPermitted(denom, msg.Supervisor).Result()
+			}
+		}
+		if k.IsSendFrozenByIssuer(ctx, amt, msg.FromAddress) {
+			return types.ErrTokenFrozenByOwner().Result()
+		}
 		if err := k.SendLockedCoins(ctx, msg.FromAddress, msg.ToAddress, msg.Supervisor, amt, msg.UnlockTime, msg.Reward, true); err != nil {
 			return err.Result()
 		}
@@ -263,6 +508,180 @@ func handleMsgSupervisedSend(ctx sdk.Context, k Keeper, msg types.MsgSupervisedS
 	}
 }
 
+func handleMsgCreateHTLC(ctx sdk.Context, k Keeper, msg types.MsgCreateHTLC) sdk.Result {
+	if err := checkSend(ctx, k, msg.Sender, msg.Recipient, msg.Amount); err != nil {
+		return err.Result()
+	}
+	if msg.ExpireTime <= ctx.BlockHeader().Time.Unix() {
+		return types.ErrUnlockTime("Invalid Expire Time:" +
+			fmt.Sprintf("%d <= %d", msg.ExpireTime, ctx.BlockHeader().Time.Unix())).Result()
+	}
+
+	htlc := authx.NewHTLC(msg.HashLock, msg.Sender, msg.Recipient, msg.Amount, msg.ExpireTime)
+	if err := k.CreateHTLC(ctx, htlc); err != nil {
+		return err.Result()
+	}
+
+	fillMsgQueue(ctx, k, "htlc_created", types.NewHTLCInfo(htlc, nil, ctx.BlockHeight()))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeCreateHTLC,
+			sdk.NewAttribute(types.AttributeKeyHashLock, htlc.HashLock.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgClaimHTLC(ctx sdk.Context, k Keeper, msg types.MsgClaimHTLC) sdk.Result {
+	htlc, err := k.ClaimHTLC(ctx, msg.HTLCSender, msg.HashLock, msg.Secret)
+	if err != nil {
+		return err.Result()
+	}
+
+	fillMsgQueue(ctx, k, "htlc_claimed", types.NewHTLCInfo(htlc, msg.Secret, ctx.BlockHeight()))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeClaimHTLC,
+			sdk.NewAttribute(types.AttributeKeyHashLock, htlc.HashLock.String()),
+			sdk.NewAttribute(types.AttributeKeySecret, msg.Secret.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, htlc.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, htlc.Amount.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgRefundHTLC(ctx sdk.Context, k Keeper, msg types.MsgRefundHTLC) sdk.Result {
+	htlc, err := k.RefundHTLC(ctx, msg.HTLCSender, msg.HashLock)
+	if err != nil {
+		return err.Result()
+	}
+
+	fillMsgQueue(ctx, k, "htlc_refunded", types.NewHTLCInfo(htlc, nil, ctx.BlockHeight()))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeRefundHTLC,
+			sdk.NewAttribute(types.AttributeKeyHashLock, htlc.HashLock.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, htlc.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, htlc.Amount.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgCreateStream(ctx sdk.Context, k Keeper, msg types.MsgCreateStream) sdk.Result {
+	if err := checkSend(ctx, k, msg.Sender, msg.Recipient, sdk.NewCoins(msg.Amount)); err != nil {
+		return err.Result()
+	}
+	if msg.StartTime < ctx.BlockHeader().Time.Unix() {
+		return types.ErrUnlockTime("Invalid Start Time:" +
+			fmt.Sprintf("%d < %d", msg.StartTime, ctx.BlockHeader().Time.Unix())).Result()
+	}
+
+	stream, err := k.CreateStream(ctx, msg.Sender, msg.Recipient, msg.Amount, msg.StartTime, msg.EndTime)
+	if err != nil {
+		return err.Result()
+	}
+
+	fillMsgQueue(ctx, k, "stream_created", types.NewStreamNotification(stream, sdk.ZeroInt(), sdk.ZeroInt(), ctx.BlockHeight()))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeCreateStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.ID)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgWithdrawStream(ctx sdk.Context, k Keeper, msg types.MsgWithdrawStream) sdk.Result {
+	stream, paid, err := k.WithdrawStream(ctx, msg.StreamID, msg.Recipient)
+	if err != nil {
+		return err.Result()
+	}
+
+	fillMsgQueue(ctx, k, "stream_withdrawn", types.NewStreamNotification(stream, paid, sdk.ZeroInt(), ctx.BlockHeight()))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Recipient.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeWithdrawStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.ID)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, sdk.NewCoin(stream.Amount.Denom, paid).String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgCancelStream(ctx sdk.Context, k Keeper, msg types.MsgCancelStream) sdk.Result {
+	stream, paid, refunded, err := k.CancelStream(ctx, msg.StreamID, msg.Sender)
+	if err != nil {
+		return err.Result()
+	}
+
+	fillMsgQueue(ctx, k, "stream_canceled", types.NewStreamNotification(stream, paid, refunded, ctx.BlockHeight()))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeCancelStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.ID)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, sdk.NewCoin(stream.Amount.Denom, paid).String()),
+			sdk.NewAttribute(types.AttributeKeyRefunded, sdk.NewCoin(stream.Amount.Denom, refunded).String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
 func fillMsgQueue(ctx sdk.Context, keeper Keeper, key string, msg interface{}) {
 	if keeper.MsgProducer.IsSubscribed(types.Topic) {
 		msgqueue.FillMsgs(ctx, key, msg)
@@ -17,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/authx"
 	"github.com/coinexchain/cet-sdk/modules/bankx"
 	"github.com/coinexchain/cet-sdk/modules/bankx/internal/keeper"
 	bx "github.com/coinexchain/cet-sdk/modules/bankx/internal/types"
@@ -33,6 +34,7 @@ var (
 	feeAddr       = sdk.AccAddress(crypto.AddressHash([]byte(auth.FeeCollectorName)))
 	owner         = testutil.ToAccAddress("owner")
 	forbiddenAddr = testutil.ToAccAddress("forbidden")
+	frozenAddr    = testutil.ToAccAddress("frozen")
 )
 
 func defaultContext() (*keeper.Keeper, sdk.Handler, sdk.Context) {
@@ -46,6 +48,7 @@ func defaultContext() (*keeper.Keeper, sdk.Handler, sdk.Context) {
 		"", "", asset.TestIdentityString)
 	_ = app.AssetKeeper.SetToken(ctx, cet)
 	_ = app.AssetKeeper.ForbidAddress(ctx, "cet", owner, []sdk.AccAddress{forbiddenAddr})
+	_, _ = app.AssetKeeper.FreezeTokenAmount(ctx, "cet", owner, frozenAddr, sdk.NewInt(300000000))
 	return &app.BankxKeeper, handler, ctx
 }
 
@@ -124,6 +127,90 @@ func TestHandlerMsgSendFail(t *testing.T) {
 
 }
 
+func TestHandlerMsgSendFrozenByIssuer(t *testing.T) {
+	bkx, handle, ctx := defaultContext()
+	err := bkx.AddCoins(ctx, frozenAddr, dex.NewCetCoins(500000000))
+	require.NoError(t, err)
+	err = bkx.AddCoins(ctx, toAddr, dex.NewCetCoins(100000000))
+	require.NoError(t, err)
+
+	msgSend := bankx.MsgSend{FromAddress: frozenAddr, ToAddress: toAddr, Amount: dex.NewCetCoins(300000000), UnlockTime: 0}
+	res := handle(ctx, msgSend)
+	require.Equal(t, bx.CodeTokenFrozenByOwner, res.Code)
+	require.Equal(t, sdk.NewInt(500000000), bkx.GetCoins(ctx, frozenAddr).AmountOf("cet"))
+
+	msgSend.Amount = dex.NewCetCoins(200000000)
+	res = handle(ctx, msgSend)
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(300000000), bkx.GetCoins(ctx, frozenAddr).AmountOf("cet"))
+
+	coins := dex.NewCetCoins(100000000)
+	in := []bank.Input{bank.NewInput(frozenAddr, coins)}
+	out := []bank.Output{bank.NewOutput(toAddr, coins)}
+	res = handle(ctx, bankx.NewMsgMultiSend(in, out))
+	require.Equal(t, bx.CodeTokenFrozenByOwner, res.Code)
+
+	err = bkx.FreezeCoins(ctx, frozenAddr, coins)
+	require.Equal(t, bx.CodeTokenFrozenByOwner, err.Code())
+	require.Equal(t, sdk.NewInt(300000000), bkx.GetCoins(ctx, frozenAddr).AmountOf("cet"))
+}
+
+func TestHandlerMsgSendPermissionedToken(t *testing.T) {
+	app := testapp.NewTestApp()
+	ctx := sdk.NewContext(app.Cms, abci.Header{Time: time.Now()}, false, log.NewNopLogger())
+	app.BankxKeeper.SetParams(ctx, bx.DefaultParams())
+	app.BankxKeeper.SetSendEnabled(ctx, true)
+	bkx, handle := app.BankxKeeper, bankx.NewHandler(app.BankxKeeper)
+	kyc, _ := asset.NewToken("KYC token", "kyc", sdk.NewInt(200000000000000), owner,
+		false, false, false, false,
+		"", "", asset.TestIdentityString)
+	kyc.SetPermissioned(true)
+	_ = app.AssetKeeper.SetToken(ctx, kyc)
+	_ = app.AssetKeeper.AddTokenWhitelist(ctx, "kyc", owner, []sdk.AccAddress{fromAddr})
+
+	err := bkx.AddCoins(ctx, toAddr, dex.NewCetCoins(1e8))
+	require.NoError(t, err)
+	err = bkx.AddCoins(ctx, supervisor, dex.NewCetCoins(1e8))
+	require.NoError(t, err)
+	err = bkx.AddCoins(ctx, fromAddr, sdk.NewCoins(sdk.NewCoin("kyc", sdk.NewInt(1000))))
+	require.NoError(t, err)
+	coins := sdk.NewCoins(sdk.NewCoin("kyc", sdk.NewInt(100)))
+
+	msgSend := bankx.MsgSend{FromAddress: fromAddr, ToAddress: toAddr, Amount: coins, UnlockTime: 0}
+	res := handle(ctx, msgSend)
+	require.Equal(t, bx.CodeReceiverNotPermitted, res.Code)
+
+	in := []bank.Input{bank.NewInput(fromAddr, coins)}
+	out := []bank.Output{bank.NewOutput(toAddr, coins)}
+	res = handle(ctx, bankx.NewMsgMultiSend(in, out))
+	require.Equal(t, bx.CodeReceiverNotPermitted, res.Code)
+
+	lockFreeTime := ctx.BlockHeader().Time.Unix() + bkx.GetParams(ctx).LockCoinsFreeTime/int64(time.Second)
+	msgSupervisedSend := bankx.MsgSupervisedSend{FromAddress: fromAddr, Supervisor: supervisor, ToAddress: toAddr,
+		Amount: sdk.NewCoin("kyc", sdk.NewInt(100)), UnlockTime: lockFreeTime, Reward: 10, Operation: bankx.Create}
+	res = handle(ctx, msgSupervisedSend)
+	require.Equal(t, bx.CodeReceiverNotPermitted, res.Code)
+	require.Equal(t, sdk.NewInt(1000), bkx.GetCoins(ctx, fromAddr).AmountOf("kyc"))
+
+	// the whitelisted receivers are permitted
+	err = app.AssetKeeper.AddTokenWhitelist(ctx, "kyc", owner, []sdk.AccAddress{toAddr})
+	require.NoError(t, err)
+	res = handle(ctx, msgSend)
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(100), bkx.GetCoins(ctx, toAddr).AmountOf("kyc"))
+
+	// the supervisor may receive the reward, so it must be permitted too
+	res = handle(ctx, msgSupervisedSend)
+	require.Equal(t, bx.CodeReceiverNotPermitted, res.Code)
+
+	// the addresses removed from the whitelist can not send their balance
+	err = app.AssetKeeper.RemoveTokenWhitelist(ctx, "kyc", owner, []sdk.AccAddress{fromAddr})
+	require.NoError(t, err)
+	res = handle(ctx, msgSend)
+	require.Equal(t, bx.CodeSenderNotPermitted, res.Code)
+	require.Equal(t, sdk.NewInt(900), bkx.GetCoins(ctx, fromAddr).AmountOf("kyc"))
+}
+
 func TestHandlerMsgSendUnlockFirst(t *testing.T) {
 	bkx, handle, ctx := defaultContext()
 	fee := bkx.GetParams(ctx).LockCoinsFeePerDay
@@ -422,3 +509,166 @@ func TestHandleMsgSupervisedSendException(t *testing.T) {
 		require.Equal(t, tc.code, ret.Code)
 	}
 }
+
+func TestHandleMsgHTLC(t *testing.T) {
+	bkx, handle, ctx := defaultContext()
+	now := ctx.BlockHeader().Time.Unix()
+	err := bkx.AddCoins(ctx, fromAddr, dex.NewCetCoins(10*1e8))
+	require.NoError(t, err)
+
+	secret := []byte("secret")
+	hashLock := authx.GetHashLock(secret)
+	res := handle(ctx, bankx.NewMsgCreateHTLC(fromAddr, toAddr, dex.NewCetCoins(3e8), hashLock, now))
+	require.Equal(t, bx.CodeInvalidUnlockTime, res.Code)
+	res = handle(ctx, bankx.NewMsgCreateHTLC(frozenAddr, toAddr, dex.NewCetCoins(3e8), hashLock, now+100))
+	require.Equal(t, bx.CodeTokenFrozenByOwner, res.Code)
+	res = handle(ctx, bankx.NewMsgCreateHTLC(forbiddenAddr, toAddr, dex.NewCetCoins(3e8), hashLock, now+100))
+	require.Equal(t, bx.CodeTokenForbiddenByOwner, res.Code)
+
+	res = handle(ctx, bankx.NewMsgCreateHTLC(fromAddr, toAddr, dex.NewCetCoins(3e8), hashLock, now+100))
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(7e8), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+	require.Equal(t, sdk.NewInt(3e8), bkx.GetLockedCoins(ctx, fromAddr)[0].Coin.Amount)
+
+	// anyone knowing the secret could claim it for the recipient
+	res = handle(ctx, bankx.NewMsgClaimHTLC(myaddr, fromAddr, hashLock, secret))
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(3e8), bkx.GetCoins(ctx, toAddr).AmountOf("cet"))
+	require.Empty(t, bkx.GetLockedCoins(ctx, fromAddr))
+	res = handle(ctx, bankx.NewMsgClaimHTLC(myaddr, fromAddr, hashLock, secret))
+	require.Equal(t, bx.CodeHTLCNotFound, res.Code)
+
+	hashLock = authx.GetHashLock([]byte("secret2"))
+	res = handle(ctx, bankx.NewMsgCreateHTLC(fromAddr, toAddr, dex.NewCetCoins(3e8), hashLock, now+100))
+	require.True(t, res.IsOK())
+	res = handle(ctx, bankx.NewMsgRefundHTLC(fromAddr, fromAddr, hashLock))
+	require.Equal(t, bx.CodeHTLCNotExpired, res.Code)
+	ctx = ctx.WithBlockTime(time.Unix(now+100, 0))
+	res = handle(ctx, bankx.NewMsgRefundHTLC(myaddr, fromAddr, hashLock))
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(7e8), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+	require.Equal(t, sdk.NewInt(3e8), bkx.GetCoins(ctx, toAddr).AmountOf("cet"))
+}
+
+func TestHandleMsgStream(t *testing.T) {
+	bkx, handle, ctx := defaultContext()
+	now := ctx.BlockHeader().Time.Unix()
+	err := bkx.AddCoins(ctx, fromAddr, dex.NewCetCoins(10*1e8))
+	require.NoError(t, err)
+
+	res := handle(ctx, bankx.NewMsgCreateStream(fromAddr, toAddr, dex.NewCetCoin(3e8), now-1, now+100))
+	require.Equal(t, bx.CodeInvalidUnlockTime, res.Code)
+	res = handle(ctx, bankx.NewMsgCreateStream(frozenAddr, toAddr, dex.NewCetCoin(3e8), now, now+100))
+	require.Equal(t, bx.CodeTokenFrozenByOwner, res.Code)
+	res = handle(ctx, bankx.NewMsgCreateStream(forbiddenAddr, toAddr, dex.NewCetCoin(3e8), now, now+100))
+	require.Equal(t, bx.CodeTokenForbiddenByOwner, res.Code)
+
+	res = handle(ctx, bankx.NewMsgCreateStream(fromAddr, toAddr, dex.NewCetCoin(3e8), now, now+100))
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(7e8), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+	require.Equal(t, sdk.NewInt(3e8), bkx.GetLockedCoins(ctx, fromAddr)[0].Coin.Amount)
+
+	ctx = ctx.WithBlockTime(time.Unix(now+40, 0))
+	res = handle(ctx, bankx.NewMsgWithdrawStream(fromAddr, 1))
+	require.Equal(t, sdk.CodeUnauthorized, res.Code)
+	res = handle(ctx, bankx.NewMsgWithdrawStream(toAddr, 1))
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(1.2e8), bkx.GetCoins(ctx, toAddr).AmountOf("cet"))
+	res = handle(ctx, bankx.NewMsgWithdrawStream(toAddr, 1))
+	require.Equal(t, bx.CodeNoWithdrawableAmount, res.Code)
+
+	ctx = ctx.WithBlockTime(time.Unix(now+50, 0))
+	res = handle(ctx, bankx.NewMsgCancelStream(fromAddr, 1))
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(1.5e8), bkx.GetCoins(ctx, toAddr).AmountOf("cet"))
+	require.Equal(t, sdk.NewInt(8.5e8), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+	require.Empty(t, bkx.GetLockedCoins(ctx, fromAddr))
+	res = handle(ctx, bankx.NewMsgCancelStream(fromAddr, 1))
+	require.Equal(t, bx.CodeStreamNotFound, res.Code)
+
+	// the lock fee is charged for the days the stream lasts beyond the free time
+	lockFreeTime := ctx.BlockHeader().Time.Unix() + bkx.GetParams(ctx).LockCoinsFreeTime/int64(time.Second)
+	fee := bkx.GetParams(ctx).LockCoinsFeePerDay
+	start := ctx.BlockHeader().Time.Unix()
+	res = handle(ctx, bankx.NewMsgCreateStream(fromAddr, toAddr, dex.NewCetCoin(1e8), start, lockFreeTime+1))
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(7.5e8-fee), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+}
+
+func TestHandleMsgVestingSend(t *testing.T) {
+	bkx, handle, ctx := defaultContext()
+	now := ctx.BlockHeader().Time.Unix()
+	err := bkx.AddCoins(ctx, fromAddr, dex.NewCetCoins(10*1e8))
+	require.NoError(t, err)
+
+	schedule := authx.NewVestingSchedule(now-300, now-100, now+100, 100)
+	res := handle(ctx, bankx.NewMsgVestingSend(frozenAddr, toAddr, dex.NewCetCoins(4e8), schedule))
+	require.Equal(t, bx.CodeTokenFrozenByOwner, res.Code)
+	res = handle(ctx, bankx.NewMsgVestingSend(fromAddr, toAddr, dex.NewCetCoins(4e8),
+		authx.NewVestingSchedule(now-300, now-200, now-100, 100)))
+	require.Equal(t, bx.CodeInvalidUnlockTime, res.Code)
+
+	// the activation fee of the recipient is deducted before the amount is split into tranches
+	res = handle(ctx, bankx.NewMsgVestingSend(fromAddr, toAddr, dex.NewCetCoins(5e8), schedule))
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(5e8), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+	lockedCoins := bkx.GetLockedCoins(ctx, toAddr)
+	require.Equal(t, 3, len(lockedCoins))
+	require.Equal(t, now-100, lockedCoins[0].UnlockTime)
+	require.Equal(t, sdk.NewInt(2e8), lockedCoins[0].Coin.Amount)
+	require.Equal(t, sdk.NewInt(1e8), lockedCoins[1].Coin.Amount)
+	require.Equal(t, sdk.NewInt(1e8), lockedCoins[2].Coin.Amount)
+}
+
+func TestHandleMsgLockedMultiSend(t *testing.T) {
+	bkx, handle, ctx := defaultContext()
+	now := ctx.BlockHeader().Time.Unix()
+	lockFreeTime := now + bkx.GetParams(ctx).LockCoinsFreeTime/int64(time.Second)
+	fee := bkx.GetParams(ctx).LockCoinsFeePerDay
+	require.NoError(t, bkx.AddCoins(ctx, fromAddr, dex.NewCetCoins(20e8+2*fee)))
+	require.NoError(t, bkx.AddCoins(ctx, supervisor, dex.NewCetCoins(1e8)))
+	addr3 := testutil.ToAccAddress("addr3")
+	addr4 := testutil.ToAccAddress("addr4")
+
+	res := handle(ctx, bankx.NewMsgLockedMultiSend(fromAddr, []bankx.LockedOutput{
+		bankx.NewLockedOutput(toAddr, dex.NewCetCoins(2e8), 0, nil, 0),
+		bankx.NewLockedOutput(addr3, dex.NewCetCoins(2e8), now-1, nil, 0),
+	}))
+	require.Equal(t, bx.CodeInvalidUnlockTime, res.Code)
+	res = handle(ctx, bankx.NewMsgLockedMultiSend(fromAddr, []bankx.LockedOutput{
+		bankx.NewLockedOutput(addr3, dex.NewCetCoins(2e8), now+100, myaddr, 0),
+	}))
+	require.Equal(t, sdk.CodeUnknownAddress, res.Code)
+
+	res = handle(ctx, bankx.NewMsgLockedMultiSend(fromAddr, []bankx.LockedOutput{
+		bankx.NewLockedOutput(toAddr, dex.NewCetCoins(2e8), 0, nil, 0),
+		bankx.NewLockedOutput(toAddr, dex.NewCetCoins(3e8), lockFreeTime+1, nil, 0),
+		bankx.NewLockedOutput(addr3, dex.NewCetCoins(4e8), lockFreeTime+1, supervisor, 1e8),
+		bankx.NewLockedOutput(addr4, dex.NewCetCoins(5e8), now+100, nil, 0),
+	}))
+	require.True(t, res.IsOK())
+
+	// the lock fee is charged for each locked output beyond the free time
+	require.Equal(t, sdk.NewInt(6e8), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+	require.Equal(t, sdk.NewInt(3e8+2*fee), bkx.GetCoins(ctx, feeAddr).AmountOf("cet"))
+
+	// toAddr pays its activation fee out of the unlocked output
+	require.Equal(t, sdk.NewInt(1e8), bkx.GetCoins(ctx, toAddr).AmountOf("cet"))
+	require.Equal(t, 1, len(bkx.GetLockedCoins(ctx, toAddr)))
+	require.Equal(t, sdk.NewInt(3e8), bkx.GetLockedCoins(ctx, toAddr)[0].Coin.Amount)
+
+	// addr3 and addr4 pay their activation fees out of the locked outputs
+	require.True(t, bkx.GetCoins(ctx, addr3).IsZero())
+	lockedCoins := bkx.GetLockedCoins(ctx, addr3)
+	require.Equal(t, 1, len(lockedCoins))
+	require.Equal(t, authx.NewSupervisedLockedCoin("cet", sdk.NewInt(3e8), lockFreeTime+1, fromAddr, supervisor, 1e8),
+		lockedCoins[0])
+	require.True(t, bkx.GetCoins(ctx, addr4).IsZero())
+	require.Equal(t, sdk.NewInt(4e8), bkx.GetLockedCoins(ctx, addr4)[0].Coin.Amount)
+
+	// the locked output is too small to pay the activation fee
+	res = handle(ctx, bankx.NewMsgLockedMultiSend(fromAddr, []bankx.LockedOutput{
+		bankx.NewLockedOutput(testutil.ToAccAddress("addr5"), dex.NewCetCoins(5e7), now+100, nil, 0),
+	}))
+	require.False(t, res.IsOK())
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 	"math"
 	"time"
@@ -24,6 +25,7 @@ type Keeper struct {
 	ak            auth.AccountKeeper
 	tk            types.ExpectedAssetStatusKeeper
 	sk            types.SupplyKeeper
+	hooks         types.BankxHooks
 	MsgProducer   msgqueue.MsgSender
 }
 
@@ -42,6 +44,26 @@ func NewKeeper(paramSubspace params.Subspace, axk authx.AccountXKeeper,
 	}
 }
 
+// SetHooks must be called before the keeper is passed to the other keepers, because they hold copies of it
+func (k *Keeper) SetHooks(hooks types.BankxHooks) {
+	if k.hooks != nil {
+		panic("cannot set bankx hooks twice")
+	}
+	k.hooks = hooks
+}
+
+// afterBalanceChanged notifies the hooks that the amounts of the denoms in amt held by addr may have been changed
+func (k Keeper) afterBalanceChanged(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) {
+	if k.hooks == nil || amt.Empty() {
+		return
+	}
+	denoms := make([]string, len(amt))
+	for i, coin := range amt {
+		denoms[i] = coin.Denom
+	}
+	k.hooks.AfterBalanceChanged(ctx, addr, k.GetTotalCoins(ctx, addr), denoms)
+}
+
 func (k Keeper) GetParams(ctx sdk.Context) (param types.Params) {
 	k.paramSubspace.GetParamSet(ctx, &param)
 	return
@@ -59,12 +81,20 @@ func (k Keeper) HasCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) bo
 	return acc.SpendableCoins(ctx.BlockTime()).IsAllGTE(amt)
 }
 
+// SendCoins moves amt from one account to another, the amounts frozen by the token owners can not be moved
 func (k Keeper) SendCoins(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) sdk.Error {
 	if k.IsSendForbidden(ctx, amt, from) {
 		return types.ErrTokenForbiddenByOwner()
 	}
-	ret := k.bk.SendCoins(ctx, from, to, amt)
-	return ret
+	if k.IsSendFrozenByIssuer(ctx, amt, from) {
+		return types.ErrTokenFrozenByOwner()
+	}
+	if err := k.bk.SendCoins(ctx, from, to, amt); err != nil {
+		return err
+	}
+	k.afterBalanceChanged(ctx, from, amt)
+	k.afterBalanceChanged(ctx, to, amt)
+	return nil
 }
 
 func (k Keeper) SendLockedCoins(ctx sdk.Context, fromAddr, toAddr, supervisor sdk.AccAddress, amt sdk.Coins,
@@ -78,16 +108,8 @@ func (k Keeper) SendLockedCoins(ctx sdk.Context, fromAddr, toAddr, supervisor sd
 		}
 	}
 
-	lockDuration := (unlockTime - ctx.BlockHeader().Time.Unix()) * int64(time.Second)
-	if lockDuration > k.GetParams(ctx).LockCoinsFreeTime && k.GetParams(ctx).LockCoinsFeePerDay > 0 {
-		exceededDays := (lockDuration-k.GetParams(ctx).LockCoinsFreeTime-1)/(24*int64(time.Hour)) + 1
-		if exceededDays > math.MaxInt64/k.GetParams(ctx).LockCoinsFeePerDay {
-			return types.ErrUnlockTime("Unlock time is too large")
-		}
-		lockCoinsFee := dex.NewCetCoins(k.GetParams(ctx).LockCoinsFeePerDay * exceededDays)
-		if err := k.DeductFee(ctx, fromAddr, lockCoinsFee); err != nil {
-			return err
-		}
+	if err := k.deductLockCoinsFee(ctx, fromAddr, unlockTime); err != nil {
+		return err
 	}
 
 	if err := k.SubtractCoins(ctx, fromAddr, amt); err != nil {
@@ -107,6 +129,7 @@ func (k Keeper) SendLockedCoins(ctx sdk.Context, fromAddr, toAddr, supervisor sd
 		}
 	}
 	k.axk.SetAccountX(ctx, ax)
+	k.afterBalanceChanged(ctx, toAddr, amt)
 
 	if !amt.Empty() {
 		k.axk.InsertUnlockedCoinsQueue(ctx, unlockTime, toAddr)
@@ -114,6 +137,61 @@ func (k Keeper) SendLockedCoins(ctx sdk.Context, fromAddr, toAddr, supervisor sd
 	return nil
 }
 
+// SendVestingCoins locks amt in toAddr as the tranches of the schedule, which are released by the
+// EndBlocker through the unlock queue like the other locked coins
+func (k Keeper) SendVestingCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins,
+	schedule authx.VestingSchedule) sdk.Error {
+	if k.IsSendForbidden(ctx, amt, fromAddr) {
+		return types.ErrTokenForbiddenByOwner()
+	}
+	if k.ak.GetAccount(ctx, toAddr) == nil {
+		if err := k.AddCoins(ctx, toAddr, sdk.Coins{}); err != nil {
+			return err
+		}
+	}
+
+	// the last tranche stays locked until the end time
+	if err := k.deductLockCoinsFee(ctx, fromAddr, schedule.EndTime); err != nil {
+		return err
+	}
+
+	if err := k.SubtractCoins(ctx, fromAddr, amt); err != nil {
+		return err
+	}
+
+	ax := k.axk.GetOrCreateAccountX(ctx, toAddr)
+	tranches := schedule.GetTranches(amt)
+	ax.LockedCoins = append(ax.LockedCoins, tranches...)
+	for _, coin := range amt {
+		if err := k.tk.UpdateTokenSendLock(ctx, coin.Denom, coin.Amount, true); err != nil {
+			return err
+		}
+	}
+	k.axk.SetAccountX(ctx, ax)
+	k.afterBalanceChanged(ctx, toAddr, amt)
+
+	for _, tranche := range tranches {
+		k.axk.InsertUnlockedCoinsQueue(ctx, tranche.UnlockTime, toAddr)
+	}
+	return nil
+}
+
+// deductLockCoinsFee charges addr for the days the coins are locked beyond LockCoinsFreeTime
+func (k Keeper) deductLockCoinsFee(ctx sdk.Context, addr sdk.AccAddress, unlockTime int64) sdk.Error {
+	lockDuration := (unlockTime - ctx.BlockHeader().Time.Unix()) * int64(time.Second)
+	if lockDuration > k.GetParams(ctx).LockCoinsFreeTime && k.GetParams(ctx).LockCoinsFeePerDay > 0 {
+		exceededDays := (lockDuration-k.GetParams(ctx).LockCoinsFreeTime-1)/(24*int64(time.Hour)) + 1
+		if exceededDays > math.MaxInt64/k.GetParams(ctx).LockCoinsFeePerDay {
+			return types.ErrUnlockTime("Unlock time is too large")
+		}
+		lockCoinsFee := dex.NewCetCoins(k.GetParams(ctx).LockCoinsFeePerDay * exceededDays)
+		if err := k.DeductFee(ctx, addr, lockCoinsFee); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (k Keeper) EarlierUnlockCoin(ctx sdk.Context, fromAddr, toAddr, supervisor sdk.AccAddress, amt *sdk.Coin,
 	unlockTime int64, reward int64, isReturned bool) (*authx.NotificationUnlock, sdk.Error) {
 	ax, ok := k.axk.GetAccountX(ctx, toAddr)
@@ -170,6 +248,7 @@ func (k Keeper) EarlierUnlockCoin(ctx sdk.Context, fromAddr, toAddr, supervisor
 
 	ax.LockedCoins = append(ax.LockedCoins[:coinIndex], ax.LockedCoins[coinIndex+1:]...)
 	k.axk.SetAccountX(ctx, ax)
+	k.afterBalanceChanged(ctx, toAddr, sdk.NewCoins(*amt))
 
 	if !hasOther {
 		k.axk.RemoveFromUnlockedCoinsQueue(ctx, unlockTime, toAddr)
@@ -187,10 +266,234 @@ func (k Keeper) EarlierUnlockCoin(ctx sdk.Context, fromAddr, toAddr, supervisor
 	return unlockInfo, nil
 }
 
+// CreateHTLC moves the amount of the HTLC to the locked coins of its sender, which are not unlocked
+// by the EndBlocker, but released by ClaimHTLC or RefundHTLC
+func (k Keeper) CreateHTLC(ctx sdk.Context, htlc authx.HTLC) sdk.Error {
+	if k.IsSendForbidden(ctx, htlc.Amount, htlc.Sender) {
+		return types.ErrTokenForbiddenByOwner()
+	}
+	if _, exist := k.axk.GetHTLC(ctx, htlc.Sender, htlc.HashLock); exist {
+		return types.ErrHTLCAlreadyExists(htlc.HashLock)
+	}
+
+	if err := k.deductLockCoinsFee(ctx, htlc.Sender, htlc.ExpireTime); err != nil {
+		return err
+	}
+	if err := k.SubtractCoins(ctx, htlc.Sender, htlc.Amount); err != nil {
+		return err
+	}
+
+	ax := k.axk.GetOrCreateAccountX(ctx, htlc.Sender)
+	ax.LockedCoins = append(ax.LockedCoins, htlc.GetLockedCoins()...)
+	for _, coin := range htlc.Amount {
+		if err := k.tk.UpdateTokenSendLock(ctx, coin.Denom, coin.Amount, true); err != nil {
+			return err
+		}
+	}
+	k.axk.SetAccountX(ctx, ax)
+	k.axk.SetHTLC(ctx, htlc)
+	k.afterBalanceChanged(ctx, htlc.Sender, htlc.Amount)
+	return nil
+}
+
+// ClaimHTLC releases the amount of the HTLC sent by sender to its recipient if the secret matches and it
+// has not expired
+func (k Keeper) ClaimHTLC(ctx sdk.Context, sender sdk.AccAddress, hashLock []byte, secret []byte) (authx.HTLC, sdk.Error) {
+	htlc, ok := k.axk.GetHTLC(ctx, sender, hashLock)
+	if !ok {
+		return htlc, types.ErrHTLCNotFound(hashLock)
+	}
+	if htlc.IsExpired(ctx.BlockHeader().Time.Unix()) {
+		return htlc, types.ErrHTLCExpired(hashLock)
+	}
+	if !htlc.IsSecretMatched(secret) {
+		return htlc, types.ErrInvalidSecret("secret does not match the hash lock")
+	}
+	return htlc, k.releaseHTLC(ctx, htlc, htlc.Recipient)
+}
+
+// RefundHTLC returns the amount of the HTLC to its sender after it has expired
+func (k Keeper) RefundHTLC(ctx sdk.Context, sender sdk.AccAddress, hashLock []byte) (authx.HTLC, sdk.Error) {
+	htlc, ok := k.axk.GetHTLC(ctx, sender, hashLock)
+	if !ok {
+		return htlc, types.ErrHTLCNotFound(hashLock)
+	}
+	if !htlc.IsExpired(ctx.BlockHeader().Time.Unix()) {
+		return htlc, types.ErrHTLCNotExpired(hashLock)
+	}
+	return htlc, k.releaseHTLC(ctx, htlc, htlc.Sender)
+}
+
+func (k Keeper) releaseHTLC(ctx sdk.Context, htlc authx.HTLC, receiver sdk.AccAddress) sdk.Error {
+	ax, ok := k.axk.GetAccountX(ctx, htlc.Sender)
+	if !ok {
+		return sdk.ErrUnknownAddress(fmt.Sprintf("account %s does not exist", htlc.Sender))
+	}
+	stillLocked := make(authx.LockedCoins, 0, len(ax.LockedCoins))
+	for _, lockedCoin := range ax.LockedCoins {
+		if !bytes.Equal(lockedCoin.HashLock, htlc.HashLock) {
+			stillLocked = append(stillLocked, lockedCoin)
+		}
+	}
+	ax.LockedCoins = stillLocked
+	k.axk.SetAccountX(ctx, ax)
+	k.axk.RemoveHTLC(ctx, htlc)
+
+	for _, coin := range htlc.Amount {
+		if err := k.tk.UpdateTokenSendLock(ctx, coin.Denom, coin.Amount, false); err != nil {
+			return err
+		}
+	}
+	if err := k.AddCoins(ctx, receiver, htlc.Amount); err != nil {
+		return err
+	}
+	k.afterBalanceChanged(ctx, htlc.Sender, htlc.Amount)
+	return nil
+}
+
+func (k Keeper) GetHTLC(ctx sdk.Context, sender sdk.AccAddress, hashLock []byte) (authx.HTLC, bool) {
+	return k.axk.GetHTLC(ctx, sender, hashLock)
+}
+
+func (k Keeper) GetHTLCsOfAccount(ctx sdk.Context, addr sdk.AccAddress) []authx.HTLC {
+	return k.axk.GetHTLCsOfAccount(ctx, addr)
+}
+
+// CreateStream moves the amount of the stream to the locked coins of its sender, which are not unlocked
+// by the EndBlocker, but released to the recipient pro rata by WithdrawStream or CancelStream
+func (k Keeper) CreateStream(ctx sdk.Context, sender, recipient sdk.AccAddress, amt sdk.Coin,
+	startTime, endTime int64) (authx.Stream, sdk.Error) {
+	coins := sdk.NewCoins(amt)
+	if k.IsSendForbidden(ctx, coins, sender) {
+		return authx.Stream{}, types.ErrTokenForbiddenByOwner()
+	}
+
+	if err := k.deductLockCoinsFee(ctx, sender, endTime); err != nil {
+		return authx.Stream{}, err
+	}
+	if err := k.SubtractCoins(ctx, sender, coins); err != nil {
+		return authx.Stream{}, err
+	}
+
+	id := k.axk.GetNextStreamID(ctx)
+	k.axk.SetNextStreamID(ctx, id+1)
+	stream := authx.NewStream(id, sender, recipient, amt, startTime, endTime)
+
+	ax := k.axk.GetOrCreateAccountX(ctx, sender)
+	ax.LockedCoins = append(ax.LockedCoins, authx.NewStreamLockedCoin(amt.Denom, amt.Amount, endTime, id))
+	if err := k.tk.UpdateTokenSendLock(ctx, amt.Denom, amt.Amount, true); err != nil {
+		return stream, err
+	}
+	k.axk.SetAccountX(ctx, ax)
+	k.axk.SetStream(ctx, stream)
+	k.afterBalanceChanged(ctx, sender, coins)
+	return stream, nil
+}
+
+// WithdrawStream pays the vested but not yet withdrawn amount of the stream to its recipient
+func (k Keeper) WithdrawStream(ctx sdk.Context, id uint64, recipient sdk.AccAddress) (authx.Stream, sdk.Int, sdk.Error) {
+	stream, ok := k.axk.GetStream(ctx, id)
+	if !ok {
+		return stream, sdk.ZeroInt(), types.ErrStreamNotFound(id)
+	}
+	if !stream.Recipient.Equals(recipient) {
+		return stream, sdk.ZeroInt(), sdk.ErrUnauthorized("only the recipient can withdraw from the stream")
+	}
+	amt := stream.GetWithdrawable(ctx.BlockHeader().Time.Unix())
+	if !amt.IsPositive() {
+		return stream, sdk.ZeroInt(), types.ErrNoWithdrawableAmount(id)
+	}
+
+	if err := k.releaseStreamCoins(ctx, stream, stream.Recipient, amt); err != nil {
+		return stream, sdk.ZeroInt(), err
+	}
+	stream.Withdrawn = stream.Withdrawn.Add(amt)
+	if stream.GetRemaining().IsZero() {
+		k.axk.RemoveStream(ctx, stream)
+	} else {
+		k.axk.SetStream(ctx, stream)
+	}
+	return stream, amt, nil
+}
+
+// CancelStream pays the vested amount of the stream to its recipient, returns the unvested remainder
+// to its sender and removes the stream
+func (k Keeper) CancelStream(ctx sdk.Context, id uint64, sender sdk.AccAddress) (stream authx.Stream,
+	paid sdk.Int, refunded sdk.Int, err sdk.Error) {
+	stream, ok := k.axk.GetStream(ctx, id)
+	if !ok {
+		return stream, sdk.ZeroInt(), sdk.ZeroInt(), types.ErrStreamNotFound(id)
+	}
+	if !stream.Sender.Equals(sender) {
+		return stream, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ErrUnauthorized("only the sender can cancel the stream")
+	}
+	paid = stream.GetWithdrawable(ctx.BlockHeader().Time.Unix())
+	refunded = stream.GetRemaining().Sub(paid)
+
+	if paid.IsPositive() {
+		if err = k.releaseStreamCoins(ctx, stream, stream.Recipient, paid); err != nil {
+			return
+		}
+	}
+	if refunded.IsPositive() {
+		if err = k.releaseStreamCoins(ctx, stream, stream.Sender, refunded); err != nil {
+			return
+		}
+	}
+	stream.Withdrawn = stream.Withdrawn.Add(paid)
+	k.axk.RemoveStream(ctx, stream)
+	return
+}
+
+// releaseStreamCoins takes amt out of the sender's locked coin of the stream and adds it to receiver
+func (k Keeper) releaseStreamCoins(ctx sdk.Context, stream authx.Stream, receiver sdk.AccAddress, amt sdk.Int) sdk.Error {
+	ax, ok := k.axk.GetAccountX(ctx, stream.Sender)
+	if !ok {
+		return sdk.ErrUnknownAddress(fmt.Sprintf("account %s does not exist", stream.Sender))
+	}
+	coinIndex := -1
+	for i, lockedCoin := range ax.LockedCoins {
+		if lockedCoin.StreamID == stream.ID {
+			coinIndex = i
+			break
+		}
+	}
+	if coinIndex < 0 || ax.LockedCoins[coinIndex].Coin.Amount.LT(amt) {
+		return types.ErrLockedCoinNotFound()
+	}
+	lockedCoin := &ax.LockedCoins[coinIndex]
+	lockedCoin.Coin.Amount = lockedCoin.Coin.Amount.Sub(amt)
+	if lockedCoin.Coin.Amount.IsZero() {
+		ax.LockedCoins = append(ax.LockedCoins[:coinIndex], ax.LockedCoins[coinIndex+1:]...)
+	}
+	k.axk.SetAccountX(ctx, ax)
+
+	if err := k.tk.UpdateTokenSendLock(ctx, stream.Amount.Denom, amt, false); err != nil {
+		return err
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(stream.Amount.Denom, amt))
+	if err := k.AddCoins(ctx, receiver, coins); err != nil {
+		return err
+	}
+	k.afterBalanceChanged(ctx, stream.Sender, coins)
+	return nil
+}
+
+func (k Keeper) GetStream(ctx sdk.Context, id uint64) (authx.Stream, bool) {
+	return k.axk.GetStream(ctx, id)
+}
+
+func (k Keeper) GetStreamsOfAccount(ctx sdk.Context, addr sdk.AccAddress) []authx.Stream {
+	return k.axk.GetStreamsOfAccount(ctx, addr)
+}
+
 func (k Keeper) FreezeCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error {
 	if k.IsSendForbidden(ctx, amt, addr) {
 		return types.ErrTokenForbiddenByOwner()
 	}
+	if k.IsSendFrozenByIssuer(ctx, amt, addr) {
+		return types.ErrTokenFrozenByOwner()
+	}
 	_, err := k.bk.SubtractCoins(ctx, addr, amt)
 	if err != nil {
 		return err
@@ -220,26 +523,40 @@ func (k Keeper) UnFreezeCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin
 	return err
 }
 
+// SubtractCoins removes amt from an account, the amounts frozen by the token owners can not be removed
 func (k Keeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error {
-	_, err := k.bk.SubtractCoins(ctx, addr, amt)
-	return err
+	if k.IsSendFrozenByIssuer(ctx, amt, addr) {
+		return types.ErrTokenFrozenByOwner()
+	}
+	if _, err := k.bk.SubtractCoins(ctx, addr, amt); err != nil {
+		return err
+	}
+	k.afterBalanceChanged(ctx, addr, amt)
+	return nil
 }
 
 func (k Keeper) AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error {
-	_, err := k.bk.AddCoins(ctx, addr, amt)
-	return err
+	if _, err := k.bk.AddCoins(ctx, addr, amt); err != nil {
+		return err
+	}
+	k.afterBalanceChanged(ctx, addr, amt)
+	return nil
 }
 
 func (k Keeper) MockAddLockedCoins(ctx sdk.Context, addr sdk.AccAddress, lockedCoins authx.LockedCoins) {
 	ax := k.axk.GetOrCreateAccountX(ctx, addr)
 	ax.LockedCoins = append(ax.LockedCoins, lockedCoins...)
 	k.axk.SetAccountX(ctx, ax)
+	for _, coin := range lockedCoins {
+		k.afterBalanceChanged(ctx, addr, sdk.NewCoins(coin.Coin))
+	}
 }
 
 func (k Keeper) MockAddFrozenCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) {
 	ax := k.axk.GetOrCreateAccountX(ctx, addr)
 	ax.FrozenCoins = ax.FrozenCoins.Add(amt)
 	k.axk.SetAccountX(ctx, ax)
+	k.afterBalanceChanged(ctx, addr, amt)
 }
 
 func (k Keeper) DeductInt64CetFee(ctx sdk.Context, addr sdk.AccAddress, amt int64) sdk.Error {
@@ -280,11 +597,19 @@ func (k Keeper) DeductActivationFeeForFreshAccounts(ctx sdk.Context, addrs []sdk
 }
 
 func (k Keeper) DeductFee(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error {
-	return k.sk.SendCoinsFromAccountToModule(ctx, addr, auth.FeeCollectorName, amt)
+	if err := k.sk.SendCoinsFromAccountToModule(ctx, addr, auth.FeeCollectorName, amt); err != nil {
+		return err
+	}
+	k.afterBalanceChanged(ctx, addr, amt)
+	return nil
 }
 
 func (k Keeper) DonateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error {
-	return k.sk.SendCoinsFromAccountToModule(ctx, addr, distribution.ModuleName, amt)
+	if err := k.sk.SendCoinsFromAccountToModule(ctx, addr, distribution.ModuleName, amt); err != nil {
+		return err
+	}
+	k.afterBalanceChanged(ctx, addr, amt)
+	return nil
 }
 
 func (k Keeper) IsSendForbidden(ctx sdk.Context, amt sdk.Coins, addr sdk.AccAddress) bool {
@@ -296,6 +621,28 @@ func (k Keeper) IsSendForbidden(ctx sdk.Context, amt sdk.Coins, addr sdk.AccAddr
 	return false
 }
 
+// IsPermittedByIssuer returns false and the denom if addr is not permitted to hold one of the permissioned tokens in amt
+func (k Keeper) IsPermittedByIssuer(ctx sdk.Context, amt sdk.Coins, addr sdk.AccAddress) (string, bool) {
+	for _, coin := range amt {
+		if !k.tk.IsPermittedByTokenIssuer(ctx, coin.Denom, addr) {
+			return coin.Denom, false
+		}
+	}
+	return "", true
+}
+
+// IsSendFrozenByIssuer returns true if moving amt out of addr would touch the amounts frozen by the token owners
+func (k Keeper) IsSendFrozenByIssuer(ctx sdk.Context, amt sdk.Coins, addr sdk.AccAddress) bool {
+	coins := k.GetCoins(ctx, addr)
+	for _, coin := range amt {
+		frozen := k.tk.GetIssuerFrozenAmount(ctx, coin.Denom, addr)
+		if frozen.IsPositive() && coins.AmountOf(coin.Denom).Sub(coin.Amount).LT(frozen) {
+			return true
+		}
+	}
+	return false
+}
+
 func (k Keeper) IsTokensExist(ctx sdk.Context, amt sdk.Coins) (string, bool) {
 	for _, coin := range amt {
 		if !k.tk.IsTokenExists(ctx, coin.Denom) {
@@ -337,6 +684,17 @@ func (k Keeper) GetTotalCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
 
 }
 
+// IterateTotalCoins iterates the total coins of all the accounts, including the locked and frozen coins
+func (k Keeper) IterateTotalCoins(ctx sdk.Context, process func(addr sdk.AccAddress, coins sdk.Coins) (stop bool)) {
+	k.ak.IterateAccounts(ctx, func(acc auth.Account) bool {
+		coins := acc.GetCoins()
+		if accx, found := k.axk.GetAccountX(ctx, acc.GetAddress()); found {
+			coins = coins.Add(accx.GetAllCoins())
+		}
+		return process(acc.GetAddress(), coins)
+	})
+}
+
 func (k Keeper) BlacklistedAddr(addr sdk.AccAddress) bool {
 	return k.bk.BlacklistedAddr(addr)
 }
@@ -350,7 +708,16 @@ func (k Keeper) GetSendEnabled(ctx sdk.Context) bool {
 }
 
 func (k Keeper) InputOutputCoins(ctx sdk.Context, inputs []bank.Input, outputs []bank.Output) sdk.Error {
-	return k.bk.InputOutputCoins(ctx, inputs, outputs)
+	if err := k.bk.InputOutputCoins(ctx, inputs, outputs); err != nil {
+		return err
+	}
+	for _, input := range inputs {
+		k.afterBalanceChanged(ctx, input.Address, input.Coins)
+	}
+	for _, output := range outputs {
+		k.afterBalanceChanged(ctx, output.Address, output.Coins)
+	}
+	return nil
 }
 
 func (k Keeper) SetMemoRequired(ctx sdk.Context, addr sdk.AccAddress, required bool) sdk.Error {
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -25,6 +26,11 @@ var myaddr = testutil.ToAccAddress("myaddr")
 var ownerAddr = testutil.ToAccAddress("owneraddr")
 
 func defaultContext() (keeper.Keeper, sdk.Context) {
+	app, ctx := defaultApp()
+	return app.BankxKeeper, ctx
+}
+
+func defaultApp() (*testapp.TestApp, sdk.Context) {
 	app := testapp.NewTestApp()
 	ctx := sdk.NewContext(app.Cms, abci.Header{}, false, log.NewNopLogger())
 	app.AccountKeeper.SetAccount(ctx, supply.NewEmptyModuleAccount(authx.ModuleName))
@@ -38,7 +44,7 @@ func defaultContext() (keeper.Keeper, sdk.Context) {
 	_ = app.AssetKeeper.IssueToken(ctx, "cet", "cet", sdk.NewInt(100000000000), ownerAddr,
 		false, false, false, false,
 		"", "", "cet")
-	return app.BankxKeeper, ctx
+	return app, ctx
 }
 
 func givenAccountWith(ctx sdk.Context, keeper keeper.Keeper, addr sdk.AccAddress, coinsString string) error {
@@ -153,6 +159,25 @@ func TestGetTotalCoins(t *testing.T) {
 	require.Equal(t, expected, coins)
 }
 
+func TestIterateTotalCoins(t *testing.T) {
+	bkx, ctx := defaultContext()
+	err := givenAccountWith(ctx, bkx, myaddr, "100cet, 20bch")
+	require.NoError(t, err)
+	bkx.MockAddLockedCoins(ctx, myaddr, authx.LockedCoins{authx.NewLockedCoin("bch", sdk.NewInt(20), 1000)})
+	bkx.MockAddFrozenCoins(ctx, myaddr, sdk.NewCoins(sdk.NewCoin("bch", sdk.NewInt(10))))
+
+	found := false
+	bkx.IterateTotalCoins(ctx, func(addr sdk.AccAddress, coins sdk.Coins) bool {
+		if addr.Equals(myaddr) {
+			found = true
+			require.Equal(t, sdk.NewInt(50), coins.AmountOf("bch"))
+			require.Equal(t, sdk.NewInt(100), coins.AmountOf("cet"))
+		}
+		return false
+	})
+	require.True(t, found)
+}
+
 func TestKeeper_TotalAmountOfCoin(t *testing.T) {
 
 	bkx, ctx := defaultContext()
@@ -229,3 +254,181 @@ func TestKeeper_SendCoins(t *testing.T) {
 	cs := bkx.GetTotalCoins(ctx, addr2)
 	require.Equal(t, coins, cs)
 }
+
+func TestKeeper_Hooks(t *testing.T) {
+	app, ctx := defaultApp()
+	bkx := app.BankxKeeper
+	require.Panics(t, func() { bkx.SetHooks(app.TokenKeeper) })
+
+	addr2 := testutil.ToAccAddress("addr2")
+	require.NoError(t, givenAccountWith(ctx, bkx, myaddr, "100abc"))
+	require.NoError(t, bkx.SendCoins(ctx, myaddr, addr2, sdk.NewCoins(sdk.NewCoin("abc", sdk.NewInt(60)))))
+	require.Equal(t, uint64(2), app.TokenKeeper.GetHolderCount(ctx, "abc"))
+	require.Equal(t, uint64(1), app.TokenKeeper.GetHolderRank(ctx, "abc", addr2).Rank)
+
+	// the frozen coins are still held
+	require.NoError(t, bkx.FreezeCoins(ctx, addr2, sdk.NewCoins(sdk.NewCoin("abc", sdk.NewInt(60)))))
+	require.Equal(t, uint64(2), app.TokenKeeper.GetHolderCount(ctx, "abc"))
+	require.NoError(t, bkx.SubtractCoins(ctx, myaddr, sdk.NewCoins(sdk.NewCoin("abc", sdk.NewInt(40)))))
+	require.Equal(t, uint64(1), app.TokenKeeper.GetHolderCount(ctx, "abc"))
+	require.Nil(t, app.TokenKeeper.GetHolderRank(ctx, "abc", myaddr))
+}
+
+func TestKeeper_HTLC(t *testing.T) {
+	app, ctx := defaultApp()
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	bkx := app.BankxKeeper
+	bkx.SetParams(ctx, types.DefaultParams())
+	addr2 := testutil.ToAccAddress("addr2")
+	require.NoError(t, givenAccountWith(ctx, bkx, myaddr, "100abc"))
+
+	require.NoError(t, givenAccountWith(ctx, bkx, addr2, "10abc"))
+
+	// the same hash lock used by another sender does not block the htlc
+	secret := []byte("secret")
+	amt := sdk.NewCoins(sdk.NewCoin("abc", sdk.NewInt(60)))
+	frontRun := authx.NewHTLC(authx.GetHashLock(secret), addr2, addr2, sdk.NewCoins(sdk.NewCoin("abc", sdk.NewInt(10))), 1100)
+	require.NoError(t, bkx.CreateHTLC(ctx, frontRun))
+	htlc := authx.NewHTLC(authx.GetHashLock(secret), myaddr, addr2, amt, 1100)
+	require.NoError(t, bkx.CreateHTLC(ctx, htlc))
+	_, err := bkx.RefundHTLC(ctx, addr2, frontRun.HashLock)
+	require.Equal(t, types.CodeHTLCNotExpired, err.Code())
+	_, err = bkx.ClaimHTLC(ctx, addr2, frontRun.HashLock, secret)
+	require.NoError(t, err)
+	require.Equal(t, "10abc", coinsOf(ctx, bkx, addr2))
+	require.Equal(t, "40abc", coinsOf(ctx, bkx, myaddr))
+	require.Equal(t, "100abc", bkx.GetTotalCoins(ctx, myaddr).String())
+	require.Equal(t, 1, len(bkx.GetLockedCoins(ctx, myaddr)))
+	require.True(t, bkx.GetLockedCoins(ctx, myaddr)[0].IsHashLocked())
+	require.Equal(t, "60", app.AssetKeeper.GetToken(ctx, "abc").GetSendLock().String())
+	require.Equal(t, []authx.HTLC{htlc}, bkx.GetHTLCsOfAccount(ctx, addr2))
+
+	err = bkx.CreateHTLC(ctx, htlc)
+	require.Equal(t, types.CodeHTLCAlreadyExists, err.Code())
+	_, err = bkx.ClaimHTLC(ctx, myaddr, htlc.HashLock, []byte("wrong"))
+	require.Equal(t, types.CodeInvalidSecret, err.Code())
+	_, err = bkx.RefundHTLC(ctx, myaddr, htlc.HashLock)
+	require.Equal(t, types.CodeHTLCNotExpired, err.Code())
+
+	_, err = bkx.ClaimHTLC(ctx, myaddr, htlc.HashLock, secret)
+	require.NoError(t, err)
+	require.Equal(t, "70abc", coinsOf(ctx, bkx, addr2))
+	require.Equal(t, "40abc", bkx.GetTotalCoins(ctx, myaddr).String())
+	require.Empty(t, bkx.GetLockedCoins(ctx, myaddr))
+	require.True(t, app.AssetKeeper.GetToken(ctx, "abc").GetSendLock().IsZero())
+	require.Equal(t, uint64(2), app.TokenKeeper.GetHolderCount(ctx, "abc"))
+	_, found := bkx.GetHTLC(ctx, myaddr, htlc.HashLock)
+	require.False(t, found)
+	_, err = bkx.ClaimHTLC(ctx, myaddr, htlc.HashLock, secret)
+	require.Equal(t, types.CodeHTLCNotFound, err.Code())
+
+	// the expired htlc can only be refunded
+	amt = sdk.NewCoins(sdk.NewCoin("abc", sdk.NewInt(40)))
+	htlc = authx.NewHTLC(authx.GetHashLock([]byte("secret2")), myaddr, addr2, amt, 1100)
+	require.NoError(t, bkx.CreateHTLC(ctx, htlc))
+	require.Equal(t, "", coinsOf(ctx, bkx, myaddr))
+	ctx = ctx.WithBlockTime(time.Unix(1100, 0))
+	_, err = bkx.ClaimHTLC(ctx, myaddr, htlc.HashLock, []byte("secret2"))
+	require.Equal(t, types.CodeHTLCExpired, err.Code())
+	_, err = bkx.RefundHTLC(ctx, myaddr, htlc.HashLock)
+	require.NoError(t, err)
+	require.Equal(t, "40abc", coinsOf(ctx, bkx, myaddr))
+	require.Empty(t, bkx.GetLockedCoins(ctx, myaddr))
+	require.Empty(t, bkx.GetHTLCsOfAccount(ctx, myaddr))
+}
+
+func TestKeeper_Stream(t *testing.T) {
+	app, ctx := defaultApp()
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	bkx := app.BankxKeeper
+	bkx.SetParams(ctx, types.DefaultParams())
+	addr2 := testutil.ToAccAddress("addr2")
+	require.NoError(t, givenAccountWith(ctx, bkx, myaddr, "100abc"))
+
+	stream, err := bkx.CreateStream(ctx, myaddr, addr2, sdk.NewInt64Coin("abc", 60), 1000, 1700)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), stream.ID)
+	require.Equal(t, "40abc", coinsOf(ctx, bkx, myaddr))
+	require.Equal(t, "100abc", bkx.GetTotalCoins(ctx, myaddr).String())
+	require.True(t, bkx.GetLockedCoins(ctx, myaddr)[0].IsStreamed())
+	require.Equal(t, "60", app.AssetKeeper.GetToken(ctx, "abc").GetSendLock().String())
+	require.Equal(t, 1, len(bkx.GetStreamsOfAccount(ctx, addr2)))
+
+	_, _, err = bkx.WithdrawStream(ctx, stream.ID, addr2)
+	require.Equal(t, types.CodeNoWithdrawableAmount, err.Code())
+
+	// 60 * 300 / 700 = 25.7 is rounded down
+	ctx = ctx.WithBlockTime(time.Unix(1300, 0))
+	_, _, err = bkx.WithdrawStream(ctx, stream.ID, myaddr)
+	require.Equal(t, sdk.CodeUnauthorized, err.Code())
+	_, paid, err := bkx.WithdrawStream(ctx, stream.ID, addr2)
+	require.NoError(t, err)
+	require.Equal(t, "25", paid.String())
+	require.Equal(t, "25abc", coinsOf(ctx, bkx, addr2))
+	require.Equal(t, "35", bkx.GetLockedCoins(ctx, myaddr)[0].Coin.Amount.String())
+	stream, _ = bkx.GetStream(ctx, stream.ID)
+	require.Equal(t, "25", stream.Withdrawn.String())
+
+	// 60 * 400 / 700 = 34.3, of which 25 has been withdrawn
+	ctx = ctx.WithBlockTime(time.Unix(1400, 0))
+	_, _, _, err = bkx.CancelStream(ctx, stream.ID, addr2)
+	require.Equal(t, sdk.CodeUnauthorized, err.Code())
+	_, paid, refunded, err := bkx.CancelStream(ctx, stream.ID, myaddr)
+	require.NoError(t, err)
+	require.Equal(t, "9", paid.String())
+	require.Equal(t, "26", refunded.String())
+	require.Equal(t, "34abc", coinsOf(ctx, bkx, addr2))
+	require.Equal(t, "66abc", coinsOf(ctx, bkx, myaddr))
+	require.Empty(t, bkx.GetLockedCoins(ctx, myaddr))
+	require.True(t, app.AssetKeeper.GetToken(ctx, "abc").GetSendLock().IsZero())
+	require.Empty(t, bkx.GetStreamsOfAccount(ctx, myaddr))
+	_, _, _, err = bkx.CancelStream(ctx, stream.ID, myaddr)
+	require.Equal(t, types.CodeStreamNotFound, err.Code())
+
+	// the stream is removed after its whole amount is withdrawn
+	stream, err = bkx.CreateStream(ctx, myaddr, addr2, sdk.NewInt64Coin("abc", 66), 1400, 1500)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), stream.ID)
+	ctx = ctx.WithBlockTime(time.Unix(1600, 0))
+	_, paid, err = bkx.WithdrawStream(ctx, stream.ID, addr2)
+	require.NoError(t, err)
+	require.Equal(t, "66", paid.String())
+	require.Equal(t, "100abc", coinsOf(ctx, bkx, addr2))
+	require.Empty(t, bkx.GetLockedCoins(ctx, myaddr))
+	_, found := bkx.GetStream(ctx, stream.ID)
+	require.False(t, found)
+}
+
+func TestKeeper_SendVestingCoins(t *testing.T) {
+	app, ctx := defaultApp()
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	bkx := app.BankxKeeper
+	bkx.SetParams(ctx, types.DefaultParams())
+	addr2 := testutil.ToAccAddress("addr2")
+	require.NoError(t, givenAccountWith(ctx, bkx, myaddr, "100abc"))
+
+	schedule := authx.NewVestingSchedule(1000, 1200, 1400, 100)
+	amt := sdk.NewCoins(sdk.NewCoin("abc", sdk.NewInt(100)))
+	require.NoError(t, bkx.SendVestingCoins(ctx, myaddr, addr2, amt, schedule))
+	require.Equal(t, "", coinsOf(ctx, bkx, myaddr))
+	require.Equal(t, "100abc", bkx.GetTotalCoins(ctx, addr2).String())
+	require.Equal(t, authx.LockedCoins{
+		authx.NewLockedCoin("abc", sdk.NewInt(50), 1200),
+		authx.NewLockedCoin("abc", sdk.NewInt(25), 1300),
+		authx.NewLockedCoin("abc", sdk.NewInt(25), 1400),
+	}, bkx.GetLockedCoins(ctx, addr2))
+	require.Equal(t, "100", app.AssetKeeper.GetToken(ctx, "abc").GetSendLock().String())
+
+	// the tranches are released by the EndBlocker one by one
+	ctx = ctx.WithBlockTime(time.Unix(1250, 0))
+	authx.EndBlocker(ctx, app.AccountXKeeper, app.AccountKeeper, app.AssetKeeper)
+	require.Equal(t, "50abc", coinsOf(ctx, bkx, addr2))
+	require.Equal(t, 2, len(bkx.GetLockedCoins(ctx, addr2)))
+	require.Equal(t, "50", app.AssetKeeper.GetToken(ctx, "abc").GetSendLock().String())
+
+	ctx = ctx.WithBlockTime(time.Unix(1400, 0))
+	authx.EndBlocker(ctx, app.AccountXKeeper, app.AccountKeeper, app.AssetKeeper)
+	require.Equal(t, "100abc", coinsOf(ctx, bkx, addr2))
+	require.Empty(t, bkx.GetLockedCoins(ctx, addr2))
+	require.True(t, app.AssetKeeper.GetToken(ctx, "abc").GetSendLock().IsZero())
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,6 +16,10 @@ import (
 const (
 	QueryParameters = "parameters"
 	QueryBalances   = "balances"
+	QueryHTLC       = "htlc"
+	QueryHTLCs      = "htlcs"
+	QueryStream     = "stream"
+	QueryStreams    = "streams"
 )
 
 // creates a querier for asset REST endpoints
@@ -25,6 +30,14 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryParameters(ctx, keeper)
 		case QueryBalances:
 			return queryBalances(ctx, keeper, req)
+		case QueryHTLC:
+			return queryHTLC(ctx, keeper, req)
+		case QueryHTLCs:
+			return queryHTLCs(ctx, keeper, req)
+		case QueryStream:
+			return queryStream(ctx, keeper, req)
+		case QueryStreams:
+			return queryStreams(ctx, keeper, req)
 		default:
 			return nil, sdk.ErrUnknownRequest("query symbol : " + path[0])
 		}
@@ -82,3 +95,113 @@ func NewQueryAddrBalances(addr sdk.AccAddress) QueryAddrBalances {
 		Addr: addr,
 	}
 }
+
+func queryHTLC(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, sdk.Error) {
+	var params QueryHTLCParam
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	htlc, ok := k.GetHTLC(ctx, params.Sender, params.HashLock)
+	if !ok {
+		return nil, types.ErrHTLCNotFound(params.HashLock)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, htlc)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryHTLCs(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, sdk.Error) {
+	var params QueryAddrHTLCs
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetHTLCsOfAccount(ctx, params.Addr))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// queryStream returns the stream together with its amount withdrawable at the current block time
+func queryStream(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, sdk.Error) {
+	var params QueryStreamParam
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	stream, ok := k.GetStream(ctx, params.ID)
+	if !ok {
+		return nil, types.ErrStreamNotFound(params.ID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, types.NewStreamInfo(stream, ctx.BlockHeader().Time.Unix()))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryStreams(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, sdk.Error) {
+	var params QueryAddrStreams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	streams := k.GetStreamsOfAccount(ctx, params.Addr)
+	infos := make([]types.StreamInfo, len(streams))
+	for i, stream := range streams {
+		infos[i] = types.NewStreamInfo(stream, ctx.BlockHeader().Time.Unix())
+	}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, infos)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+type QueryHTLCParam struct {
+	Sender   sdk.AccAddress `json:"sender"`
+	HashLock cmn.HexBytes   `json:"hash_lock"`
+}
+
+func NewQueryHTLCParam(sender sdk.AccAddress, hashLock []byte) QueryHTLCParam {
+	return QueryHTLCParam{
+		Sender:   sender,
+		HashLock: hashLock,
+	}
+}
+
+type QueryAddrHTLCs struct {
+	Addr sdk.AccAddress `json:"addr"`
+}
+
+func NewQueryAddrHTLCs(addr sdk.AccAddress) QueryAddrHTLCs {
+	return QueryAddrHTLCs{
+		Addr: addr,
+	}
+}
+
+type QueryStreamParam struct {
+	ID uint64 `json:"id"`
+}
+
+func NewQueryStreamParam(id uint64) QueryStreamParam {
+	return QueryStreamParam{
+		ID: id,
+	}
+}
+
+type QueryAddrStreams struct {
+	Addr sdk.AccAddress `json:"addr"`
+}
+
+func NewQueryAddrStreams(addr sdk.AccAddress) QueryAddrStreams {
+	return QueryAddrStreams{
+		Addr: addr,
+	}
+}
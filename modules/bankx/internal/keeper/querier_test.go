@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -13,6 +14,7 @@ import (
 	"github.com/coinexchain/cet-sdk/modules/bankx/internal/keeper"
 	"github.com/coinexchain/cet-sdk/modules/bankx/internal/types"
 	"github.com/coinexchain/cet-sdk/testapp"
+	"github.com/coinexchain/cet-sdk/testutil"
 )
 
 func Test_queryParams(t *testing.T) {
@@ -62,3 +64,67 @@ func Test_queryBalances(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 }
+
+func Test_queryHTLCs(t *testing.T) {
+	testApp := testapp.NewTestApp()
+	ctx := testApp.NewCtx()
+	querier := keeper.NewQuerier(testApp.BankxKeeper)
+
+	_, _, sender := testutil.KeyPubAddr()
+	_, _, recipient := testutil.KeyPubAddr()
+	htlc := authx.NewHTLC(authx.GetHashLock([]byte("secret")), sender, recipient,
+		sdk.NewCoins(sdk.NewInt64Coin("cet", 100)), 1000)
+
+	req := abci.RequestQuery{Data: testApp.Cdc.MustMarshalJSON(keeper.NewQueryHTLCParam(htlc.Sender, htlc.HashLock))}
+	_, err := querier(ctx, []string{keeper.QueryHTLC}, req)
+	require.Equal(t, types.CodeHTLCNotFound, err.Code())
+
+	testApp.AccountXKeeper.SetHTLC(ctx, htlc)
+	res, err := querier(ctx, []string{keeper.QueryHTLC}, req)
+	require.NoError(t, err)
+	var htlc2 authx.HTLC
+	testApp.Cdc.MustUnmarshalJSON(res, &htlc2)
+	require.Equal(t, htlc.HashLock, htlc2.HashLock)
+	require.Equal(t, recipient, htlc2.Recipient)
+	require.Equal(t, "100cet", htlc2.Amount.String())
+
+	req = abci.RequestQuery{Data: testApp.Cdc.MustMarshalJSON(keeper.NewQueryAddrHTLCs(recipient))}
+	res, err = querier(ctx, []string{keeper.QueryHTLCs}, req)
+	require.NoError(t, err)
+	var htlcs []authx.HTLC
+	testApp.Cdc.MustUnmarshalJSON(res, &htlcs)
+	require.Equal(t, 1, len(htlcs))
+	require.Equal(t, htlc.HashLock, htlcs[0].HashLock)
+}
+
+func Test_queryStreams(t *testing.T) {
+	testApp := testapp.NewTestApp()
+	ctx := testApp.NewCtx().WithBlockTime(time.Unix(1250, 0))
+	querier := keeper.NewQuerier(testApp.BankxKeeper)
+
+	_, _, sender := testutil.KeyPubAddr()
+	_, _, recipient := testutil.KeyPubAddr()
+	stream := authx.NewStream(1, sender, recipient, sdk.NewInt64Coin("cet", 100), 1000, 2000)
+
+	req := abci.RequestQuery{Data: testApp.Cdc.MustMarshalJSON(keeper.NewQueryStreamParam(stream.ID))}
+	_, err := querier(ctx, []string{keeper.QueryStream}, req)
+	require.Equal(t, types.CodeStreamNotFound, err.Code())
+
+	testApp.AccountXKeeper.SetStream(ctx, stream)
+	res, err := querier(ctx, []string{keeper.QueryStream}, req)
+	require.NoError(t, err)
+	var info types.StreamInfo
+	testApp.Cdc.MustUnmarshalJSON(res, &info)
+	require.Equal(t, recipient, info.Stream.Recipient)
+	require.Equal(t, "100cet", info.Stream.Amount.String())
+	require.Equal(t, "25", info.Withdrawable.String())
+
+	req = abci.RequestQuery{Data: testApp.Cdc.MustMarshalJSON(keeper.NewQueryAddrStreams(sender))}
+	res, err = querier(ctx, []string{keeper.QueryStreams}, req)
+	require.NoError(t, err)
+	var infos []types.StreamInfo
+	testApp.Cdc.MustUnmarshalJSON(res, &infos)
+	require.Equal(t, 1, len(infos))
+	require.Equal(t, stream.ID, infos[0].Stream.ID)
+	require.Equal(t, "25", infos[0].Withdrawable.String())
+}
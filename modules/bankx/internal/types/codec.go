@@ -17,4 +17,12 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSend{}, "bankx/MsgSend", nil)
 	cdc.RegisterConcrete(MsgMultiSend{}, "bankx/MsgMultiSend", nil)
 	cdc.RegisterConcrete(MsgSupervisedSend{}, "bankx/MsgSupervisedSend", nil)
+	cdc.RegisterConcrete(MsgCreateHTLC{}, "bankx/MsgCreateHTLC", nil)
+	cdc.RegisterConcrete(MsgClaimHTLC{}, "bankx/MsgClaimHTLC", nil)
+	cdc.RegisterConcrete(MsgRefundHTLC{}, "bankx/MsgRefundHTLC", nil)
+	cdc.RegisterConcrete(MsgCreateStream{}, "bankx/MsgCreateStream", nil)
+	cdc.RegisterConcrete(MsgWithdrawStream{}, "bankx/MsgWithdrawStream", nil)
+	cdc.RegisterConcrete(MsgCancelStream{}, "bankx/MsgCancelStream", nil)
+	cdc.RegisterConcrete(MsgVestingSend{}, "bankx/MsgVestingSend", nil)
+	cdc.RegisterConcrete(MsgLockedMultiSend{}, "bankx/MsgLockedMultiSend", nil)
 }
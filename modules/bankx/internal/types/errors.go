@@ -22,6 +22,17 @@ const (
 	CodeRewardExceedsAmount             sdk.CodeType = 312
 	CodeLockedCoinNotFound              sdk.CodeType = 313
 	CodeInvalidTokenSymbol              sdk.CodeType = 314
+	CodeTokenFrozenByOwner              sdk.CodeType = 315
+	CodeSenderNotPermitted              sdk.CodeType = 316
+	CodeReceiverNotPermitted            sdk.CodeType = 317
+	CodeInvalidHashLock                 sdk.CodeType = 318
+	CodeHTLCAlreadyExists               sdk.CodeType = 319
+	CodeHTLCNotFound                    sdk.CodeType = 320
+	CodeInvalidSecret                   sdk.CodeType = 321
+	CodeHTLCExpired                     sdk.CodeType = 322
+	CodeHTLCNotExpired                  sdk.CodeType = 323
+	CodeStreamNotFound                  sdk.CodeType = 324
+	CodeNoWithdrawableAmount            sdk.CodeType = 325
 )
 
 func ErrMemoMissing() sdk.Error {
@@ -79,3 +90,47 @@ func ErrLockedCoinNotFound() sdk.Error {
 func ErrInvalidTokenSymbol(symbol string) sdk.Error {
 	return sdk.NewError(CodeSpaceBankx, CodeInvalidTokenSymbol, "%s token not exist", symbol)
 }
+
+func ErrTokenFrozenByOwner() sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeTokenFrozenByOwner, "the amount has been frozen by token owner")
+}
+
+func ErrSenderNotPermitted(denom string, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeSenderNotPermitted, "%s is not permitted by the owner of permissioned token %s to send it", addr, denom)
+}
+
+func ErrReceiverNotPermitted(denom string, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeReceiverNotPermitted, "%s is not permitted by the owner of permissioned token %s to receive it", addr, denom)
+}
+
+func ErrInvalidHashLock(msg string) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeInvalidHashLock, msg)
+}
+
+func ErrHTLCAlreadyExists(hashLock []byte) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeHTLCAlreadyExists, "htlc with hash lock %X already exists", hashLock)
+}
+
+func ErrHTLCNotFound(hashLock []byte) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeHTLCNotFound, "htlc with hash lock %X not found", hashLock)
+}
+
+func ErrInvalidSecret(msg string) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeInvalidSecret, msg)
+}
+
+func ErrHTLCExpired(hashLock []byte) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeHTLCExpired, "htlc with hash lock %X has expired", hashLock)
+}
+
+func ErrHTLCNotExpired(hashLock []byte) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeHTLCNotExpired, "htlc with hash lock %X has not expired", hashLock)
+}
+
+func ErrStreamNotFound(id uint64) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeStreamNotFound, "stream %d not found", id)
+}
+
+func ErrNoWithdrawableAmount(id uint64) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeNoWithdrawableAmount, "stream %d has no withdrawable amount", id)
+}
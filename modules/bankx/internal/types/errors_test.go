@@ -15,4 +15,10 @@ func TestErrMsg(t *testing.T) {
 	require.Equal(t, CodeInvalidUnlockTime, err.Code())
 	err = ErrTokenForbiddenByOwner()
 	require.Equal(t, CodeTokenForbiddenByOwner, err.Code())
+	err = ErrTokenFrozenByOwner()
+	require.Equal(t, CodeTokenFrozenByOwner, err.Code())
+	err = ErrSenderNotPermitted("abc", nil)
+	require.Equal(t, CodeSenderNotPermitted, err.Code())
+	err = ErrReceiverNotPermitted("abc", nil)
+	require.Equal(t, CodeReceiverNotPermitted, err.Code())
 }
@@ -1,11 +1,22 @@
 package types
 
 const (
-	EventTypeTransfer = "transfer"
+	EventTypeTransfer   = "transfer"
+	EventTypeCreateHTLC = "create_htlc"
+	EventTypeClaimHTLC  = "claim_htlc"
+	EventTypeRefundHTLC = "refund_htlc"
+
+	EventTypeCreateStream   = "create_stream"
+	EventTypeWithdrawStream = "withdraw_stream"
+	EventTypeCancelStream   = "cancel_stream"
 
 	AttributeKeyRecipient = "recipient"
 	AttributeKeySender    = "sender"
 	AttributeKeyAmount    = "amount"
+	AttributeKeyHashLock  = "hash_lock"
+	AttributeKeySecret    = "secret"
+	AttributeKeyStreamID  = "stream_id"
+	AttributeKeyRefunded  = "refunded"
 
 	AttributeValueCategory = ModuleName
 )
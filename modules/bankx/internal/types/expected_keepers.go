@@ -13,12 +13,24 @@ type ExpectedAccountXKeeper interface {
 	IterateAccounts(ctx sdk.Context, process func(authx.AccountX) (stop bool))
 	InsertUnlockedCoinsQueue(ctx sdk.Context, unlockedTime int64, address sdk.AccAddress)
 	RemoveFromUnlockedCoinsQueue(ctx sdk.Context, unlockedTime int64, address sdk.AccAddress)
+	SetHTLC(ctx sdk.Context, htlc authx.HTLC)
+	GetHTLC(ctx sdk.Context, sender sdk.AccAddress, hashLock []byte) (htlc authx.HTLC, ok bool)
+	RemoveHTLC(ctx sdk.Context, htlc authx.HTLC)
+	GetHTLCsOfAccount(ctx sdk.Context, addr sdk.AccAddress) []authx.HTLC
+	GetNextStreamID(ctx sdk.Context) uint64
+	SetNextStreamID(ctx sdk.Context, id uint64)
+	SetStream(ctx sdk.Context, stream authx.Stream)
+	GetStream(ctx sdk.Context, id uint64) (stream authx.Stream, ok bool)
+	RemoveStream(ctx sdk.Context, stream authx.Stream)
+	GetStreamsOfAccount(ctx sdk.Context, addr sdk.AccAddress) []authx.Stream
 }
 
 type ExpectedAssetStatusKeeper interface {
 	IsForbiddenByTokenIssuer(ctx sdk.Context, symbol string, addr sdk.AccAddress) bool
+	IsPermittedByTokenIssuer(ctx sdk.Context, symbol string, addr sdk.AccAddress) bool
 	IsTokenExists(ctx sdk.Context, symbol string) bool
 	UpdateTokenSendLock(ctx sdk.Context, symbol string, amount sdk.Int, lock bool) sdk.Error
+	GetIssuerFrozenAmount(ctx sdk.Context, symbol string, addr sdk.AccAddress) sdk.Int
 }
 
 // SupplyKeeper defines the expected supply keeper
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankxHooks is implemented by the modules which keep track of the balances changed by bankx keeper
+type BankxHooks interface {
+	// AfterBalanceChanged is called with the total coins of addr, including its locked and frozen coins,
+	// and the denoms whose amounts may have been changed
+	AfterBalanceChanged(ctx sdk.Context, addr sdk.AccAddress, totalCoins sdk.Coins, denoms []string)
+}
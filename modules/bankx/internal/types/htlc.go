@@ -0,0 +1,32 @@
+package types
+
+import (
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/authx"
+)
+
+// HTLCInfo is pushed to the msg queue when an HTLC is created, claimed or refunded
+type HTLCInfo struct {
+	HashLock   cmn.HexBytes   `json:"hash_lock"`
+	Sender     sdk.AccAddress `json:"sender"`
+	Recipient  sdk.AccAddress `json:"recipient"`
+	Amount     sdk.Coins      `json:"amount"`
+	ExpireTime int64          `json:"expire_time"`
+	Secret     cmn.HexBytes   `json:"secret,omitempty"`
+	Height     int64          `json:"height"`
+}
+
+func NewHTLCInfo(htlc authx.HTLC, secret []byte, height int64) HTLCInfo {
+	return HTLCInfo{
+		HashLock:   htlc.HashLock,
+		Sender:     htlc.Sender,
+		Recipient:  htlc.Recipient,
+		Amount:     htlc.Amount,
+		ExpireTime: htlc.ExpireTime,
+		Secret:     secret,
+		Height:     height,
+	}
+}
@@ -1,11 +1,16 @@
 package types
 
 import (
+	"bytes"
 	"math"
 	"time"
 
+	cmn "github.com/tendermint/tendermint/libs/common"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/coinexchain/cet-sdk/modules/authx"
 )
 
 var _ sdk.Msg = MsgSetMemoRequired{}
@@ -271,3 +276,522 @@ func (msg MsgSupervisedSend) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{msg.FromAddress}
 }
+
+var _ sdk.Msg = MsgCreateHTLC{}
+
+// MsgCreateHTLC locks the amount in the sender's account under the hash lock until the expire time
+type MsgCreateHTLC struct {
+	Sender     sdk.AccAddress `json:"sender"`
+	Recipient  sdk.AccAddress `json:"recipient"`
+	Amount     sdk.Coins      `json:"amount"`
+	HashLock   cmn.HexBytes   `json:"hash_lock"`
+	ExpireTime int64          `json:"expire_time"`
+}
+
+func NewMsgCreateHTLC(sender, recipient sdk.AccAddress, amount sdk.Coins, hashLock []byte, expireTime int64) MsgCreateHTLC {
+	return MsgCreateHTLC{
+		Sender:     sender,
+		Recipient:  recipient,
+		Amount:     amount,
+		HashLock:   hashLock,
+		ExpireTime: expireTime,
+	}
+}
+
+func (msg *MsgCreateHTLC) SetAccAddress(addr sdk.AccAddress) {
+	msg.Sender = addr
+}
+
+// Route Implements Msg
+func (msg MsgCreateHTLC) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgCreateHTLC) Type() string { return "create_htlc" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateHTLC) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if msg.Recipient.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	if !msg.Amount.IsValid() {
+		return sdk.ErrInvalidCoins("send amount is invalid: " + msg.Amount.String())
+	}
+	if !msg.Amount.IsAllPositive() {
+		return sdk.ErrInsufficientCoins("send amount must be positive")
+	}
+	if len(msg.HashLock) != authx.HashLockLength {
+		return ErrInvalidHashLock("hash lock must be a sha256 hash")
+	}
+	if msg.ExpireTime <= 0 {
+		return ErrUnlockTime("expire time must be positive")
+	}
+	if msg.ExpireTime > math.MaxInt64/int64(time.Second) {
+		return ErrUnlockTime("expire time is too large")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreateHTLC) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateHTLC) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+var _ sdk.Msg = MsgClaimHTLC{}
+
+// MsgClaimHTLC reveals the secret of the hash lock of the HTLC sent by HTLCSender, anyone knowing it could
+// claim the amount for the recipient
+type MsgClaimHTLC struct {
+	Sender     sdk.AccAddress `json:"sender"`
+	HTLCSender sdk.AccAddress `json:"htlc_sender"`
+	HashLock   cmn.HexBytes   `json:"hash_lock"`
+	Secret     cmn.HexBytes   `json:"secret"`
+}
+
+func NewMsgClaimHTLC(sender, htlcSender sdk.AccAddress, hashLock []byte, secret []byte) MsgClaimHTLC {
+	return MsgClaimHTLC{
+		Sender:     sender,
+		HTLCSender: htlcSender,
+		HashLock:   hashLock,
+		Secret:     secret,
+	}
+}
+
+func (msg *MsgClaimHTLC) SetAccAddress(addr sdk.AccAddress) {
+	msg.Sender = addr
+}
+
+// Route Implements Msg
+func (msg MsgClaimHTLC) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgClaimHTLC) Type() string { return "claim_htlc" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgClaimHTLC) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if msg.HTLCSender.Empty() {
+		return sdk.ErrInvalidAddress("missing htlc sender address")
+	}
+	if len(msg.HashLock) != authx.HashLockLength {
+		return ErrInvalidHashLock("hash lock must be a sha256 hash")
+	}
+	if len(msg.Secret) == 0 || len(msg.Secret) > authx.MaxSecretLength {
+		return ErrInvalidSecret("invalid secret length")
+	}
+	if !bytes.Equal(authx.GetHashLock(msg.Secret), msg.HashLock) {
+		return ErrInvalidSecret("secret does not match the hash lock")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgClaimHTLC) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgClaimHTLC) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+var _ sdk.Msg = MsgRefundHTLC{}
+
+// MsgRefundHTLC returns the amount of an expired HTLC to HTLCSender, anyone could send it
+type MsgRefundHTLC struct {
+	Sender     sdk.AccAddress `json:"sender"`
+	HTLCSender sdk.AccAddress `json:"htlc_sender"`
+	HashLock   cmn.HexBytes   `json:"hash_lock"`
+}
+
+func NewMsgRefundHTLC(sender, htlcSender sdk.AccAddress, hashLock []byte) MsgRefundHTLC {
+	return MsgRefundHTLC{
+		Sender:     sender,
+		HTLCSender: htlcSender,
+		HashLock:   hashLock,
+	}
+}
+
+func (msg *MsgRefundHTLC) SetAccAddress(addr sdk.AccAddress) {
+	msg.Sender = addr
+}
+
+// Route Implements Msg
+func (msg MsgRefundHTLC) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgRefundHTLC) Type() string { return "refund_htlc" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRefundHTLC) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if msg.HTLCSender.Empty() {
+		return sdk.ErrInvalidAddress("missing htlc sender address")
+	}
+	if len(msg.HashLock) != authx.HashLockLength {
+		return ErrInvalidHashLock("hash lock must be a sha256 hash")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRefundHTLC) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRefundHTLC) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+var _ sdk.Msg = MsgCreateStream{}
+
+// MsgCreateStream escrows the amount, which is vested to the recipient linearly from the start time to the end time
+type MsgCreateStream struct {
+	Sender    sdk.AccAddress `json:"sender"`
+	Recipient sdk.AccAddress `json:"recipient"`
+	Amount    sdk.Coin       `json:"amount"`
+	StartTime int64          `json:"start_time"`
+	EndTime   int64          `json:"end_time"`
+}
+
+func NewMsgCreateStream(sender, recipient sdk.AccAddress, amount sdk.Coin, startTime, endTime int64) MsgCreateStream {
+	return MsgCreateStream{
+		Sender:    sender,
+		Recipient: recipient,
+		Amount:    amount,
+		StartTime: startTime,
+		EndTime:   endTime,
+	}
+}
+
+func (msg *MsgCreateStream) SetAccAddress(addr sdk.AccAddress) {
+	msg.Sender = addr
+}
+
+// Route Implements Msg
+func (msg MsgCreateStream) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgCreateStream) Type() string { return "create_stream" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateStream) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if msg.Recipient.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	if msg.Sender.Equals(msg.Recipient) {
+		return sdk.ErrInvalidAddress("sender and recipient must be different")
+	}
+	if !msg.Amount.IsValid() {
+		return sdk.ErrInvalidCoins("send amount is invalid: " + msg.Amount.String())
+	}
+	if !msg.Amount.IsPositive() {
+		return sdk.ErrInsufficientCoins("send amount must be positive")
+	}
+	if msg.StartTime <= 0 {
+		return ErrUnlockTime("start time must be positive")
+	}
+	if msg.EndTime <= msg.StartTime {
+		return ErrUnlockTime("end time must be later than start time")
+	}
+	if msg.EndTime > math.MaxInt64/int64(time.Second) {
+		return ErrUnlockTime("end time is too large")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreateStream) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateStream) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+var _ sdk.Msg = MsgWithdrawStream{}
+
+// MsgWithdrawStream transfers the vested amount of the stream to its recipient
+type MsgWithdrawStream struct {
+	Recipient sdk.AccAddress `json:"recipient"`
+	StreamID  uint64         `json:"stream_id"`
+}
+
+func NewMsgWithdrawStream(recipient sdk.AccAddress, streamID uint64) MsgWithdrawStream {
+	return MsgWithdrawStream{
+		Recipient: recipient,
+		StreamID:  streamID,
+	}
+}
+
+func (msg *MsgWithdrawStream) SetAccAddress(addr sdk.AccAddress) {
+	msg.Recipient = addr
+}
+
+// Route Implements Msg
+func (msg MsgWithdrawStream) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgWithdrawStream) Type() string { return "withdraw_stream" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgWithdrawStream) ValidateBasic() sdk.Error {
+	if msg.Recipient.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	if msg.StreamID == 0 {
+		return ErrStreamNotFound(msg.StreamID)
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgWithdrawStream) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgWithdrawStream) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Recipient}
+}
+
+var _ sdk.Msg = MsgCancelStream{}
+
+// MsgCancelStream pays the vested amount to the recipient and returns the unvested remainder to the sender
+type MsgCancelStream struct {
+	Sender   sdk.AccAddress `json:"sender"`
+	StreamID uint64         `json:"stream_id"`
+}
+
+func NewMsgCancelStream(sender sdk.AccAddress, streamID uint64) MsgCancelStream {
+	return MsgCancelStream{
+		Sender:   sender,
+		StreamID: streamID,
+	}
+}
+
+func (msg *MsgCancelStream) SetAccAddress(addr sdk.AccAddress) {
+	msg.Sender = addr
+}
+
+// Route Implements Msg
+func (msg MsgCancelStream) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgCancelStream) Type() string { return "cancel_stream" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCancelStream) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if msg.StreamID == 0 {
+		return ErrStreamNotFound(msg.StreamID)
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCancelStream) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCancelStream) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+var _ sdk.Msg = MsgVestingSend{}
+
+// MsgVestingSend sends coins which are locked in the recipient's account and released by the schedule
+type MsgVestingSend struct {
+	FromAddress sdk.AccAddress        `json:"from_address"`
+	ToAddress   sdk.AccAddress        `json:"to_address"`
+	Amount      sdk.Coins             `json:"amount"`
+	Schedule    authx.VestingSchedule `json:"schedule"`
+}
+
+func NewMsgVestingSend(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, schedule authx.VestingSchedule) MsgVestingSend {
+	return MsgVestingSend{FromAddress: fromAddr, ToAddress: toAddr, Amount: amount, Schedule: schedule}
+}
+
+func (msg *MsgVestingSend) SetAccAddress(addr sdk.AccAddress) {
+	msg.FromAddress = addr
+}
+
+// Route Implements Msg
+func (msg MsgVestingSend) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgVestingSend) Type() string { return "vesting_send" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgVestingSend) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	if !msg.Amount.IsValid() {
+		return sdk.ErrInvalidCoins("send amount is invalid: " + msg.Amount.String())
+	}
+	if !msg.Amount.IsAllPositive() {
+		return sdk.ErrInsufficientCoins("send amount must be positive")
+	}
+	if err := msg.Schedule.Validate(); err != nil {
+		return ErrUnlockTime(err.Error())
+	}
+	if msg.Schedule.EndTime > math.MaxInt64/int64(time.Second) {
+		return ErrUnlockTime("end time is too large")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgVestingSend) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgVestingSend) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// LockedOutput is an output of MsgLockedMultiSend, whose coins are locked until UnlockTime if it is not zero,
+// and can be unlocked earlier or returned by the Supervisor if it is not empty
+type LockedOutput struct {
+	Address    sdk.AccAddress `json:"address"`
+	Coins      sdk.Coins      `json:"coins"`
+	UnlockTime int64          `json:"unlock_time,omitempty"`
+	Supervisor sdk.AccAddress `json:"supervisor,omitempty"`
+	Reward     int64          `json:"reward,omitempty"`
+}
+
+func NewLockedOutput(addr sdk.AccAddress, coins sdk.Coins, unlockTime int64, supervisor sdk.AccAddress, reward int64) LockedOutput {
+	return LockedOutput{
+		Address:    addr,
+		Coins:      coins,
+		UnlockTime: unlockTime,
+		Supervisor: supervisor,
+		Reward:     reward,
+	}
+}
+
+func (out LockedOutput) IsLocked() bool {
+	return out.UnlockTime != 0
+}
+
+func (out LockedOutput) IsSupervised() bool {
+	return !out.Supervisor.Empty()
+}
+
+func (out LockedOutput) ValidateBasic() sdk.Error {
+	if out.Address.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	if !out.Coins.IsValid() {
+		return sdk.ErrInvalidCoins("send amount is invalid: " + out.Coins.String())
+	}
+	if !out.Coins.IsAllPositive() {
+		return sdk.ErrInsufficientCoins("send amount must be positive")
+	}
+	if out.UnlockTime < 0 {
+		return ErrUnlockTime("negative unlock time")
+	}
+	if out.UnlockTime > math.MaxInt64/int64(time.Second) {
+		return ErrUnlockTime("unlock time is too large")
+	}
+	if !out.IsSupervised() {
+		if out.Reward != 0 {
+			return sdk.ErrInvalidAddress("missing supervisor address for the reward")
+		}
+		return nil
+	}
+	if !out.IsLocked() {
+		return ErrUnlockTime("unlock time must be positive for the supervised output")
+	}
+	if len(out.Coins) != 1 {
+		return sdk.ErrInvalidCoins("supervised output must have exactly one coin")
+	}
+	if out.Reward < 0 {
+		return sdk.ErrInsufficientCoins("reward can not be negative")
+	}
+	if sdk.NewInt(out.Reward).GT(out.Coins[0].Amount) {
+		return ErrRewardExceedsAmount()
+	}
+	return nil
+}
+
+var _ sdk.Msg = MsgLockedMultiSend{}
+
+// MsgLockedMultiSend sends coins from one address to several outputs, each of which may be locked and supervised
+type MsgLockedMultiSend struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	Outputs     []LockedOutput `json:"outputs"`
+}
+
+func NewMsgLockedMultiSend(fromAddr sdk.AccAddress, outputs []LockedOutput) MsgLockedMultiSend {
+	return MsgLockedMultiSend{FromAddress: fromAddr, Outputs: outputs}
+}
+
+func (msg *MsgLockedMultiSend) SetAccAddress(addr sdk.AccAddress) {
+	msg.FromAddress = addr
+}
+
+// Route Implements Msg
+func (msg MsgLockedMultiSend) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgLockedMultiSend) Type() string { return "locked_multi_send" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgLockedMultiSend) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if len(msg.Outputs) == 0 {
+		return ErrNoOutputs()
+	}
+	for _, out := range msg.Outputs {
+		if err := out.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgLockedMultiSend) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgLockedMultiSend) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// GetTotalCoins returns the sum of the coins of all the outputs
+func (msg MsgLockedMultiSend) GetTotalCoins() sdk.Coins {
+	total := sdk.Coins{}
+	for _, out := range msg.Outputs {
+		total = total.Add(out.Coins)
+	}
+	return total
+}
@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 
+	"github.com/coinexchain/cet-sdk/modules/authx"
 	"github.com/coinexchain/cet-sdk/testutil"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
@@ -232,3 +233,147 @@ func TestMsgSupervisedSend_Type(t *testing.T) {
 	require.True(t, len(msg.Type()) > 0)
 	require.Equal(t, ModuleName, msg.Route())
 }
+
+func TestMsgHTLC_ValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress([]byte("sender"))
+	recipient := sdk.AccAddress([]byte("recipient"))
+	amt := sdk.NewCoins(sdk.NewInt64Coin("cet", 123))
+	amtInvalid := sdk.Coins{sdk.Coin{Denom: "cet", Amount: sdk.NewInt(-123)}}
+	secret := []byte("secret")
+	hashLock := authx.GetHashLock(secret)
+
+	testutil.ValidateBasic(t, []testutil.TestCase{
+		{Valid: true, Msg: NewMsgCreateHTLC(sender, recipient, amt, hashLock, 10)},
+		{Valid: false, Msg: NewMsgCreateHTLC(nil, recipient, amt, hashLock, 10)},
+		{Valid: false, Msg: NewMsgCreateHTLC(sender, nil, amt, hashLock, 10)},
+		{Valid: false, Msg: NewMsgCreateHTLC(sender, recipient, amtInvalid, hashLock, 10)},
+		{Valid: false, Msg: NewMsgCreateHTLC(sender, recipient, sdk.Coins{}, hashLock, 10)},
+		{Valid: false, Msg: NewMsgCreateHTLC(sender, recipient, amt, secret, 10)},
+		{Valid: false, Msg: NewMsgCreateHTLC(sender, recipient, amt, hashLock, 0)},
+		{Valid: false, Msg: NewMsgCreateHTLC(sender, recipient, amt, hashLock, 0x0FFFFFFFFFFFFFFF)},
+		{Valid: true, Msg: NewMsgClaimHTLC(sender, sender, hashLock, secret)},
+		{Valid: false, Msg: NewMsgClaimHTLC(nil, sender, hashLock, secret)},
+		{Valid: false, Msg: NewMsgClaimHTLC(sender, nil, hashLock, secret)},
+		{Valid: false, Msg: NewMsgClaimHTLC(sender, sender, secret, secret)},
+		{Valid: false, Msg: NewMsgClaimHTLC(sender, sender, hashLock, []byte("wrong"))},
+		{Valid: false, Msg: NewMsgClaimHTLC(sender, sender, hashLock, nil)},
+		{Valid: false, Msg: NewMsgClaimHTLC(sender, sender, authx.GetHashLock(make([]byte, 65)), make([]byte, 65))},
+		{Valid: true, Msg: NewMsgRefundHTLC(sender, sender, hashLock)},
+		{Valid: false, Msg: NewMsgRefundHTLC(nil, sender, hashLock)},
+		{Valid: false, Msg: NewMsgRefundHTLC(sender, sender, secret)},
+		{Valid: false, Msg: NewMsgRefundHTLC(sender, nil, hashLock)},
+	})
+}
+
+func TestMsgHTLC_GetSigners(t *testing.T) {
+	sender := sdk.AccAddress([]byte("sender"))
+	recipient := sdk.AccAddress([]byte("recipient"))
+	hashLock := authx.GetHashLock([]byte("secret"))
+
+	createMsg := NewMsgCreateHTLC(sender, recipient, sdk.NewCoins(sdk.NewInt64Coin("cet", 123)), hashLock, 10)
+	require.Equal(t, []sdk.AccAddress{sender}, createMsg.GetSigners())
+	require.Equal(t, "create_htlc", createMsg.Type())
+	claimMsg := NewMsgClaimHTLC(recipient, sender, hashLock, []byte("secret"))
+	require.Equal(t, []sdk.AccAddress{recipient}, claimMsg.GetSigners())
+	require.Equal(t, "claim_htlc", claimMsg.Type())
+	refundMsg := NewMsgRefundHTLC(sender, sender, hashLock)
+	require.Equal(t, []sdk.AccAddress{sender}, refundMsg.GetSigners())
+	require.Equal(t, "refund_htlc", refundMsg.Type())
+}
+
+func TestMsgStream_ValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress([]byte("sender"))
+	recipient := sdk.AccAddress([]byte("recipient"))
+	amt := sdk.NewInt64Coin("cet", 123)
+	amtInvalid := sdk.Coin{Denom: "cet", Amount: sdk.NewInt(-123)}
+
+	testutil.ValidateBasic(t, []testutil.TestCase{
+		{Valid: true, Msg: NewMsgCreateStream(sender, recipient, amt, 10, 20)},
+		{Valid: false, Msg: NewMsgCreateStream(nil, recipient, amt, 10, 20)},
+		{Valid: false, Msg: NewMsgCreateStream(sender, nil, amt, 10, 20)},
+		{Valid: false, Msg: NewMsgCreateStream(sender, sender, amt, 10, 20)},
+		{Valid: false, Msg: NewMsgCreateStream(sender, recipient, amtInvalid, 10, 20)},
+		{Valid: false, Msg: NewMsgCreateStream(sender, recipient, sdk.NewInt64Coin("cet", 0), 10, 20)},
+		{Valid: false, Msg: NewMsgCreateStream(sender, recipient, amt, 0, 20)},
+		{Valid: false, Msg: NewMsgCreateStream(sender, recipient, amt, 20, 20)},
+		{Valid: false, Msg: NewMsgCreateStream(sender, recipient, amt, 10, 0x0FFFFFFFFFFFFFFF)},
+		{Valid: true, Msg: NewMsgWithdrawStream(recipient, 1)},
+		{Valid: false, Msg: NewMsgWithdrawStream(nil, 1)},
+		{Valid: false, Msg: NewMsgWithdrawStream(recipient, 0)},
+		{Valid: true, Msg: NewMsgCancelStream(sender, 1)},
+		{Valid: false, Msg: NewMsgCancelStream(nil, 1)},
+		{Valid: false, Msg: NewMsgCancelStream(sender, 0)},
+	})
+}
+
+func TestMsgStream_GetSigners(t *testing.T) {
+	sender := sdk.AccAddress([]byte("sender"))
+	recipient := sdk.AccAddress([]byte("recipient"))
+
+	createMsg := NewMsgCreateStream(sender, recipient, sdk.NewInt64Coin("cet", 123), 10, 20)
+	require.Equal(t, []sdk.AccAddress{sender}, createMsg.GetSigners())
+	require.Equal(t, "create_stream", createMsg.Type())
+	withdrawMsg := NewMsgWithdrawStream(recipient, 1)
+	require.Equal(t, []sdk.AccAddress{recipient}, withdrawMsg.GetSigners())
+	require.Equal(t, "withdraw_stream", withdrawMsg.Type())
+	cancelMsg := NewMsgCancelStream(sender, 1)
+	require.Equal(t, []sdk.AccAddress{sender}, cancelMsg.GetSigners())
+	require.Equal(t, "cancel_stream", cancelMsg.Type())
+}
+
+func TestMsgVestingSend_ValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress([]byte("sender"))
+	recipient := sdk.AccAddress([]byte("recipient"))
+	amt := sdk.NewCoins(sdk.NewInt64Coin("cet", 123))
+	amtInvalid := sdk.Coins{sdk.Coin{Denom: "cet", Amount: sdk.NewInt(-123)}}
+	schedule := authx.NewVestingSchedule(1000, 1200, 2000, 100)
+
+	testutil.ValidateBasic(t, []testutil.TestCase{
+		{Valid: true, Msg: NewMsgVestingSend(sender, recipient, amt, schedule)},
+		{Valid: false, Msg: NewMsgVestingSend(nil, recipient, amt, schedule)},
+		{Valid: false, Msg: NewMsgVestingSend(sender, nil, amt, schedule)},
+		{Valid: false, Msg: NewMsgVestingSend(sender, recipient, amtInvalid, schedule)},
+		{Valid: false, Msg: NewMsgVestingSend(sender, recipient, sdk.Coins{}, schedule)},
+		{Valid: false, Msg: NewMsgVestingSend(sender, recipient, amt, authx.NewVestingSchedule(1000, 900, 2000, 100))},
+		{Valid: false, Msg: NewMsgVestingSend(sender, recipient, amt, authx.NewVestingSchedule(1000, 1200, 2000, 1))},
+		{Valid: false, Msg: NewMsgVestingSend(sender, recipient, amt,
+			authx.NewVestingSchedule(0x0FFFFFFFFFFFFFF0, 0x0FFFFFFFFFFFFFF0, 0x0FFFFFFFFFFFFFFF, 1))},
+	})
+
+	msg := NewMsgVestingSend(sender, recipient, amt, schedule)
+	require.Equal(t, []sdk.AccAddress{sender}, msg.GetSigners())
+	require.Equal(t, "vesting_send", msg.Type())
+}
+
+func TestMsgLockedMultiSend_ValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress([]byte("sender"))
+	recipient := sdk.AccAddress([]byte("recipient"))
+	supervisor := sdk.AccAddress([]byte("supervisor"))
+	amt := sdk.NewCoins(sdk.NewInt64Coin("cet", 123))
+	amt2 := sdk.NewCoins(sdk.NewInt64Coin("abc", 10), sdk.NewInt64Coin("cet", 123))
+	amtInvalid := sdk.Coins{sdk.Coin{Denom: "cet", Amount: sdk.NewInt(-123)}}
+	newMsg := func(outputs ...LockedOutput) MsgLockedMultiSend {
+		return NewMsgLockedMultiSend(sender, outputs)
+	}
+
+	testutil.ValidateBasic(t, []testutil.TestCase{
+		{Valid: true, Msg: newMsg(NewLockedOutput(recipient, amt2, 0, nil, 0), NewLockedOutput(recipient, amt, 10, supervisor, 100))},
+		{Valid: false, Msg: NewMsgLockedMultiSend(nil, []LockedOutput{NewLockedOutput(recipient, amt, 0, nil, 0)})},
+		{Valid: false, Msg: newMsg()},
+		{Valid: false, Msg: newMsg(NewLockedOutput(nil, amt, 0, nil, 0))},
+		{Valid: false, Msg: newMsg(NewLockedOutput(recipient, amtInvalid, 0, nil, 0))},
+		{Valid: false, Msg: newMsg(NewLockedOutput(recipient, sdk.Coins{}, 0, nil, 0))},
+		{Valid: false, Msg: newMsg(NewLockedOutput(recipient, amt, -1, nil, 0))},
+		{Valid: false, Msg: newMsg(NewLockedOutput(recipient, amt, 0x0FFFFFFFFFFFFFFF, nil, 0))},
+		{Valid: false, Msg: newMsg(NewLockedOutput(recipient, amt, 10, nil, 1))},
+		{Valid: false, Msg: newMsg(NewLockedOutput(recipient, amt, 0, supervisor, 0))},
+		{Valid: false, Msg: newMsg(NewLockedOutput(recipient, amt2, 10, supervisor, 0))},
+		{Valid: false, Msg: newMsg(NewLockedOutput(recipient, amt, 10, supervisor, -1))},
+		{Valid: false, Msg: newMsg(NewLockedOutput(recipient, amt, 10, supervisor, 124))},
+	})
+
+	msg := newMsg(NewLockedOutput(recipient, amt2, 0, nil, 0), NewLockedOutput(recipient, amt, 10, supervisor, 100))
+	require.Equal(t, []sdk.AccAddress{sender}, msg.GetSigners())
+	require.Equal(t, "locked_multi_send", msg.Type())
+	require.Equal(t, "10abc,246cet", msg.GetTotalCoins().String())
+}
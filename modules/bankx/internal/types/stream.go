@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/authx"
+)
+
+// StreamInfo is returned by the stream queriers
+type StreamInfo struct {
+	Stream       authx.Stream `json:"stream"`
+	Withdrawable sdk.Int      `json:"withdrawable"`
+}
+
+func NewStreamInfo(stream authx.Stream, time int64) StreamInfo {
+	return StreamInfo{
+		Stream:       stream,
+		Withdrawable: stream.GetWithdrawable(time),
+	}
+}
+
+// StreamNotification is pushed to the msg queue when a stream is created, withdrawn or canceled
+type StreamNotification struct {
+	ID        uint64         `json:"id"`
+	Sender    sdk.AccAddress `json:"sender"`
+	Recipient sdk.AccAddress `json:"recipient"`
+	Amount    sdk.Coin       `json:"amount"`
+	StartTime int64          `json:"start_time"`
+	EndTime   int64          `json:"end_time"`
+	Paid      sdk.Int        `json:"paid"`
+	Refunded  sdk.Int        `json:"refunded"`
+	Height    int64          `json:"height"`
+}
+
+func NewStreamNotification(stream authx.Stream, paid, refunded sdk.Int, height int64) StreamNotification {
+	return StreamNotification{
+		ID:        stream.ID,
+		Sender:    stream.Sender,
+		Recipient: stream.Recipient,
+		Amount:    stream.Amount,
+		StartTime: stream.StartTime,
+		EndTime:   stream.EndTime,
+		Paid:      paid,
+		Refunded:  refunded,
+		Height:    height,
+	}
+}
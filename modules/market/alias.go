@@ -9,6 +9,8 @@ import (
 const (
 	StoreKey   = types.StoreKey
 	ModuleName = types.ModuleName
+
+	MaxTokenPricePrecision = types.MaxTokenPricePrecision
 )
 
 const (
@@ -17,6 +19,7 @@ const (
 	SymbolSeparator         = types.SymbolSeparator
 	LimitOrder              = types.LimitOrder
 	GTE                     = types.GTE
+	IOC                     = types.IOC
 	BID                     = types.BID
 	ASK                     = types.ASK
 	BUY                     = types.BUY
@@ -24,14 +27,15 @@ const (
 )
 
 var (
-	NewBaseKeeper       = keepers.NewKeeper
-	DefaultParams       = types.DefaultParams
-	DecToBigEndianBytes = types.DecToBigEndianBytes
-	ValidateOrderID     = types.ValidateOrderID
-	IsValidTradingPair  = types.IsValidTradingPair
-	ModuleCdc           = types.ModuleCdc
-	GetSymbol           = dex.GetSymbol
-	SplitSymbol         = dex.SplitSymbol
+	NewBaseKeeper         = keepers.NewKeeper
+	DefaultParams         = types.DefaultParams
+	DecToBigEndianBytes   = types.DecToBigEndianBytes
+	ValidateOrderID       = types.ValidateOrderID
+	IsValidTradingPair    = types.IsValidTradingPair
+	GetGranularityOfOrder = types.GetGranularityOfOrder
+	ModuleCdc             = types.ModuleCdc
+	GetSymbol             = dex.GetSymbol
+	SplitSymbol           = dex.SplitSymbol
 )
 
 type (
@@ -47,4 +51,7 @@ type (
 	CreateOrderInfo         = types.CreateOrderInfo
 	FillOrderInfo           = types.FillOrderInfo
 	CancelOrderInfo         = types.CancelOrderInfo
+	DepthLevel              = types.DepthLevel
+	DepthDiffInfo           = types.DepthDiffInfo
+	DepthSnapshotInfo       = types.DepthSnapshotInfo
 )
@@ -2,7 +2,10 @@ package market
 
 import (
 	"crypto/sha256"
+	"runtime"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,13 +18,37 @@ import (
 )
 
 // Some handlers which are useful when orders are matched and traded.
+// During matching, the deals are only recorded, and they are applied to the state afterwards.
 type InfoForDeal struct {
 	bxKeeper      types.ExpectedBankxKeeper
 	msgSender     msgqueue.MsgSender
 	dataHash      []byte
 	changedOrders map[string]*types.Order
+	deals         []dealRecord
 	lastPrice     sdk.Dec
-	context       sdk.Context
+	height        int64
+}
+
+// The coins exchanged by a deal, and the fill messages of the two orders at the moment of dealing
+type dealRecord struct {
+	buyer      sdk.AccAddress
+	seller     sdk.AccAddress
+	stockCoins sdk.Coins
+	moneyCoins sdk.Coins
+	fillInfos  []types.FillOrderInfo
+}
+
+// exchange the coins of the recorded deals, in the same order as they are dealt
+func (info *InfoForDeal) applyDeals(ctx sdk.Context) {
+	for _, deal := range info.deals {
+		info.bxKeeper.UnFreezeCoins(ctx, deal.seller, deal.stockCoins)
+		info.bxKeeper.SendCoins(ctx, deal.seller, deal.buyer, deal.stockCoins)
+		info.bxKeeper.UnFreezeCoins(ctx, deal.buyer, deal.moneyCoins)
+		info.bxKeeper.SendCoins(ctx, deal.buyer, deal.seller, deal.moneyCoins)
+		for _, fillInfo := range deal.fillInfos {
+			msgqueue.FillMsgs(ctx, types.FillOrderInfoKey, fillInfo)
+		}
+	}
 }
 
 // returns true when a buyer's frozen money is not enough to buy LeftStock.
@@ -96,12 +123,21 @@ func (wo *WrappedOrder) Deal(otherSide match.OrderForTrade, amount int64, price
 	seller.DealStock += amount
 	buyer.DealMoney += moneyAmountInt64
 	seller.DealMoney += moneyAmountInt64
-	ctx := wo.infoForDeal.context
-	// exchange the coins
-	wo.infoForDeal.bxKeeper.UnFreezeCoins(ctx, seller.Sender, stockCoins)
-	wo.infoForDeal.bxKeeper.SendCoins(ctx, seller.Sender, buyer.Sender, stockCoins)
-	wo.infoForDeal.bxKeeper.UnFreezeCoins(ctx, buyer.Sender, moneyCoins)
-	wo.infoForDeal.bxKeeper.SendCoins(ctx, buyer.Sender, seller.Sender, moneyCoins)
+	// the coins will be exchanged after all the markets are matched
+	deal := dealRecord{
+		buyer:      buyer.Sender,
+		seller:     seller.Sender,
+		stockCoins: stockCoins,
+		moneyCoins: moneyCoins,
+	}
+	if wo.infoForDeal.msgSender.IsSubscribed(types.Topic) {
+		height := wo.infoForDeal.height
+		deal.fillInfos = []types.FillOrderInfo{
+			newFillOrderInfo(seller, amount, moneyAmountInt64, price, height),
+			newFillOrderInfo(buyer, amount, moneyAmountInt64, price, height),
+		}
+	}
+	wo.infoForDeal.deals = append(wo.infoForDeal.deals, deal)
 
 	// record the changed orders for further processing
 	wo.infoForDeal.changedOrders[buyer.OrderID()] = buyer
@@ -109,44 +145,23 @@ func (wo *WrappedOrder) Deal(otherSide match.OrderForTrade, amount int64, price
 
 	// record the last executed price, which will be stored in MarketInfo
 	wo.infoForDeal.lastPrice = price
-
-	if wo.infoForDeal.msgSender.IsSubscribed(types.Topic) {
-		SendFillMsg(ctx, seller, buyer, amount, moneyAmountInt64, price, ctx.BlockHeight())
-	}
 }
 
-func SendFillMsg(ctx sdk.Context, seller *Order, buyer *Order, stockAmount, moneyAmount int64, price sdk.Dec, currentHeight int64) {
-	sellInfo := types.FillOrderInfo{
-		OrderID:     seller.OrderID(),
-		Height:      currentHeight,
-		TradingPair: seller.TradingPair,
-		Side:        seller.Side,
-		FillPrice:   price,
-		LeftStock:   seller.LeftStock,
-		Freeze:      seller.Freeze,
-		DealStock:   seller.DealStock,
-		DealMoney:   seller.DealMoney,
-		CurrStock:   stockAmount,
-		CurrMoney:   moneyAmount,
-		Price:       seller.Price,
-	}
-	msgqueue.FillMsgs(ctx, types.FillOrderInfoKey, sellInfo)
-
-	buyInfo := types.FillOrderInfo{
-		OrderID:     buyer.OrderID(),
+func newFillOrderInfo(order *Order, stockAmount, moneyAmount int64, price sdk.Dec, currentHeight int64) types.FillOrderInfo {
+	return types.FillOrderInfo{
+		OrderID:     order.OrderID(),
 		Height:      currentHeight,
-		TradingPair: buyer.TradingPair,
-		Side:        buyer.Side,
+		TradingPair: order.TradingPair,
+		Side:        order.Side,
 		FillPrice:   price,
-		LeftStock:   buyer.LeftStock,
-		Freeze:      buyer.Freeze,
-		DealStock:   buyer.DealStock,
-		DealMoney:   buyer.DealMoney,
+		LeftStock:   order.LeftStock,
+		Freeze:      order.Freeze,
+		DealStock:   order.DealStock,
+		DealMoney:   order.DealMoney,
 		CurrStock:   stockAmount,
 		CurrMoney:   moneyAmount,
-		Price:       buyer.Price,
+		Price:       order.Price,
 	}
-	msgqueue.FillMsgs(ctx, types.FillOrderInfoKey, buyInfo)
 }
 
 // unfreeze the frozen token in the order and remove it from the market
@@ -225,43 +240,92 @@ func calRebateAmount(ctx sdk.Context, fee int64, keeper types.ExpectedAuthXKeepe
 	return rebateAmount
 }
 
-// Iterate the candidate orders for matching, and remove the orders whose sender is forbidden by the money owner or the stock owner.
+// Iterate the candidate orders for matching, and remove the orders whose sender is forbidden by the money owner or the stock owner,
+// or is not whitelisted for a permissioned stock or money.
 func filterCandidates(ctx sdk.Context, asKeeper types.ExpectedAssetStatusKeeper, ordersIn []*types.Order, stock, money string) []*types.Order {
 	ordersOut := make([]*types.Order, 0, len(ordersIn))
 	for _, order := range ordersIn {
 		if !(asKeeper.IsForbiddenByTokenIssuer(ctx, stock, order.Sender) ||
-			asKeeper.IsForbiddenByTokenIssuer(ctx, money, order.Sender)) {
+			asKeeper.IsForbiddenByTokenIssuer(ctx, money, order.Sender)) &&
+			asKeeper.IsPermittedByTokenIssuer(ctx, stock, order.Sender) &&
+			asKeeper.IsPermittedByTokenIssuer(ctx, money, order.Sender) {
 			ordersOut = append(ordersOut, order)
 		}
 	}
 	return ordersOut
 }
 
-func runMatch(ctx sdk.Context, midPrice sdk.Dec, ratio int64, symbol string, keeper keepers.Keeper, dataHash []byte, currHeight int64) (map[string]*types.Order, sdk.Dec) {
-	orderKeeper := keepers.NewOrderKeeper(keeper.GetMarketKey(), symbol, types.ModuleCdc)
-	asKeeper := keeper.GetAssetKeeper()
-	bxKeeper := keeper.GetBankxKeeper()
+// The orders of a market which are loaded from the store before matching
+type matchInput struct {
+	midPrice       sdk.Dec
+	orderKeeper    keepers.OrderKeeper
+	candidateBytes [][]byte
+	candidates     []*types.Order
+	iocOrders      []*types.Order
+}
+
+// loadMatchInput reads the encoded candidate orders and the IOC orders of a market from the store.
+// The cache stores and the gas meter are not safe for concurrent access, so it is called sequentially.
+func loadMatchInput(ctx sdk.Context, mi types.MarketInfo, keeper keepers.Keeper, currHeight int64) *matchInput {
+	orderKeeper := keeper.GetOrderKeeper(mi.GetSymbol())
+
+	// the IOC orders created in this block must be removed even if they are not dealt
+	iocOrders := make([]*types.Order, 0)
+	for _, order := range orderKeeper.GetOrdersAtHeight(ctx, currHeight) {
+		if order.TimeInForce == types.IOC {
+			iocOrders = append(iocOrders, order)
+		}
+	}
+	return &matchInput{
+		midPrice:       mi.LastExecutedPrice,
+		orderKeeper:    orderKeeper,
+		candidateBytes: orderKeeper.GetMatchingCandidateBytes(ctx),
+		iocOrders:      iocOrders,
+	}
+}
+
+// decode the loaded candidate orders of the markets with at most 'workers' goroutines
+func decodeCandidates(inputList []*matchInput, workers int) {
+	runInParallel(len(inputList), workers, func(idx int) {
+		if input := inputList[idx]; input != nil {
+			input.candidates = input.orderKeeper.DecodeOrders(input.candidateBytes)
+			input.candidateBytes = nil
+		}
+	})
+}
+
+// sort and match the candidate orders of the markets with at most 'workers' goroutines
+func matchInParallel(inputList []*matchInput, workers int, ratio int64, keeper keepers.Keeper,
+	dataHash []byte, currHeight int64) []*InfoForDeal {
+	infoForDealList := make([]*InfoForDeal, len(inputList))
+	runInParallel(len(inputList), workers, func(idx int) {
+		if inputList[idx] != nil {
+			infoForDealList[idx] = runMatch(inputList[idx], ratio, keeper, dataHash, currHeight)
+		}
+	})
+	return infoForDealList
+}
+
+// runMatch works only on the loaded orders, so it can be run for different markets in parallel.
+// The returned InfoForDeal contains the deals to be applied and the orders need further processing.
+func runMatch(input *matchInput, ratio int64, keeper keepers.Keeper, dataHash []byte, currHeight int64) *InfoForDeal {
+	midPrice := input.midPrice
 	lowPrice := midPrice.Mul(sdk.NewDec(100 - ratio)).Quo(sdk.NewDec(100))
 	highPrice := midPrice.Mul(sdk.NewDec(100 + ratio)).Quo(sdk.NewDec(100))
 
 	infoForDeal := &InfoForDeal{
-		bxKeeper:      bxKeeper,
+		bxKeeper:      keeper.GetBankxKeeper(),
 		dataHash:      dataHash,
 		changedOrders: make(map[string]*types.Order),
-		context:       ctx,
+		height:        currHeight,
 		lastPrice:     sdk.NewDec(0),
 		msgSender:     keeper.GetMsgProducer(),
 	}
 
-	// from the order book, we fetch the candidate orders for matching and filter them
-	stock, money := SplitSymbol(orderKeeper.GetSymbol())
-	orderCandidates := orderKeeper.GetMatchingCandidates(ctx)
-	orderCandidates = filterCandidates(ctx, asKeeper, orderCandidates, stock, money)
-
 	// fill bidList and askList with wrapped orders
-	bidList := make([]match.OrderForTrade, 0, len(orderCandidates))
-	askList := make([]match.OrderForTrade, 0, len(orderCandidates))
-	for _, orderCandidate := range orderCandidates {
+	bidList := make([]match.OrderForTrade, 0, len(input.candidates))
+	askList := make([]match.OrderForTrade, 0, len(input.candidates))
+	for _, orderCandidate := range input.candidates {
 		wrappedOrder := &WrappedOrder{
 			order:       orderCandidate,
 			infoForDeal: infoForDeal,
@@ -277,23 +341,41 @@ func runMatch(ctx sdk.Context, midPrice sdk.Dec, ratio int64, symbol string, kee
 
 	// both dealt orders and IOC order need further processing
 	ordersForUpdate := infoForDeal.changedOrders
-	for _, order := range orderKeeper.GetOrdersAtHeight(ctx, currHeight) {
-		if order.TimeInForce == types.IOC {
-			// if an IOC order is not included, we include it
-			if _, ok := ordersForUpdate[order.OrderID()]; !ok {
-				ordersForUpdate[order.OrderID()] = order
-			}
+	for _, order := range input.iocOrders {
+		// if an IOC order is not included, we include it
+		if _, ok := ordersForUpdate[order.OrderID()]; !ok {
+			ordersForUpdate[order.OrderID()] = order
 		}
 	}
 
-	return ordersForUpdate, infoForDeal.lastPrice
+	return infoForDeal
+}
+
+// call fn(0), fn(1) ... fn(n-1) with at most 'workers' goroutines
+func runInParallel(n, workers int, fn func(idx int)) {
+	indexes := make(chan int, n)
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < n; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range indexes {
+				fn(idx)
+			}
+		}()
+	}
+	wg.Wait()
 }
 
 func removeExpiredOrder(ctx sdk.Context, keeper keepers.Keeper, marketInfoList []types.MarketInfo, marketParams *types.Params) {
 	currHeight := ctx.BlockHeight()
 	bankxKeeper := keeper.GetBankxKeeper()
 	for _, mi := range marketInfoList {
-		orderKeeper := keepers.NewOrderKeeper(keeper.GetMarketKey(), mi.GetSymbol(), types.ModuleCdc)
+		orderKeeper := keeper.GetOrderKeeper(mi.GetSymbol())
 		oldOrders := orderKeeper.GetOlderThan(ctx, currHeight)
 
 		for _, order := range oldOrders {
@@ -319,7 +401,7 @@ func removeExpiredMarket(ctx sdk.Context, keeper keepers.Keeper, marketParams *t
 	delistKeeper := keepers.NewDelistKeeper(keeper.GetMarketKey())
 	delistSymbols := delistKeeper.GetDelistSymbolsBeforeTime(ctx, currTime)
 	for _, symbol := range delistSymbols {
-		orderKeeper := keepers.NewOrderKeeper(keeper.GetMarketKey(), symbol, types.ModuleCdc)
+		orderKeeper := keeper.GetOrderKeeper(symbol)
 		oldOrders := orderKeeper.GetOlderThan(ctx, currHeight+1)
 		for _, ord := range oldOrders {
 			removeOrder(ctx, orderKeeper, bankxKeeper, keeper, ord, marketParams)
@@ -358,9 +440,19 @@ func EndBlocker(ctx sdk.Context, keeper keepers.Keeper) /*sdk.Tags*/ {
 		keeper.SetOrderCleanTime(ctx, currTime)
 		removeExpiredOrder(ctx, keeper, marketInfoList, &marketParams)
 		removeExpiredMarket(ctx, keeper, &marketParams)
-		return //nil
+	} else {
+		matchAndUpdateMarkets(ctx, keeper, &marketParams, runtime.NumCPU())
 	}
+	sendDepthMsgs(ctx, keeper, &marketParams)
+}
 
+// Run the match engine for the markets with newly added orders, and then update their order books.
+// The encoded orders of the markets are read from the store one market by one market, because the
+// cache stores are not safe for concurrent access. Then 'workers' goroutines decode them, and after
+// the forbidden senders are filtered out, sort and match them in memory. At last the deals and the
+// order updates are applied in the order of markets, which keeps the result the same as matching
+// the markets one by one.
+func matchAndUpdateMarkets(ctx sdk.Context, keeper keepers.Keeper, marketParams *types.Params, workers int) {
 	markets := keeper.GetMarketsWithNewlyAddedOrder(ctx)
 	if len(markets) == 0 {
 		return
@@ -373,48 +465,135 @@ func EndBlocker(ctx sdk.Context, keeper keepers.Keeper) /*sdk.Tags*/ {
 			return //should not reach here in production
 		}
 	}
-	currHeight := ctx.BlockHeight()
-	ordersForUpdateList := make([]map[string]*types.Order, len(marketInfoList))
-	newPrices := make([]sdk.Dec, len(marketInfoList))
+	matchable := make([]bool, len(marketInfoList))
 	for idx, mi := range marketInfoList {
 		// if a token is globally forbidden, exchange it is also impossible
 		if keeper.IsTokenForbidden(ctx, mi.Stock) ||
 			keeper.IsTokenForbidden(ctx, mi.Money) {
 			continue
 		}
-		symbol := mi.GetSymbol()
-		dataHash := ctx.BlockHeader().DataHash
-		ratio := marketParams.MaxExecutedPriceChangeRatio
-		oUpdate, newPrice := runMatch(ctx, mi.LastExecutedPrice, ratio, symbol, keeper, dataHash, currHeight)
-		newPrices[idx] = newPrice
-		ordersForUpdateList[idx] = oUpdate
+		matchable[idx] = true
+		keeper.RemoveNewlyAddedMark(ctx, mi.GetSymbol())
+	}
+
+	currHeight := ctx.BlockHeight()
+	dataHash := ctx.BlockHeader().DataHash
+	ratio := marketParams.MaxExecutedPriceChangeRatio
+	// the store is only accessed sequentially, while decoding, sorting and matching run in parallel
+	inputList := make([]*matchInput, len(marketInfoList))
+	for idx, mi := range marketInfoList {
+		if matchable[idx] {
+			inputList[idx] = loadMatchInput(ctx, mi, keeper, currHeight)
+		}
+	}
+	decodeCandidates(inputList, workers)
+	asKeeper := keeper.GetAssetKeeper()
+	for idx, mi := range marketInfoList {
+		if input := inputList[idx]; input != nil {
+			input.candidates = filterCandidates(ctx, asKeeper, input.candidates, mi.Stock, mi.Money)
+		}
+	}
+	infoForDealList := matchInParallel(inputList, workers, ratio, keeper, dataHash, currHeight)
+
+	for _, infoForDeal := range infoForDealList {
+		if infoForDeal != nil {
+			infoForDeal.applyDeals(ctx)
+		}
 	}
 	for idx, mi := range marketInfoList {
 		// ignore a market if there are no orders need further processing
-		if len(ordersForUpdateList[idx]) == 0 {
+		infoForDeal := infoForDealList[idx]
+		if infoForDeal == nil || len(infoForDeal.changedOrders) == 0 {
 			continue
 		}
 		bankxKeeper := keeper.GetBankxKeeper()
-		orderKeeper := keepers.NewOrderKeeper(keeper.GetMarketKey(), mi.GetSymbol(), types.ModuleCdc)
-		// update the order book
-		for _, order := range ordersForUpdateList[idx] {
+		orderKeeper := keeper.GetOrderKeeper(mi.GetSymbol())
+		// update the order book, in the order of order IDs
+		orderIDs := make([]string, 0, len(infoForDeal.changedOrders))
+		for orderID := range infoForDeal.changedOrders {
+			orderIDs = append(orderIDs, orderID)
+		}
+		sort.Strings(orderIDs)
+		for _, orderID := range orderIDs {
+			order := infoForDeal.changedOrders[orderID]
 			orderKeeper.Update(ctx, order)
 			if order.TimeInForce == types.IOC || order.LeftStock == 0 || notEnoughMoney(order) {
-				removeOrder(ctx, orderKeeper, bankxKeeper, keeper, order, &marketParams)
+				removeOrder(ctx, orderKeeper, bankxKeeper, keeper, order, marketParams)
 				if keeper.IsSubScribed(types.Topic) {
-					cancelOrderInfo := packageCancelOrderMsg(ctx, order, &marketParams, keeper)
+					cancelOrderInfo := packageCancelOrderMsg(ctx, order, marketParams, keeper)
 					msgqueue.FillMsgs(ctx, types.CancelOrderInfoKey, cancelOrderInfo)
 				}
 			}
 		}
 		// if some orders dealt, update last executed price of this market
-		if !newPrices[idx].IsZero() {
-			mi.LastExecutedPrice = newPrices[idx]
+		if !infoForDeal.lastPrice.IsZero() {
+			mi.LastExecutedPrice = infoForDeal.lastPrice
 			keeper.SetMarket(ctx, mi)
 		}
 	}
 }
 
+// Send the depth diffs of the markets whose price levels were changed in this block, and send the
+// depth snapshots of all the markets every DepthSnapshotInterval blocks. The sequence numbers are
+// updated no matter whether the messages are subscribed, so the gaps can be detected.
+func sendDepthMsgs(ctx sdk.Context, keeper keepers.Keeper, marketParams *types.Params) {
+	depthKeeper := keeper.GetDepthKeeper()
+	changes := depthKeeper.GetDepthChanges()
+	depthKeeper.ClearDepthChanges()
+	subscribed := keeper.IsSubScribed(types.Topic)
+	currHeight := ctx.BlockHeight()
+
+	// the changes are sorted by symbol, so the changes of one market are adjacent
+	for start := 0; start < len(changes); {
+		symbol := changes[start].Symbol
+		end := start
+		for end < len(changes) && changes[end].Symbol == symbol {
+			end++
+		}
+		seq := depthKeeper.IncrSequence(symbol)
+		if subscribed {
+			diffInfo := types.DepthDiffInfo{
+				TradingPair: symbol,
+				Height:      currHeight,
+				Sequence:    seq,
+				Bids:        make([]types.DepthLevel, 0, end-start),
+				Asks:        make([]types.DepthLevel, 0, end-start),
+			}
+			orderKeeper := keeper.GetOrderKeeper(symbol)
+			for _, change := range changes[start:end] {
+				level := types.DepthLevel{
+					Price:  change.Price,
+					Amount: orderKeeper.GetDepthAtPrice(ctx, change.Side, change.Price),
+				}
+				if change.Side == types.BID {
+					diffInfo.Bids = append(diffInfo.Bids, level)
+				} else {
+					diffInfo.Asks = append(diffInfo.Asks, level)
+				}
+			}
+			msgqueue.FillMsgs(ctx, types.DepthDiffInfoKey, diffInfo)
+		}
+		start = end
+	}
+
+	interval := marketParams.DepthSnapshotInterval
+	if !subscribed || interval <= 0 || currHeight%interval != 0 {
+		return
+	}
+	for _, mi := range keeper.GetAllMarketInfos(ctx) {
+		symbol := mi.GetSymbol()
+		orderKeeper := keeper.GetOrderKeeper(symbol)
+		bids, asks := orderKeeper.GetDepth(ctx)
+		msgqueue.FillMsgs(ctx, types.DepthSnapshotKey, types.DepthSnapshotInfo{
+			TradingPair: symbol,
+			Height:      currHeight,
+			Sequence:    depthKeeper.GetSequence(symbol),
+			Bids:        bids,
+			Asks:        asks,
+		})
+	}
+}
+
 func packageCancelOrderMsg(ctx sdk.Context, order *types.Order,
 	marketParams *Params, keeper types.ExpectedAuthXKeeper) types.CancelOrderInfo {
 	return packageCancelOrderMsgWithDelReason(ctx, order, "", marketParams, keeper)
@@ -2,7 +2,11 @@ package market
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
+	"math/rand"
+	"runtime"
 	"sort"
 	"testing"
 	"time"
@@ -19,6 +23,7 @@ import (
 
 	"github.com/coinexchain/cet-sdk/modules/market/internal/keepers"
 	"github.com/coinexchain/cet-sdk/modules/market/internal/types"
+	"github.com/coinexchain/cet-sdk/modules/market/match"
 	"github.com/coinexchain/cet-sdk/msgqueue"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
@@ -298,6 +303,26 @@ func TestDelist(t *testing.T) {
 	}
 }
 
+func TestFilterCandidates(t *testing.T) {
+	addr01, _ := simpleAddr("00001")
+	addr02, _ := simpleAddr("00002")
+	axk := &mocAssertStatusKeeper{}
+	axk.forbiddenDenomList = []string{"cet"}
+	axk.forbiddenAddrList = []sdk.AccAddress{addr01}
+	axk.unpermittedDenomList = []string{"usdt"}
+	axk.unpermittedAddrList = []sdk.AccAddress{addr02}
+	ctx, _ := newContextAndMarketKey(unitTestChainID)
+
+	orders := []*types.Order{
+		newTO("00001", 1, 11051, 60, types.BUY, types.GTE, 98, 1),
+		newTO("00002", 2, 11051, 60, types.BUY, types.GTE, 98, 2),
+		newTO("00003", 3, 11051, 60, types.SELL, types.GTE, 98, 3),
+	}
+	candidates := filterCandidates(ctx, axk, orders, "cet", "usdt")
+	require.Equal(t, 1, len(candidates))
+	require.Equal(t, orders[2].OrderID(), candidates[0].OrderID())
+}
+
 func TestRemoveExpiredMarket(t *testing.T) {
 	input := prepareMockInput(t, false, false)
 	haveCetAddress, _ := simpleAddr("00001")
@@ -1056,3 +1081,328 @@ func TestChargeFee(t *testing.T) {
 	keeper.cleanRecord()
 
 }
+
+func getDepthMsgs(t *testing.T, ctx sdk.Context) (diffs []types.DepthDiffInfo, snapshots []types.DepthSnapshotInfo) {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != msgqueue.EventTypeMsgQueue {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			switch string(attr.Key) {
+			case types.DepthDiffInfoKey:
+				var diff types.DepthDiffInfo
+				require.Nil(t, json.Unmarshal(attr.Value, &diff))
+				diffs = append(diffs, diff)
+			case types.DepthSnapshotKey:
+				var snapshot types.DepthSnapshotInfo
+				require.Nil(t, json.Unmarshal(attr.Value, &snapshot))
+				snapshots = append(snapshots, snapshot)
+			}
+		}
+	}
+	return
+}
+
+func TestDepthMsgs(t *testing.T) {
+	axk := &mocAssertStatusKeeper{}
+	bnk := &mocBankxKeeper{}
+	ctx, keys := newContextAndMarketKey(unitTestChainID)
+	subspace := params.NewKeeper(msgCdc, keys.keyParams, keys.tkeyParams, params.DefaultCodespace).Subspace(types.StoreKey)
+	producer := msgqueue.NewProducerFromConfig([]string{"nop"}, types.Topic, true, nil)
+	keeper := keepers.NewKeeper(keys.marketKey, axk, bnk, msgCdc, producer, subspace, auth.AccountKeeper{}, &mockKeeper{})
+	ctx = ctx.WithBlockTime(time.Now())
+	keeper.SetOrderCleanTime(ctx, ctx.BlockTime().Unix())
+	parameters := types.DefaultParams()
+	keeper.SetParams(ctx, parameters)
+	keeper.SetMarket(ctx, types.MarketInfo{
+		Stock:             "cet",
+		Money:             "usdt",
+		PricePrecision:    8,
+		LastExecutedPrice: sdk.NewDec(0),
+	})
+
+	orderKeeper := keeper.GetOrderKeeper("cet/usdt")
+	orders := []*types.Order{
+		newTO("00001", 1, 11051, 50, types.BUY, types.GTE, 998, 1),
+		newTO("00002", 2, 11051, 30, types.BUY, types.GTE, 998, 2),
+		newTO("00003", 3, 12039, 120, types.SELL, types.GTE, 998, 3),
+	}
+	for _, order := range orders {
+		orderKeeper.Add(ctx, order)
+	}
+
+	// height 1000 is a multiple of DepthSnapshotInterval
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, keeper)
+	diffs, snapshots := getDepthMsgs(t, ctx)
+	require.Equal(t, 1, len(diffs))
+	require.Equal(t, "cet/usdt", diffs[0].TradingPair)
+	require.EqualValues(t, 1, diffs[0].Sequence)
+	require.Equal(t, []types.DepthLevel{{Price: orders[0].Price, Amount: 80}}, diffs[0].Bids)
+	require.Equal(t, []types.DepthLevel{{Price: orders[2].Price, Amount: 120}}, diffs[0].Asks)
+	require.Equal(t, 1, len(snapshots))
+	require.EqualValues(t, 1, snapshots[0].Sequence)
+	require.Equal(t, diffs[0].Bids, snapshots[0].Bids)
+	require.Equal(t, diffs[0].Asks, snapshots[0].Asks)
+
+	// nothing changed, no messages
+	ctx = ctx.WithBlockHeight(1001).WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, keeper)
+	diffs, snapshots = getDepthMsgs(t, ctx)
+	require.Equal(t, 0, len(diffs))
+	require.Equal(t, 0, len(snapshots))
+
+	ctx = ctx.WithBlockHeight(1002).WithEventManager(sdk.NewEventManager())
+	orderKeeper.Remove(ctx, orders[2])
+	EndBlocker(ctx, keeper)
+	diffs, snapshots = getDepthMsgs(t, ctx)
+	require.Equal(t, 1, len(diffs))
+	require.EqualValues(t, 2, diffs[0].Sequence)
+	require.Equal(t, 0, len(diffs[0].Bids))
+	require.Equal(t, []types.DepthLevel{{Price: orders[2].Price, Amount: 0}}, diffs[0].Asks)
+	require.Equal(t, 0, len(snapshots))
+}
+
+// prepare many markets whose order books are crossed
+func prepareMarketsForMatch(marketCount, orderCount int) (sdk.Context, sdk.CommitMultiStore, keepers.Keeper, *mocBankxKeeper) {
+	axk := &mocAssertStatusKeeper{}
+	bnk := &mocBankxKeeper{}
+	ctx, keys := newContextAndMarketKey(unitTestChainID)
+	subspace := params.NewKeeper(msgCdc, keys.keyParams, keys.tkeyParams, params.DefaultCodespace).Subspace(types.StoreKey)
+	keeper := keepers.NewKeeper(keys.marketKey, axk, bnk, msgCdc, msgqueue.NewProducer(nil), subspace, auth.AccountKeeper{}, &mockKeeper{})
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	r := rand.New(rand.NewSource(1))
+	for m := 0; m < marketCount; m++ {
+		mi := types.MarketInfo{
+			Stock:             fmt.Sprintf("stk%d", m),
+			Money:             "usdt",
+			PricePrecision:    8,
+			LastExecutedPrice: sdk.NewDec(0),
+		}
+		keeper.SetMarket(ctx, mi)
+		orderKeeper := keeper.GetOrderKeeper(mi.GetSymbol())
+		for i := 0; i < orderCount; i++ {
+			side, tif := byte(types.BUY), types.GTE
+			if i%2 == 1 {
+				side = types.SELL
+			}
+			if i%7 == 0 {
+				tif = types.IOC
+			}
+			order := newTO(fmt.Sprintf("%05d", i), uint64(m*orderCount+i), 9000+r.Int63n(2000),
+				1+r.Int63n(1000), side, tif, 990+r.Int63n(11), i%256)
+			order.TradingPair = mi.GetSymbol()
+			orderKeeper.Add(ctx, order)
+		}
+	}
+	keeper.GetDepthKeeper().ClearDepthChanges()
+	// like DeliverTx, EndBlocker works on a cache-wrapped store
+	parent := ctx.MultiStore().(sdk.CommitMultiStore)
+	return ctx.WithMultiStore(parent.CacheMultiStore()), parent, keeper, bnk
+}
+
+func commitMatchResult(ctx sdk.Context, parent sdk.CommitMultiStore) []byte {
+	ctx.MultiStore().(sdk.CacheMultiStore).Write()
+	return parent.Commit().Hash
+}
+
+// legacyOrder is the WrappedOrder before matching in parallel, which exchanges the coins as soon as it deals
+type legacyOrder struct {
+	order *types.Order
+	info  *legacyInfoForDeal
+}
+
+type legacyInfoForDeal struct {
+	bxKeeper      types.ExpectedBankxKeeper
+	dataHash      []byte
+	changedOrders map[string]*types.Order
+	lastPrice     sdk.Dec
+	context       sdk.Context
+}
+
+func (lo *legacyOrder) GetPrice() sdk.Dec { return lo.order.Price }
+func (lo *legacyOrder) GetHeight() int64  { return lo.order.Height }
+func (lo *legacyOrder) GetSide() int      { return int(lo.order.Side) }
+func (lo *legacyOrder) String() string    { return lo.order.OrderID() }
+func (lo *legacyOrder) GetOwner() match.Account {
+	return lo.order.Sender
+}
+func (lo *legacyOrder) GetAmount() int64 {
+	if notEnoughMoney(lo.order) {
+		return 0
+	}
+	return lo.order.LeftStock
+}
+func (lo *legacyOrder) GetHash() []byte {
+	res := sha256.Sum256(append([]byte(lo.order.OrderID()), lo.info.dataHash...))
+	return res[:]
+}
+func (lo *legacyOrder) Deal(otherSide match.OrderForTrade, amount int64, price sdk.Dec) {
+	other := otherSide.(*legacyOrder)
+	buyer, seller := lo.order, other.order
+	if buyer.Side == types.SELL {
+		buyer, seller = other.order, lo.order
+	}
+	stock, money := SplitSymbol(buyer.TradingPair)
+	stockCoins := sdk.Coins{sdk.NewCoin(stock, sdk.NewInt(amount))}
+	moneyAmount := price.MulInt(sdk.NewInt(amount)).TruncateInt()
+	moneyCoins := sdk.Coins{sdk.NewCoin(money, moneyAmount)}
+	if moneyAmount.GT(sdk.NewInt(types.MaxOrderAmount)) {
+		return
+	}
+	moneyAmountInt64 := moneyAmount.Int64()
+	buyer.LeftStock -= amount
+	seller.LeftStock -= amount
+	buyer.Freeze -= moneyAmountInt64
+	seller.Freeze -= amount
+	buyer.DealStock += amount
+	seller.DealStock += amount
+	buyer.DealMoney += moneyAmountInt64
+	seller.DealMoney += moneyAmountInt64
+	ctx := lo.info.context
+	lo.info.bxKeeper.UnFreezeCoins(ctx, seller.Sender, stockCoins)
+	lo.info.bxKeeper.SendCoins(ctx, seller.Sender, buyer.Sender, stockCoins)
+	lo.info.bxKeeper.UnFreezeCoins(ctx, buyer.Sender, moneyCoins)
+	lo.info.bxKeeper.SendCoins(ctx, buyer.Sender, seller.Sender, moneyCoins)
+	lo.info.changedOrders[buyer.OrderID()] = buyer
+	lo.info.changedOrders[seller.OrderID()] = seller
+	lo.info.lastPrice = price
+}
+
+// legacyMatchAndUpdateMarkets is matchAndUpdateMarkets before matching in parallel: the markets are
+// matched one by one on the store, and the changed orders are updated in the order of map iteration.
+func legacyMatchAndUpdateMarkets(ctx sdk.Context, keeper keepers.Keeper, marketParams *types.Params) {
+	markets := keeper.GetMarketsWithNewlyAddedOrder(ctx)
+	marketInfoList := make([]types.MarketInfo, len(markets))
+	for idx, market := range markets {
+		marketInfoList[idx], _ = keeper.GetMarketInfo(ctx, market)
+	}
+	currHeight := ctx.BlockHeight()
+	ordersForUpdateList := make([]map[string]*types.Order, len(marketInfoList))
+	newPrices := make([]sdk.Dec, len(marketInfoList))
+	for idx, mi := range marketInfoList {
+		if keeper.IsTokenForbidden(ctx, mi.Stock) || keeper.IsTokenForbidden(ctx, mi.Money) {
+			continue
+		}
+		ratio := marketParams.MaxExecutedPriceChangeRatio
+		midPrice := mi.LastExecutedPrice
+		lowPrice := midPrice.Mul(sdk.NewDec(100 - ratio)).Quo(sdk.NewDec(100))
+		highPrice := midPrice.Mul(sdk.NewDec(100 + ratio)).Quo(sdk.NewDec(100))
+		info := &legacyInfoForDeal{
+			bxKeeper:      keeper.GetBankxKeeper(),
+			dataHash:      ctx.BlockHeader().DataHash,
+			changedOrders: make(map[string]*types.Order),
+			lastPrice:     sdk.NewDec(0),
+			context:       ctx,
+		}
+		orderKeeper := keepers.NewOrderKeeper(keeper.GetMarketKey(), mi.GetSymbol(), types.ModuleCdc)
+		keeper.RemoveNewlyAddedMark(ctx, mi.GetSymbol())
+		candidates := orderKeeper.GetMatchingCandidates(ctx)
+		candidates = filterCandidates(ctx, keeper.GetAssetKeeper(), candidates, mi.Stock, mi.Money)
+		bidList := make([]match.OrderForTrade, 0, len(candidates))
+		askList := make([]match.OrderForTrade, 0, len(candidates))
+		for _, order := range candidates {
+			if order.Side == types.BID {
+				bidList = append(bidList, &legacyOrder{order: order, info: info})
+			} else {
+				askList = append(askList, &legacyOrder{order: order, info: info})
+			}
+		}
+		match.Match(highPrice, midPrice, lowPrice, bidList, askList)
+		for _, order := range orderKeeper.GetOrdersAtHeight(ctx, currHeight) {
+			if _, ok := info.changedOrders[order.OrderID()]; !ok && order.TimeInForce == types.IOC {
+				info.changedOrders[order.OrderID()] = order
+			}
+		}
+		ordersForUpdateList[idx] = info.changedOrders
+		newPrices[idx] = info.lastPrice
+	}
+	for idx, mi := range marketInfoList {
+		if len(ordersForUpdateList[idx]) == 0 {
+			continue
+		}
+		orderKeeper := keepers.NewOrderKeeper(keeper.GetMarketKey(), mi.GetSymbol(), types.ModuleCdc)
+		for _, order := range ordersForUpdateList[idx] {
+			orderKeeper.Update(ctx, order)
+			if order.TimeInForce == types.IOC || order.LeftStock == 0 || notEnoughMoney(order) {
+				removeOrder(ctx, orderKeeper, keeper.GetBankxKeeper(), keeper, order, marketParams)
+			}
+		}
+		if !newPrices[idx].IsZero() {
+			mi.LastExecutedPrice = newPrices[idx]
+			keeper.SetMarket(ctx, mi)
+		}
+	}
+}
+
+func sortedRecords(records []string) []string {
+	res := append([]string{}, records...)
+	sort.Strings(res)
+	return res
+}
+
+func TestParallelMatchIsDeterministic(t *testing.T) {
+	ctx, parent, keeper, bnk := prepareMarketsForMatch(20, 100)
+	params := keeper.GetParams(ctx)
+	legacyMatchAndUpdateMarkets(ctx, keeper, &params)
+	refHash := commitMatchResult(ctx, parent)
+	require.NotEqual(t, 0, len(bnk.records))
+
+	for _, workers := range []int{1, 2, 4, 16} {
+		ctxP, parentP, keeperP, bnkP := prepareMarketsForMatch(20, 100)
+		matchAndUpdateMarkets(ctxP, keeperP, &params, workers)
+		require.Equal(t, refHash, commitMatchResult(ctxP, parentP))
+		// the legacy code removes the orders in the random order of map iteration
+		require.Equal(t, sortedRecords(bnk.records), sortedRecords(bnkP.records))
+	}
+}
+
+func benchmarkMatchMarkets(b *testing.B, workers int) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		ctx, _, keeper, _ := prepareMarketsForMatch(32, 200)
+		params := keeper.GetParams(ctx)
+		b.StartTimer()
+		matchAndUpdateMarkets(ctx, keeper, &params, workers)
+	}
+}
+
+func BenchmarkMatchMarketsSequentially(b *testing.B) {
+	benchmarkMatchMarkets(b, 1)
+}
+
+func BenchmarkMatchMarketsInParallel(b *testing.B) {
+	benchmarkMatchMarkets(b, runtime.NumCPU())
+}
+
+// benchmark only the stages run by 'workers' goroutines, i.e. decoding, sorting and matching,
+// run it with '-cpu' to see how it scales, e.g. go test -bench MatchStages -cpu 1,2,4,8
+func benchmarkMatchStages(b *testing.B, workers int) {
+	ctx, _, keeper, _ := prepareMarketsForMatch(32, 200)
+	params := keeper.GetParams(ctx)
+	markets := keeper.GetMarketsWithNewlyAddedOrder(ctx)
+	loaded := make([]*matchInput, len(markets))
+	for idx, market := range markets {
+		mi, _ := keeper.GetMarketInfo(ctx, market)
+		loaded[idx] = loadMatchInput(ctx, mi, keeper, ctx.BlockHeight())
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		inputList := make([]*matchInput, len(loaded))
+		for idx, input := range loaded {
+			copied := *input
+			inputList[idx] = &copied
+		}
+		decodeCandidates(inputList, workers)
+		matchInParallel(inputList, workers, params.MaxExecutedPriceChangeRatio, keeper, nil, ctx.BlockHeight())
+	}
+}
+
+func BenchmarkMatchStagesSequentially(b *testing.B) {
+	benchmarkMatchStages(b, 1)
+}
+
+func BenchmarkMatchStagesInParallel(b *testing.B) {
+	benchmarkMatchStages(b, runtime.GOMAXPROCS(0))
+}
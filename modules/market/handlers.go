@@ -224,27 +224,78 @@ func getDenomAndOrderAmount(msg types.MsgCreateOrder) (string, int64, sdk.Error)
 }
 
 func handleMsgCreateOrder(ctx sdk.Context, msg types.MsgCreateOrder, keeper keepers.Keeper) sdk.Result {
+	order, err := createOrder(ctx, msg, keeper)
+	if err != nil {
+		return err.Result()
+	}
 
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newCreateOrderEvent(order),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// OrderCreator lets other modules place orders on the order book, e.g. bancorlite sends part of
+// a routed swap to the market as an IOC order. It refers to the keeper by pointer, so that it
+// can be handed out before the market keeper is set up.
+type OrderCreator struct {
+	keeper *keepers.Keeper
+}
+
+func NewOrderCreator(keeper *keepers.Keeper) OrderCreator {
+	return OrderCreator{keeper: keeper}
+}
+
+// CreateOrder places the order as if 'msg' were sent by its sender, and returns the ID of the new order
+func (oc OrderCreator) CreateOrder(ctx sdk.Context, msg types.MsgCreateOrder) (string, sdk.Error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return "", err
+	}
+	order, err := createOrder(ctx, msg, *oc.keeper)
+	if err != nil {
+		return "", err
+	}
+	ctx.EventManager().EmitEvent(newCreateOrderEvent(order))
+	return order.OrderID(), nil
+}
+
+func newCreateOrderEvent(order *types.Order) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeKeyCreateOrder,
+		sdk.NewAttribute(AttributeKeyOrder, order.OrderID()),
+		sdk.NewAttribute(AttributeKeyTradingPair, order.TradingPair),
+		sdk.NewAttribute(AttributeKeyHeight, strconv.FormatInt(order.Height, 10)),
+	)
+}
+
+func createOrder(ctx sdk.Context, msg types.MsgCreateOrder, keeper keepers.Keeper) (*types.Order, sdk.Error) {
 	denom, amount, err := getDenomAndOrderAmount(msg)
 	if err != nil {
-		return err.Result()
+		return nil, err
 	}
 	seq, err := keeper.QuerySeqWithAddr(ctx, msg.Sender)
 	if err != nil {
-		return err.Result()
+		return nil, err
 	}
 	marketParams := keeper.GetParams(ctx)
 	frozenFee, err := calOrderCommission(ctx, keeper, msg)
 	if err != nil {
-		return err.Result()
+		return nil, err
 	}
 	featureFee := calFeatureFeeForExistBlocks(msg, marketParams)
 	totalFee := frozenFee + featureFee
 	if featureFee > types.MaxOrderAmount || frozenFee > types.MaxOrderAmount || totalFee > types.MaxOrderAmount {
-		return types.ErrInvalidOrderAmount("The frozen fee is too large").Result()
+		return nil, types.ErrInvalidOrderAmount("The frozen fee is too large")
 	}
 	if err := checkMsgCreateOrder(ctx, keeper, msg, totalFee, amount, denom, seq); err != nil {
-		return err.Result()
+		return nil, err
 	}
 	existBlocks := msg.ExistBlocks
 	if existBlocks == 0 && msg.TimeInForce == GTE {
@@ -271,31 +322,15 @@ func handleMsgCreateOrder(ctx sdk.Context, msg types.MsgCreateOrder, keeper keep
 		DealStock:        0,
 	}
 
-	ork := keepers.NewOrderKeeper(keeper.GetMarketKey(), order.TradingPair, types.ModuleCdc)
+	ork := keeper.GetOrderKeeper(order.TradingPair)
 	if err := ork.Add(ctx, &order); err != nil {
-		return err.Result()
+		return nil, err
 	}
 	if err := handleFeeForCreateOrder(ctx, keeper, amount, denom, order.Sender, frozenFee, featureFee); err != nil {
-		return err.Result()
+		return nil, err
 	}
 	sendCreateOrderMsg(ctx, keeper, order)
-
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			EventTypeKeyCreateOrder,
-			sdk.NewAttribute(AttributeKeyOrder, order.OrderID()),
-			sdk.NewAttribute(AttributeKeyTradingPair, order.TradingPair),
-			sdk.NewAttribute(AttributeKeyHeight, strconv.FormatInt(order.Height, 10)),
-		),
-		sdk.NewEvent(
-			sdk.EventTypeMessage,
-			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
-		),
-	})
-	return sdk.Result{
-		Events: ctx.EventManager().Events(),
-	}
+	return &order, nil
 }
 
 func checkMsgCreateOrder(ctx sdk.Context, keeper keepers.Keeper, msg types.MsgCreateOrder, cetFee int64, amount int64, denom string, seq uint64) sdk.Error {
@@ -331,6 +366,11 @@ func checkMsgCreateOrder(ctx sdk.Context, keeper keepers.Keeper, msg types.MsgCr
 	if keeper.IsForbiddenByTokenIssuer(ctx, stock, msg.Sender) || keeper.IsForbiddenByTokenIssuer(ctx, money, msg.Sender) {
 		return types.ErrAddressForbidByIssuer()
 	}
+	for _, denom := range []string{stock, money} {
+		if !keeper.IsPermittedByTokenIssuer(ctx, denom, msg.Sender) {
+			return types.ErrAddressNotPermitted(denom)
+		}
+	}
 	baseValue := types.GetGranularityOfOrder(marketInfo.OrderPrecision)
 	if msg.Quantity%baseValue != 0 {
 		return types.ErrInvalidOrderAmount("The amount of tokens to trade should be a multiple of the order precision")
@@ -347,7 +387,7 @@ func handleMsgCancelOrder(ctx sdk.Context, msg types.MsgCancelOrder, keeper keep
 	bankxKeeper := keeper.GetBankxKeeper()
 	glk := keepers.NewGlobalOrderKeeper(keeper.GetMarketKey(), types.ModuleCdc)
 	order := glk.QueryOrder(ctx, msg.OrderID)
-	ork := keepers.NewOrderKeeper(keeper.GetMarketKey(), order.TradingPair, types.ModuleCdc)
+	ork := keeper.GetOrderKeeper(order.TradingPair)
 	removeOrder(ctx, ork, bankxKeeper, keeper, order, &marketParams)
 
 	// send msg to kafka
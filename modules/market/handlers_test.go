@@ -37,6 +37,7 @@ type testInput struct {
 	mk      keepers.Keeper
 	handler sdk.Handler
 	akp     auth.AccountKeeper
+	ask     types.ExpectedAssetStatusKeeper
 	keys    storeKeys
 	cdc     *codec.Codec // mk.cdc
 }
@@ -143,7 +144,7 @@ func prepareAssetKeeper(t *testing.T, keys storeKeys, cdc *codec.Codec, ctx sdk.
 	sk.SetSupply(ctx, supply.Supply{Total: sdk.Coins{}})
 	axk := authx.NewKeeper(
 		cdc,
-		keys.authxCapKey,
+		keys.authxKey,
 		params.NewKeeper(cdc, keys.keyParams, keys.tkeyParams, params.DefaultCodespace).Subspace(authx.DefaultParamspace),
 		sk,
 		ak,
@@ -167,6 +168,8 @@ func prepareAssetKeeper(t *testing.T, keys storeKeys, cdc *codec.Codec, ctx sdk.
 		params.NewKeeper(cdc, keys.keyParams, keys.tkeyParams, params.DefaultCodespace).Subspace(asset.DefaultParamspace),
 		bkx,
 		sk,
+		nil,
+		msgqueue.NewProducer(nil),
 	)
 	tk.SetParams(ctx, asset.DefaultParams())
 
@@ -285,7 +288,7 @@ func prepareMockInput(t *testing.T, addrForbid, tokenForbid bool) testInput {
 	parameters := types.DefaultParams()
 	mk.SetParams(ctx, parameters)
 
-	return testInput{ctx: ctx, mk: mk, handler: NewHandler(mk), akp: akp, keys: keys, cdc: cdc}
+	return testInput{ctx: ctx, mk: mk, handler: NewHandler(mk), akp: akp, ask: ak, keys: keys, cdc: cdc}
 }
 
 func TestMarketInfoSetFailed(t *testing.T) {
@@ -384,6 +387,33 @@ func TestMarketInfoSetSuccess(t *testing.T) {
 	}
 }
 
+func TestCreateOrderPermissionedToken(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	ret := createCetMarket(input, stock, 0)
+	require.Equal(t, true, ret.IsOK(), "create market should succeed")
+	tk := input.ask.(asset.Keeper)
+	token := tk.GetToken(input.ctx, stock)
+	token.SetPermissioned(true)
+	require.Nil(t, tk.SetToken(input.ctx, token))
+
+	msgOrder := types.MsgCreateOrder{
+		Sender:         forbidAddr,
+		TradingPair:    GetSymbol(stock, dex.CET),
+		OrderType:      types.LimitOrder,
+		PricePrecision: 8,
+		Price:          100,
+		Quantity:       10000000,
+		Side:           types.SELL,
+		TimeInForce:    types.GTE,
+	}
+	ret = input.handler(input.ctx, msgOrder)
+	require.Equal(t, types.CodeAddressNotPermitted, ret.Code, "create order should fail by the sender not permitted")
+
+	require.Nil(t, tk.AddTokenWhitelist(input.ctx, stock, haveCetAddress, []sdk.AccAddress{forbidAddr}))
+	ret = input.handler(input.ctx, msgOrder)
+	require.Equal(t, true, ret.IsOK(), "create order should succeed")
+}
+
 func TestCreateOrderFailed(t *testing.T) {
 	input := prepareMockInput(t, false, true)
 	msgOrder := types.MsgCreateOrder{
@@ -0,0 +1,101 @@
+package keepers
+
+import (
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/market/internal/types"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+// A price level which was touched by adding, updating or removing orders in the current block
+type DepthChange struct {
+	Symbol string
+	Side   byte
+	Price  sdk.Dec
+}
+
+// DepthKeeper records the changed price levels of all the markets in the current block,
+// and the sequence number of the depth messages of each market.
+// They are only used to build the depth messages for the off-chain consumers, so they are kept
+// in memory instead of the KVStore, and they never affect the app hash. Each market Keeper owns
+// one DepthKeeper, and the sequence numbers are local to the node: they differ between the nodes,
+// and restart from zero after the node restarts, and then the consumers see a gap and wait for
+// the next snapshot.
+type DepthKeeper struct {
+	mtx       sync.Mutex
+	changes   map[string]DepthChange
+	sequences map[string]uint64
+}
+
+// NewDepthKeeper returns an empty DepthKeeper, which is created by NewKeeper for each market Keeper
+func NewDepthKeeper() *DepthKeeper {
+	return &DepthKeeper{
+		changes:   make(map[string]DepthChange),
+		sequences: make(map[string]uint64),
+	}
+}
+
+// the changes are sorted by this key, i.e. in the order of symbol, side and price
+func getDepthChangedKey(symbol string, side byte, price sdk.Dec) string {
+	return string(dex.ConcatKeys(
+		[]byte(symbol),
+		[]byte{0x0},
+		[]byte{side},
+		types.DecToBigEndianBytes(price),
+	))
+}
+
+// CheckTx does not change the order books of the delivered state, so its changes are ignored.
+// The changes made by a failed DeliverTx are kept, which only add some unchanged price levels
+// to the depth diffs.
+func (keeper *DepthKeeper) MarkDepthChanged(ctx sdk.Context, symbol string, side byte, price sdk.Dec) {
+	if ctx.IsCheckTx() {
+		return
+	}
+	keeper.mtx.Lock()
+	defer keeper.mtx.Unlock()
+	keeper.changes[getDepthChangedKey(symbol, side, price)] = DepthChange{
+		Symbol: symbol,
+		Side:   side,
+		Price:  price,
+	}
+}
+
+// Returns the changed price levels in the order of symbol, side and price
+func (keeper *DepthKeeper) GetDepthChanges() []DepthChange {
+	keeper.mtx.Lock()
+	defer keeper.mtx.Unlock()
+	keys := make([]string, 0, len(keeper.changes))
+	for key := range keeper.changes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	result := make([]DepthChange, len(keys))
+	for i, key := range keys {
+		result[i] = keeper.changes[key]
+	}
+	return result
+}
+
+func (keeper *DepthKeeper) ClearDepthChanges() {
+	keeper.mtx.Lock()
+	defer keeper.mtx.Unlock()
+	keeper.changes = make(map[string]DepthChange)
+}
+
+func (keeper *DepthKeeper) GetSequence(symbol string) uint64 {
+	keeper.mtx.Lock()
+	defer keeper.mtx.Unlock()
+	return keeper.sequences[symbol]
+}
+
+// Increase the sequence number of a market by one and return the new value
+func (keeper *DepthKeeper) IncrSequence(symbol string) uint64 {
+	keeper.mtx.Lock()
+	defer keeper.mtx.Unlock()
+	keeper.sequences[symbol]++
+	return keeper.sequences[symbol]
+}
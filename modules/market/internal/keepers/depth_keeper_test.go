@@ -0,0 +1,98 @@
+package keepers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/coinexchain/cet-sdk/modules/market/internal/types"
+	"github.com/coinexchain/cet-sdk/msgqueue"
+)
+
+func TestDepthKeeper(t *testing.T) {
+	orders := createTO1()
+	orders = append(orders, newTO("00006", 6, 11051, 30, types.BUY, types.GTE, 998))
+	ctx, keys := newContextAndMarketKey(unitChainID)
+	depthKeeper := NewDepthKeeper()
+	keeper := &PersistentOrderKeeper{
+		marketKey:   keys.marketKey,
+		symbol:      "cet/usdt",
+		codec:       types.ModuleCdc,
+		depthKeeper: depthKeeper,
+	}
+	for _, order := range orders {
+		keeper.Add(ctx, order)
+	}
+
+	changes := depthKeeper.GetDepthChanges()
+	require.Equal(t, 6, len(changes))
+	require.Equal(t, "cet/usdt", changes[0].Symbol)
+	require.EqualValues(t, types.BID, changes[0].Side)
+	require.Equal(t, sdk.NewDec(10900).QuoInt64(10000), changes[0].Price)
+	require.EqualValues(t, types.ASK, changes[5].Side)
+	require.Equal(t, sdk.NewDec(12039).QuoInt64(10000), changes[5].Price)
+
+	require.EqualValues(t, 80, keeper.GetDepthAtPrice(ctx, types.BID, changes[1].Price))
+	require.EqualValues(t, 0, keeper.GetDepthAtPrice(ctx, types.ASK, changes[1].Price))
+
+	bids, asks := keeper.GetDepth(ctx)
+	require.Equal(t, []types.DepthLevel{
+		{Price: sdk.NewDec(11080).QuoInt64(10000), Amount: 50},
+		{Price: sdk.NewDec(11051).QuoInt64(10000), Amount: 80},
+		{Price: sdk.NewDec(10900).QuoInt64(10000), Amount: 50},
+	}, bids)
+	require.Equal(t, []types.DepthLevel{
+		{Price: sdk.NewDec(11010).QuoInt64(10000), Amount: 100},
+		{Price: sdk.NewDec(11032).QuoInt64(10000), Amount: 60},
+		{Price: sdk.NewDec(12039).QuoInt64(10000), Amount: 120},
+	}, asks)
+
+	depthKeeper.ClearDepthChanges()
+	require.Equal(t, 0, len(depthKeeper.GetDepthChanges()))
+	keeper.Remove(ctx, orders[4])
+	changes = depthKeeper.GetDepthChanges()
+	require.Equal(t, 1, len(changes))
+	require.Equal(t, orders[4].Price, changes[0].Price)
+	require.EqualValues(t, 0, keeper.GetDepthAtPrice(ctx, types.ASK, changes[0].Price))
+
+	require.EqualValues(t, 0, depthKeeper.GetSequence("cet/usdt"))
+	require.EqualValues(t, 1, depthKeeper.IncrSequence("cet/usdt"))
+	require.EqualValues(t, 2, depthKeeper.IncrSequence("cet/usdt"))
+	require.EqualValues(t, 2, depthKeeper.GetSequence("cet/usdt"))
+	require.EqualValues(t, 0, depthKeeper.GetSequence("btc/usdt"))
+	// every DepthKeeper has its own changes and sequences
+	require.Equal(t, 0, len(NewDepthKeeper().GetDepthChanges()))
+	require.EqualValues(t, 0, NewDepthKeeper().GetSequence("cet/usdt"))
+
+	// the changes made by CheckTx are ignored
+	depthKeeper.ClearDepthChanges()
+	keeper.Remove(ctx.WithIsCheckTx(true), orders[3])
+	require.Equal(t, 0, len(depthKeeper.GetDepthChanges()))
+
+	// the depth data is not in the store
+	iter := ctx.KVStore(keys.marketKey).Iterator([]byte{0x16}, []byte{0x18})
+	defer iter.Close()
+	require.False(t, iter.Valid())
+}
+
+func TestDepthSnapshotIntervalNotInStore(t *testing.T) {
+	ctx, keys := newContextAndMarketKey(unitChainID)
+	cdc := codec.New()
+	subspace := params.NewKeeper(cdc, keys.keyParams, keys.tkeyParams, params.DefaultCodespace).Subspace(types.StoreKey)
+	keeper := NewKeeper(keys.marketKey, nil, nil, cdc, msgqueue.NewProducer(nil), subspace, auth.AccountKeeper{}, nil)
+	param := types.DefaultParams()
+	param.DepthSnapshotInterval = 50
+	keeper.SetParams(ctx, param)
+	require.EqualValues(t, 50, keeper.GetParams(ctx).DepthSnapshotInterval)
+
+	// the chains started before DepthSnapshotInterval was added do not have it in the store
+	paramStore := ctx.KVStore(keys.keyParams)
+	paramStore.Delete(append([]byte(types.StoreKey+"/"), types.KeyDepthSnapshotInterval...))
+	require.Equal(t, param.CreateMarketFee, keeper.GetParams(ctx).CreateMarketFee)
+	require.EqualValues(t, types.DefaultDepthSnapshotInterval, keeper.GetParams(ctx).DepthSnapshotInterval)
+}
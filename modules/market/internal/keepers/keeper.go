@@ -31,6 +31,7 @@ type Keeper struct {
 	msgProducer   msgqueue.MsgSender
 	ak            auth.AccountKeeper
 	authX         types.ExpectedAuthXKeeper
+	dpk           *DepthKeeper
 }
 
 func NewKeeper(key sdk.StoreKey, axkVal types.ExpectedAssetStatusKeeper,
@@ -49,6 +50,22 @@ func NewKeeper(key sdk.StoreKey, axkVal types.ExpectedAssetStatusKeeper,
 		msgProducer:   msgKeeperVal,
 		ak:            ak,
 		authX:         authX,
+		dpk:           NewDepthKeeper(),
+	}
+}
+
+// GetDepthKeeper returns the in-memory depth changes and sequences owned by this keeper
+func (k Keeper) GetDepthKeeper() *DepthKeeper {
+	return k.dpk
+}
+
+// GetOrderKeeper returns the OrderKeeper of a market, which records its changed price levels in this keeper
+func (k Keeper) GetOrderKeeper(symbol string) OrderKeeper {
+	return &PersistentOrderKeeper{
+		marketKey:   k.marketKey,
+		symbol:      symbol,
+		codec:       k.cdc,
+		depthKeeper: k.dpk,
 	}
 }
 
@@ -64,6 +81,12 @@ func (k Keeper) GetMarketsWithNewlyAddedOrder(ctx sdk.Context) []string {
 	return res
 }
 
+// RemoveNewlyAddedMark marks a market as not-newly-added, before its orders are matched
+func (k Keeper) RemoveNewlyAddedMark(ctx sdk.Context, symbol string) {
+	store := ctx.KVStore(k.marketKey)
+	store.Delete(append(NewlyAddedKeyPrefix, []byte(symbol)...))
+}
+
 func (k Keeper) QuerySeqWithAddr(ctx sdk.Context, addr sdk.AccAddress) (uint64, sdk.Error) {
 	acc := k.ak.GetAccount(ctx, addr)
 	if acc != nil {
@@ -116,6 +139,10 @@ func (k Keeper) IsForbiddenByTokenIssuer(ctx sdk.Context, denom string, addr sdk
 	return k.axk.IsForbiddenByTokenIssuer(ctx, denom, addr)
 }
 
+func (k Keeper) IsPermittedByTokenIssuer(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	return k.axk.IsPermittedByTokenIssuer(ctx, denom, addr)
+}
+
 func (k Keeper) IsTokenForbidden(ctx sdk.Context, symbol string) bool {
 	return k.axk.IsTokenForbidden(ctx, symbol)
 }
@@ -169,8 +196,16 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 }
 
 // GetParams gets the asset module's parameters.
+// DepthSnapshotInterval is not in the store of the chains started before it was added,
+// and its default value is used until it is set by a parameter change proposal.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSubspace.GetParamSet(ctx, &params)
+	for _, pair := range params.ParamSetPairs() {
+		if bytes.Equal(pair.Key, types.KeyDepthSnapshotInterval) && !k.paramSubspace.Has(ctx, pair.Key) {
+			params.DepthSnapshotInterval = types.DefaultDepthSnapshotInterval
+			continue
+		}
+		k.paramSubspace.Get(ctx, pair.Key, pair.Value)
+	}
 	return
 }
 
@@ -183,13 +218,18 @@ func (k Keeper) GetMarketFeeMin(ctx sdk.Context) int64 {
 
 // SetOrder implements token Keeper.
 func (k Keeper) SetOrder(ctx sdk.Context, order *types.Order) sdk.Error {
-	return NewOrderKeeper(k.marketKey, order.TradingPair, k.cdc).Add(ctx, order)
+	return k.GetOrderKeeper(order.TradingPair).Add(ctx, order)
 }
 
 func (k Keeper) GetAllOrders(ctx sdk.Context) []*types.Order {
 	return NewGlobalOrderKeeper(k.marketKey, k.cdc).GetAllOrders(ctx)
 }
 
+// GetDepth aggregates the order book of a market into price levels
+func (k Keeper) GetDepth(ctx sdk.Context, symbol string) (bids []types.DepthLevel, asks []types.DepthLevel) {
+	return k.GetOrderKeeper(symbol).GetDepth(ctx)
+}
+
 // -----------------------------------------------
 // market info
 
@@ -59,14 +59,19 @@ type OrderKeeper interface {
 	GetOlderThan(ctx sdk.Context, height int64) []*types.Order
 	GetOrdersAtHeight(ctx sdk.Context, height int64) []*types.Order
 	GetMatchingCandidates(ctx sdk.Context) []*types.Order
+	GetMatchingCandidateBytes(ctx sdk.Context) [][]byte
+	DecodeOrders(orderBytesList [][]byte) []*types.Order
+	GetDepthAtPrice(ctx sdk.Context, side byte, price sdk.Dec) int64
+	GetDepth(ctx sdk.Context) (bids []types.DepthLevel, asks []types.DepthLevel)
 	GetSymbol() string
 }
 
 // PersistentOrderKeeper implements OrderKeeper interface with a KVStore
 type PersistentOrderKeeper struct {
-	marketKey sdk.StoreKey
-	symbol    string
-	codec     *codec.Codec
+	marketKey   sdk.StoreKey
+	symbol      string
+	codec       *codec.Codec
+	depthKeeper *DepthKeeper
 }
 
 func (keeper *PersistentOrderKeeper) GetSymbol() string {
@@ -111,6 +116,8 @@ func (keeper *PersistentOrderKeeper) orderQueueKey(order *types.Order) []byte {
 	)
 }
 
+// NewOrderKeeper returns an OrderKeeper which does not record the changed price levels,
+// use Keeper.GetOrderKeeper to change the order books of the markets
 func NewOrderKeeper(key sdk.StoreKey, symbol string, codec *codec.Codec) OrderKeeper {
 	return &PersistentOrderKeeper{
 		marketKey: key,
@@ -119,6 +126,12 @@ func NewOrderKeeper(key sdk.StoreKey, symbol string, codec *codec.Codec) OrderKe
 	}
 }
 
+func (keeper *PersistentOrderKeeper) markDepthChanged(ctx sdk.Context, order *types.Order) {
+	if keeper.depthKeeper != nil {
+		keeper.depthKeeper.MarkDepthChanged(ctx, keeper.symbol, order.Side, order.Price)
+	}
+}
+
 //todo: panic_for_test
 func int64ToBigEndianBytes(n int64) []byte {
 	if n < 0 {
@@ -152,10 +165,12 @@ func (keeper *PersistentOrderKeeper) Update(ctx sdk.Context, order *types.Order)
 	if order.Side == types.BID {
 		key = keeper.bidListKey(order)
 		store.Set(key, []byte{})
+		keeper.markDepthChanged(ctx, order)
 	}
 	if order.Side == types.ASK {
 		key = keeper.askListKey(order)
 		store.Set(key, []byte{})
+		keeper.markDepthChanged(ctx, order)
 	}
 	return nil
 }
@@ -177,10 +192,12 @@ func (keeper *PersistentOrderKeeper) Remove(ctx sdk.Context, order *types.Order)
 	if order.Side == types.BID {
 		key = keeper.bidListKey(order)
 		store.Delete(key)
+		keeper.markDepthChanged(ctx, order)
 	}
 	if order.Side == types.ASK {
 		key = keeper.askListKey(order)
 		store.Delete(key)
+		keeper.markDepthChanged(ctx, order)
 	}
 	return nil
 }
@@ -250,12 +267,16 @@ func (keeper *PersistentOrderKeeper) getOrder(ctx sdk.Context, orderID string) *
 	return order
 }
 
-// Return the bid orders and ask orders which have proper prices and have possibilities for deal
+// Return the bid orders and ask orders which have proper prices and have possibilities for deal.
+// It only reads the store, so it can be called with a read-only context.
 func (keeper *PersistentOrderKeeper) GetMatchingCandidates(ctx sdk.Context) []*types.Order {
-	store := ctx.KVStore(keeper.marketKey)
-	// mark this order book as not-newly-added
-	store.Delete(append(NewlyAddedKeyPrefix, []byte(keeper.symbol)...))
+	return keeper.DecodeOrders(keeper.GetMatchingCandidateBytes(ctx))
+}
 
+// Return the encoded candidate orders for matching, which can be decoded by DecodeOrders.
+// Decoding does not touch the store, so the two steps can be run in different goroutines.
+func (keeper *PersistentOrderKeeper) GetMatchingCandidateBytes(ctx sdk.Context) [][]byte {
+	store := ctx.KVStore(keeper.marketKey)
 	priceStartPos := len(keeper.symbol) + 2
 	priceEndPos := priceStartPos + types.DecByteCount
 	bidListStart := dex.ConcatKeys(BidListKeyPrefix, []byte(keeper.symbol), []byte{0x0})
@@ -297,17 +318,84 @@ func (keeper *PersistentOrderKeeper) GetMatchingCandidates(ctx sdk.Context) []*t
 			orderIDList = append(orderIDList, string(bidKey[priceEndPos:]))
 		}
 	}
-	result := make([]*types.Order, 0, len(orderIDList))
+	result := make([][]byte, 0, len(orderIDList))
 	for _, orderID := range orderIDList {
-		order := keeper.getOrder(ctx, orderID)
-		if order != nil {
-			result = append(result, order)
+		orderBytes := store.Get(orderBookKey(orderID))
+		if len(orderBytes) != 0 {
+			result = append(result, orderBytes)
 		}
+	}
+	return result
+}
 
+// Decode the orders returned by GetMatchingCandidateBytes
+func (keeper *PersistentOrderKeeper) DecodeOrders(orderBytesList [][]byte) []*types.Order {
+	result := make([]*types.Order, len(orderBytesList))
+	for i, orderBytes := range orderBytesList {
+		result[i] = &types.Order{}
+		keeper.codec.MustUnmarshalBinaryBare(orderBytes, result[i])
 	}
 	return result
 }
 
+// Sum up the LeftStock of the orders at a particular price on one side
+func (keeper *PersistentOrderKeeper) GetDepthAtPrice(ctx sdk.Context, side byte, price sdk.Dec) int64 {
+	store := ctx.KVStore(keeper.marketKey)
+	listPrefix := AskListKeyPrefix
+	if side == types.BID {
+		listPrefix = BidListKeyPrefix
+	}
+	prefix := dex.ConcatKeys(listPrefix, []byte(keeper.symbol), []byte{0x0}, types.DecToBigEndianBytes(price))
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	var amount int64
+	for ; iter.Valid(); iter.Next() {
+		order := keeper.getOrder(ctx, string(iter.Key()[len(prefix):]))
+		if order != nil {
+			amount += order.LeftStock
+		}
+	}
+	return amount
+}
+
+// Aggregate the whole order book into price levels. Bids are sorted from the highest price
+// to the lowest and asks are sorted from the lowest price to the highest.
+func (keeper *PersistentOrderKeeper) GetDepth(ctx sdk.Context) (bids []types.DepthLevel, asks []types.DepthLevel) {
+	store := ctx.KVStore(keeper.marketKey)
+	bidListStart := dex.ConcatKeys(BidListKeyPrefix, []byte(keeper.symbol), []byte{0x0})
+	bidListEnd := dex.ConcatKeys(BidListKeyPrefix, []byte(keeper.symbol), []byte{0x1})
+	askListStart := dex.ConcatKeys(AskListKeyPrefix, []byte(keeper.symbol), []byte{0x0})
+	askListEnd := dex.ConcatKeys(AskListKeyPrefix, []byte(keeper.symbol), []byte{0x1})
+	bidIter := store.ReverseIterator(bidListStart, bidListEnd)
+	defer bidIter.Close()
+	bids = keeper.aggregateLevels(ctx, bidIter)
+	askIter := store.Iterator(askListStart, askListEnd)
+	defer askIter.Close()
+	asks = keeper.aggregateLevels(ctx, askIter)
+	return
+}
+
+func (keeper *PersistentOrderKeeper) aggregateLevels(ctx sdk.Context, iter sdk.Iterator) []types.DepthLevel {
+	priceStartPos := len(keeper.symbol) + 2
+	priceEndPos := priceStartPos + types.DecByteCount
+	levels := make([]types.DepthLevel, 0, 10)
+	var lastPrice []byte
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		order := keeper.getOrder(ctx, string(key[priceEndPos:]))
+		if order == nil {
+			continue
+		}
+		price := key[priceStartPos:priceEndPos]
+		if lastPrice == nil || !bytes.Equal(lastPrice, price) {
+			levels = append(levels, types.DepthLevel{Price: order.Price})
+			lastPrice = price
+		}
+		levels[len(levels)-1].Amount += order.LeftStock
+	}
+	return levels
+}
+
 ////////////////////////////////////////////////
 
 // Global order keep can lookup a order, given its ID or the prefix of its ID, i.e. the sender's address
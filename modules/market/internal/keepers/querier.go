@@ -180,7 +180,7 @@ func queryOrdersInMarket(ctx sdk.Context, req abci.RequestQuery, mk Keeper) ([]b
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse param: %s", err))
 	}
 
-	k := NewOrderKeeper(mk.marketKey, param.TradingPair, mk.cdc)
+	k := mk.GetOrderKeeper(param.TradingPair)
 	orders := k.GetOlderThan(ctx, math.MaxInt64)
 	rs := make([]*ResOrder, len(orders))
 	for i, or := range orders {
@@ -37,6 +37,7 @@ const (
 	CodeOrderAlreadyExist      sdk.CodeType = 630
 	CodeDelistRequestExist     sdk.CodeType = 632
 	CodeInvalidMarket          sdk.CodeType = 633
+	CodeAddressNotPermitted    sdk.CodeType = 634
 )
 
 func ErrFailedParseParam() sdk.Error {
@@ -79,6 +80,10 @@ func ErrAddressForbidByIssuer() sdk.Error {
 	return sdk.NewError(CodeSpaceMarket, CodeAddressForbidByIssuer, "The sender is forbidden by token issuer")
 }
 
+func ErrAddressNotPermitted(denom string) sdk.Error {
+	return sdk.NewError(CodeSpaceMarket, CodeAddressNotPermitted, fmt.Sprintf("The sender is not permitted by the issuer of permissioned token %s", denom))
+}
+
 func ErrOrderAlreadyExist(id string) sdk.Error {
 	return sdk.NewError(CodeSpaceMarket, CodeOrderAlreadyExist, "the order [%s] already exist", id)
 }
@@ -19,6 +19,8 @@ const (
 	CreateOrderInfoKey  = "create_order_info"
 	FillOrderInfoKey    = "fill_order_info"
 	CancelOrderInfoKey  = "del_order_info"
+	DepthDiffInfoKey    = "depth_diff_info"
+	DepthSnapshotKey    = "depth_snapshot_info"
 )
 
 // cancel order of reasons
@@ -82,3 +82,30 @@ type ModifyPricePrecisionInfo struct {
 	OldPricePrecision byte   `json:"old_price_precision"`
 	NewPricePrecision byte   `json:"new_price_precision"`
 }
+
+// DepthLevel is the aggregated amount of the orders at one price on one side.
+// An amount of zero means this price level has been removed from the order book.
+type DepthLevel struct {
+	Price  sdk.Dec `json:"price"`
+	Amount int64   `json:"amount"`
+}
+
+// DepthDiffInfo contains the price levels changed in one block.
+// Sequence increases by one for each diff of a market, so consumers can detect the lost ones.
+type DepthDiffInfo struct {
+	TradingPair string       `json:"trading_pair"`
+	Height      int64        `json:"height"`
+	Sequence    uint64       `json:"sequence"`
+	Bids        []DepthLevel `json:"bids"`
+	Asks        []DepthLevel `json:"asks"`
+}
+
+// DepthSnapshotInfo contains the full depth of a market. Its Sequence is the one of the latest
+// diff which has been applied to this snapshot.
+type DepthSnapshotInfo struct {
+	TradingPair string       `json:"trading_pair"`
+	Height      int64        `json:"height"`
+	Sequence    uint64       `json:"sequence"`
+	Bids        []DepthLevel `json:"bids"`
+	Asks        []DepthLevel `json:"asks"`
+}
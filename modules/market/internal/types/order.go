@@ -1,6 +1,8 @@
 package types
 
 import (
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/coinexchain/cet-sdk/types"
@@ -91,3 +93,8 @@ func DecToBigEndianBytes(d sdk.Dec) []byte {
 	}
 	return result[:]
 }
+
+// BigEndianBytesToDec is the reverse of DecToBigEndianBytes, for non-negative decimals
+func BigEndianBytesToDec(bz []byte) sdk.Dec {
+	return sdk.NewDecFromBigIntWithPrec(new(big.Int).SetBytes(bz), sdk.Precision)
+}
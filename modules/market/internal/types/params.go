@@ -18,6 +18,7 @@ const (
 	DefaultMarketFeeMin                = 1000000
 	DefaultFeeForZeroDeal              = 1000000
 	DefaultMarketMinExpiredTime        = 7 * 24 * time.Hour
+	DefaultDepthSnapshotInterval       = 100
 )
 
 var (
@@ -29,6 +30,7 @@ var (
 	KeyMarketFeeRate               = []byte("MarketFeeRate")
 	KeyMarketFeeMin                = []byte("MarketFeeMin")
 	KeyFeeForZeroDeal              = []byte("FeeForZeroDeal")
+	KeyDepthSnapshotInterval       = []byte("DepthSnapshotInterval")
 )
 
 type Params struct {
@@ -40,6 +42,7 @@ type Params struct {
 	MarketFeeRate               int64 `json:"market_fee_rate"`
 	MarketFeeMin                int64 `json:"market_fee_min"`
 	FeeForZeroDeal              int64 `json:"fee_for_zero_deal"`
+	DepthSnapshotInterval       int64 `json:"depth_snapshot_interval"`
 }
 
 // ParamKeyTable for market module
@@ -58,6 +61,7 @@ func DefaultParams() Params {
 		DefaultMarketFeeRate,
 		DefaultMarketFeeMin,
 		DefaultFeeForZeroDeal,
+		DefaultDepthSnapshotInterval,
 	}
 }
 
@@ -74,6 +78,7 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyMarketFeeRate, Value: &p.MarketFeeRate},
 		{Key: KeyMarketFeeMin, Value: &p.MarketFeeMin},
 		{Key: KeyFeeForZeroDeal, Value: &p.FeeForZeroDeal},
+		{Key: KeyDepthSnapshotInterval, Value: &p.DepthSnapshotInterval},
 	}
 }
 
@@ -87,12 +92,13 @@ func (p *Params) ValidateGenesis() error {
 	}
 	if p.MaxExecutedPriceChangeRatio < 0 || p.MarketFeeRate < 0 ||
 		p.MarketFeeMin < 0 || p.FeeForZeroDeal < 0 ||
-		p.GTEOrderLifetime < 0 || p.GTEOrderFeatureFeeByBlocks < 0 {
+		p.GTEOrderLifetime < 0 || p.GTEOrderFeatureFeeByBlocks < 0 ||
+		p.DepthSnapshotInterval < 0 {
 		return fmt.Errorf("params must be positive, MaxExecutedPriceChangeRatio "+
 			": %d, MarketFeeRate: %d, MarketFeeMin: %d, FeeForZeroDeal: %d, GTEOrderLifetime"+
-			" : %d, GTEOrderFeatureFeeByBlocks : %d", p.MaxExecutedPriceChangeRatio,
+			" : %d, GTEOrderFeatureFeeByBlocks : %d, DepthSnapshotInterval : %d", p.MaxExecutedPriceChangeRatio,
 			p.MarketFeeRate, p.MarketFeeMin, p.FeeForZeroDeal, p.GTEOrderLifetime,
-			p.GTEOrderFeatureFeeByBlocks)
+			p.GTEOrderFeatureFeeByBlocks, p.DepthSnapshotInterval)
 	}
 	return nil
 }
@@ -113,7 +119,8 @@ func (p Params) String() string {
   MaxExecutedPriceChangeRatio: %d
   MarketFeeRate:               %d
   MarketFeeMin:                %d
-  FeeForZeroDeal:              %d`,
+  FeeForZeroDeal:              %d
+  DepthSnapshotInterval:       %d`,
 		p.CreateMarketFee,
 		p.MarketMinExpiredTime,
 		p.GTEOrderLifetime,
@@ -121,5 +128,6 @@ func (p Params) String() string {
 		p.MaxExecutedPriceChangeRatio,
 		p.MarketFeeRate,
 		p.MarketFeeMin,
-		p.FeeForZeroDeal)
+		p.FeeForZeroDeal,
+		p.DepthSnapshotInterval)
 }
@@ -16,6 +16,7 @@ func TestParams(t *testing.T) {
 		MarketFeeRate:               100,
 		MarketFeeMin:                100,
 		FeeForZeroDeal:              100,
+		DepthSnapshotInterval:       100,
 	}
 	require.Equal(t, nil, params.ValidateGenesis())
 	params1 := params
@@ -39,4 +40,7 @@ func TestParams(t *testing.T) {
 	params1 = params
 	params1.FeeForZeroDeal = -1
 	require.NotNil(t, params1.ValidateGenesis())
+	params1 = params
+	params1.DepthSnapshotInterval = -1
+	require.NotNil(t, params1.ValidateGenesis())
 }
@@ -59,6 +59,8 @@ type mocAssertStatusKeeper struct {
 	forbiddenDenomList       []string
 	globalForbiddenDenomList []string
 	forbiddenAddrList        []sdk.AccAddress
+	unpermittedDenomList     []string
+	unpermittedAddrList      []sdk.AccAddress
 }
 
 func (k *mocAssertStatusKeeper) IsTokenForbidden(ctx sdk.Context, denom string) bool {
@@ -83,6 +85,14 @@ func (k *mocAssertStatusKeeper) IsForbiddenByTokenIssuer(ctx sdk.Context, denom
 	}
 	return false
 }
+func (k *mocAssertStatusKeeper) IsPermittedByTokenIssuer(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	for i := 0; i < len(k.unpermittedDenomList); i++ {
+		if denom == k.unpermittedDenomList[i] && bytes.Equal(addr, k.unpermittedAddrList[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (k *mocAssertStatusKeeper) GetToken(ctx sdk.Context, symbol string) asset.Token {
 	return nil
 }
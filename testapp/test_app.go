@@ -278,6 +278,7 @@ func (app *TestApp) initKeepers(invCheckPeriod uint) {
 		app.SupplyKeeper,
 		app.MsgQueProducer,
 	)
+	app.BankxKeeper.SetHooks(app.TokenKeeper)
 	app.DistrxKeeper = distributionx.NewKeeper(
 		app.BankxKeeper,
 		app.DistrKeeper,
@@ -288,6 +289,8 @@ func (app *TestApp) initKeepers(invCheckPeriod uint) {
 		app.ParamsKeeper.Subspace(asset.DefaultParamspace),
 		app.BankxKeeper,
 		app.SupplyKeeper,
+		app.DistrxKeeper,
+		app.MsgQueProducer,
 	)
 	app.StakingXKeeper = stakingx.NewKeeper(
 		app.keyStakingX,
@@ -307,6 +310,7 @@ func (app *TestApp) initKeepers(invCheckPeriod uint) {
 		app.BankxKeeper,
 		app.AssetKeeper,
 		&app.MarketKeeper,
+		market.NewOrderCreator(&app.MarketKeeper),
 		app.AccountXKeeper,
 		app.MsgQueProducer)
 